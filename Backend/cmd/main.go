@@ -3,12 +3,21 @@ package main
 import (
 	"backend/internal/app/services"
 	"backend/internal/config"
-	"backend/internal/domain/models"
+	"backend/internal/infra/database/migrations"
 	"backend/internal/infra/database/mysql"
+	"backend/internal/infra/gorm/encryption"
 	"backend/internal/infra/gorm/repositories"
+	"backend/internal/infra/http/docs"
 	"backend/internal/infra/http/handlers"
 	"backend/internal/infra/http/routes"
+	"backend/internal/infra/http/routes/middleware"
+	"backend/internal/infra/mailer"
+	oidcprovider "backend/internal/infra/oidc"
+	"backend/internal/infra/ratelimit"
+	"backend/internal/infra/storage"
+	"backend/pkg/fieldcrypt"
 	"log"
+	"os"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
@@ -19,18 +28,36 @@ func main() {
 	// 1. Load Config
 	cfg := config.LoadConfig()
 
+	// 1.1. Subcommand: go run ./cmd migrate <up|down|status|create>
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(cfg, os.Args[2:])
+		return
+	}
+
+	// 1.2. Kunci enkripsi data pribadi tamu (model Booking & Guest memakai serializer "encrypted")
+	keyring, err := fieldcrypt.New(cfg.FieldEncryptionKeys, cfg.FieldIndexKey)
+	if err != nil {
+		log.Fatalf("❌ Gagal memuat kunci enkripsi (FIELD_ENCRYPTION_KEYS / FIELD_INDEX_KEY): %v", err)
+	}
+	encryption.Register(keyring)
+
+	// 1.3. Subcommand: go run ./cmd reencrypt (enkripsi data lama / rotasi kunci)
+	if len(os.Args) > 1 && os.Args[1] == "reencrypt" {
+		runReencrypt(cfg, keyring)
+		return
+	}
+
 	// 2. Initialize Database
 	db := mysql.InitDB(cfg)
 
-	// 3. Auto Migrate All Models
-	mysql.AutoMigrate(db,
-		&models.User{},
-		&models.Room{},
-		&models.RoomImage{},
-		&models.Booking{},
-		&models.Review{},
-		&models.Payment{},
-	)
+	// 3. Pastikan skema sudah dimigrasi (server menolak jalan dengan skema lama)
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("❌ Gagal membaca file migrasi: %v", err)
+	}
+	if err := migrator.EnsureUpToDate(); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
 
 	// 4. Initialize Repositories
 	userRepo := repositories.NewGormRepository(db)
@@ -39,37 +66,119 @@ func main() {
 	roomImageRepo := repositories.NewGormRoomImageRepository(db)
 	reviewRepo := repositories.NewGormReviewRepository(db)
 	paymentRepo := repositories.NewPaymentRepository(db)
+	amenityRepo := repositories.NewGormAmenityRepository(db)
+	propertyRepo := repositories.NewGormPropertyRepository(db)
+	reportRepo := repositories.NewGormReportRepository(db)
+	housekeepingRepo := repositories.NewGormHousekeepingRepository(db)
+	guestRepo := repositories.NewGormGuestRepository(db, keyring)
+	auditRepo := repositories.NewGormAuditRepository(db)
+	privacyRepo := repositories.NewGormPrivacyRepository(db)
+	twoFactorRepo := repositories.NewGormTwoFactorRepository(db)
+	identityRepo := repositories.NewGormIdentityRepository(db)
+	savedGuestRepo := repositories.NewGormSavedGuestRepository(db)
+	emailChangeRepo := repositories.NewGormEmailChangeRepository(db)
+	bookingGroupRepo := repositories.NewGormBookingGroupRepository(db, keyring)
+	waitlistRepo := repositories.NewGormWaitlistRepository(db)
+	extraRepo := repositories.NewGormExtraRepository(db)
+
+	// 4.1. Initialize File Storage (local disk / S3-compatible)
+	fileStorage, err := storage.NewFileStorage(cfg)
+	if err != nil {
+		log.Fatalf("❌ Gagal inisialisasi file storage: %v", err)
+	}
+	log.Printf("File storage: %v", fileStorage)
+
+	// 4.2. Initialize Rate Limit Store (memory / Redis)
+	limiterStore, err := ratelimit.NewStore(cfg)
+	if err != nil {
+		log.Fatalf("❌ Gagal inisialisasi rate limit store: %v", err)
+	}
+
+	// 4.3. Initialize OpenID Connect Providers (discovery dilakukan saat pertama dipakai)
+	oidcProviders := oidcprovider.NewProviders(cfg)
+	log.Printf("OIDC providers: %d", len(oidcProviders))
+
+	// 4.4. Initialize Mailer (log / SMTP)
+	accountMailer, err := mailer.NewMailer(cfg)
+	if err != nil {
+		log.Fatalf("❌ Gagal inisialisasi mailer: %v", err)
+	}
+	log.Printf("Mailer: %v", accountMailer)
 
 	// 5. Initialize Services
-	authService := services.NewAuthService(userRepo, cfg)
-	roomService := services.NewRoomService(roomRepo, roomImageRepo)
-	bookingService := services.NewBookingService(bookingRepo, roomRepo, reviewRepo)
+	authService := services.NewAuthService(userRepo, twoFactorRepo, limiterStore, cfg)
+	roomService := services.NewRoomService(roomRepo, roomImageRepo, amenityRepo, propertyRepo, fileStorage, cfg)
+	waitlistService := services.NewWaitlistService(waitlistRepo, roomRepo, userRepo, accountMailer, cfg)
+	bookingService := services.NewBookingService(bookingRepo, roomRepo, reviewRepo, housekeepingRepo, guestRepo, paymentRepo, bookingGroupRepo, extraRepo, propertyRepo, waitlistService)
 	reviewService := services.NewReviewService(reviewRepo, bookingRepo)
-	paymentService := services.NewPaymentService(paymentRepo, bookingRepo)
+	paymentService := services.NewPaymentService(paymentRepo, bookingRepo, bookingGroupRepo)
+	amenityService := services.NewAmenityService(amenityRepo)
+	propertyService := services.NewPropertyService(propertyRepo, userRepo)
+	reportService := services.NewReportService(reportRepo)
+	extraService := services.NewExtraService(extraRepo, propertyRepo)
+	housekeepingService := services.NewHousekeepingService(housekeepingRepo, bookingRepo, roomRepo, userRepo)
+	guestService := services.NewGuestService(guestRepo, bookingRepo)
+	privacyService := services.NewPrivacyService(auditRepo, privacyRepo, guestRepo, bookingRepo, userRepo)
+	userService := services.NewUserService(userRepo, savedGuestRepo, emailChangeRepo, privacyService, authService, accountMailer, cfg)
+	oidcService := services.NewOIDCService(oidcProviders, identityRepo, userRepo, authService)
 
 	// 6. Initialize Handlers
 	authHandler := handlers.NewAuthHandler(authService)
 	roomHandler := handlers.NewRoomHandler(roomService)
-	bookingHandler := handlers.NewBookingHandler(bookingService)
+	bookingHandler := handlers.NewBookingHandler(bookingService, userService)
 	reviewHandler := handlers.NewReviewHandler(reviewService)
-	userHandler := handlers.NewUserHandler(db)
+	userHandler := handlers.NewUserHandler(userService)
 	paymentHandler := handlers.NewPaymentHandler(paymentService)
+	amenityHandler := handlers.NewAmenityHandler(amenityService)
+	propertyHandler := handlers.NewPropertyHandler(propertyService)
+	reportHandler := handlers.NewReportHandler(reportService)
+	housekeepingHandler := handlers.NewHousekeepingHandler(housekeepingService, roomService)
+	guestHandler := handlers.NewGuestHandler(guestService)
+	privacyHandler := handlers.NewPrivacyHandler(privacyService)
+	oidcHandler := handlers.NewOIDCHandler(oidcService)
+	groupHandler := handlers.NewGroupHandler(bookingService, paymentService)
+	waitlistHandler := handlers.NewWaitlistHandler(waitlistService, bookingService)
+	extraHandler := handlers.NewExtraHandler(extraService)
 
 	// 7. Create Fiber App
-	app := fiber.New()
+	app := fiber.New(fiber.Config{
+		// Pemetaan error domain -> status HTTP terpusat
+		ErrorHandler: middleware.ErrorHandler,
+		// Default Fiber 4MB; beri ruang untuk upload galeri (jumlah file x ukuran maksimal) + field form lain
+		BodyLimit: (cfg.UploadMaxImageMB*cfg.UploadMaxFiles + 1) << 20,
+		// IP asli client untuk rate limit jika server di belakang reverse proxy
+		ProxyHeader: cfg.ProxyHeader,
+	})
 
 	// 8. Add Middleware
 	app.Use(logger.New())
+	app.Use(middleware.LanguageMiddleware())
 	app.Use(cors.New(cors.Config{
-		AllowOrigins: "http://localhost:3000", // Ganti dengan origin frontend Anda jika berbeda
-		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
+		AllowOrigins:  "http://localhost:3000", // Ganti dengan origin frontend Anda jika berbeda
+		AllowHeaders:  "Origin, Content-Type, Accept, Accept-Language, Authorization",
+		ExposeHeaders: "Retry-After, X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset",
 	}))
 
-	// 8.1. Serve static files for uploads
-	app.Static("/uploads", "./uploads")
+	// 8.1. Serve uploaded files (hanya untuk storage lokal; S3 memakai presigned URL)
+	if local, ok := fileStorage.(*storage.LocalStorage); ok {
+		app.Get(local.PublicBase()+"/*", local.ServeHandler())
+	}
 
 	// 9. Setup Routes
-	routes.SetupRoutes(app, authHandler, roomHandler, bookingHandler, reviewHandler, userHandler, paymentHandler, cfg)
+	routes.SetupRoutes(app, authHandler, roomHandler, bookingHandler, reviewHandler, userHandler, paymentHandler, amenityHandler, propertyHandler, reportHandler, housekeepingHandler, guestHandler, privacyHandler, oidcHandler, groupHandler, waitlistHandler, extraHandler, authService, propertyService, limiterStore, cfg)
+
+	// 9.1. Pastikan semua route terdokumentasi di OpenAPI spec
+	if missing, err := docs.MissingRoutes(app); err != nil {
+		log.Printf("⚠️ Gagal membaca OpenAPI spec: %v", err)
+	} else if len(missing) > 0 {
+		log.Printf("⚠️ Route belum ada di OpenAPI spec (%s/openapi.json): %v", docs.DocsPrefix, missing)
+	}
+
+	// 9.2. Tugas stay-over housekeeping dibuat otomatis setiap hari
+	go runHousekeepingScheduler(housekeepingService)
+
+	// 9.3. Penawaran daftar tunggu yang kedaluwarsa diteruskan ke antrean berikutnya
+	go runWaitlistScheduler(waitlistService)
 
 	// 10. Start Server
 	port := ":" + cfg.ServerPort
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"backend/internal/config"
+	"backend/internal/infra/database/migrations"
+	"backend/internal/infra/database/mysql"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+const migrateUsage = `Penggunaan: go run ./cmd migrate <perintah>
+
+Perintah:
+  up              Jalankan semua migrasi yang belum dijalankan
+  down [n]        Batalkan n migrasi terakhir (default 1)
+  status          Tampilkan status semua migrasi
+  create <nama>   Buat file migrasi baru di ` + migrations.SourceDir
+
+// runMigrate menangani subcommand `migrate`
+func runMigrate(cfg *config.Config, args []string) {
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	// create tidak butuh koneksi database
+	if args[0] == "create" {
+		if len(args) < 2 {
+			log.Fatal(migrateUsage)
+		}
+		upPath, downPath, err := migrations.Create(migrations.SourceDir, args[1])
+		if err != nil {
+			log.Fatalf("❌ Gagal membuat migrasi: %v", err)
+		}
+		log.Printf("✅ Migrasi dibuat:\n  %s\n  %s", upPath, downPath)
+		return
+	}
+
+	db := mysql.InitDB(cfg)
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("❌ Gagal membaca file migrasi: %v", err)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, m := range applied {
+			log.Printf("⬆️  %06d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		log.Printf("✅ %d migrasi dijalankan", len(applied))
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				log.Fatalf("❌ Jumlah langkah tidak valid: %s", args[1])
+			}
+		}
+		rolledBack, err := migrator.Down(steps)
+		for _, m := range rolledBack {
+			log.Printf("⬇️  %06d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		log.Printf("✅ %d migrasi dibatalkan", len(rolledBack))
+
+	case "status":
+		all, err := migrator.Status()
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		for _, m := range all {
+			status := "pending"
+			if m.AppliedAt != nil {
+				status = "applied " + m.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%06d_%-40s %s\n", m.Version, m.Name, status)
+		}
+
+	default:
+		log.Fatal(migrateUsage)
+	}
+}
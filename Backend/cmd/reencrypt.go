@@ -0,0 +1,35 @@
+package main
+
+import (
+	"backend/internal/config"
+	"backend/internal/infra/database/migrations"
+	"backend/internal/infra/database/mysql"
+	"backend/internal/infra/gorm/encryption"
+	"backend/pkg/fieldcrypt"
+	"log"
+)
+
+// runReencrypt menangani subcommand `reencrypt`: mengenkripsi data pribadi tamu yang masih
+// plaintext dan mengganti ciphertext kunci lama dengan kunci aktif (rotasi kunci).
+// Setelah selesai, kunci lama boleh dihapus dari FIELD_ENCRYPTION_KEYS.
+func runReencrypt(cfg *config.Config, keyring *fieldcrypt.Keyring) {
+	db := mysql.InitDB(cfg)
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		log.Fatalf("❌ Gagal membaca file migrasi: %v", err)
+	}
+	if err := migrator.EnsureUpToDate(); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	log.Printf("🔐 Enkripsi ulang data pribadi tamu dengan kunci %q...", keyring.ActiveKeyID())
+	updated, err := encryption.Reencrypt(db, keyring)
+	for table, count := range updated {
+		log.Printf("   %s: %d baris diperbarui", table, count)
+	}
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	log.Println("✅ Semua data pribadi tamu memakai kunci aktif")
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"backend/internal/app/services"
+	"log"
+	"time"
+)
+
+// housekeepingInterval: generator dijalankan tiap jam agar tugas hari ini tetap terbuat
+// walaupun server baru dinyalakan siang hari. Generator idempoten, jadi aman diulang.
+const housekeepingInterval = time.Hour
+
+// runHousekeepingScheduler membuat tugas stay-over harian di background
+func runHousekeepingScheduler(housekeepingService services.HousekeepingService) {
+	for {
+		created, err := housekeepingService.GenerateStayoverTasks(time.Now())
+		if err != nil {
+			log.Printf("⚠️ Gagal membuat tugas stay-over housekeeping: %v", err)
+		} else if created > 0 {
+			log.Printf("Housekeeping: %d tugas stay-over baru dibuat", created)
+		}
+		time.Sleep(housekeepingInterval)
+	}
+}
+
+// waitlistInterval: pembatalan langsung memproses daftar tunggu; scheduler menangani penawaran
+// yang kedaluwarsa (diteruskan ke antrean berikutnya) dan kamar yang kosong lewat jalur lain
+const waitlistInterval = time.Minute
+
+// runWaitlistScheduler memproses daftar tunggu di background
+func runWaitlistScheduler(waitlistService services.WaitlistService) {
+	for {
+		offered, err := waitlistService.ProcessWaitlist(time.Now())
+		if err != nil {
+			log.Printf("⚠️ Gagal memproses daftar tunggu: %v", err)
+		} else if offered > 0 {
+			log.Printf("Daftar tunggu: %d penawaran kamar baru dikirim", offered)
+		}
+		time.Sleep(waitlistInterval)
+	}
+}
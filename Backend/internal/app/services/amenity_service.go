@@ -0,0 +1,15 @@
+package services
+
+import "backend/internal/domain/models"
+
+// AmenityService mendefinisikan kontrak untuk katalog fasilitas kamar
+type AmenityService interface {
+	// Untuk Publik (daftar filter pencarian)
+	GetAllAmenities(category string) ([]models.Amenity, error)
+	GetAmenityByID(amenityID uint) (*models.Amenity, error)
+
+	// Untuk Admin
+	CreateAmenity(amenity *models.Amenity) (*models.Amenity, error)
+	UpdateAmenity(amenity *models.Amenity) (*models.Amenity, error)
+	DeleteAmenity(amenityID uint) error
+}
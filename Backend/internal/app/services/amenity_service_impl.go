@@ -0,0 +1,68 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type amenityServiceImpl struct {
+	amenityRepo repositories.AmenityRepository
+}
+
+func NewAmenityService(amenityRepo repositories.AmenityRepository) AmenityService {
+	return &amenityServiceImpl{amenityRepo: amenityRepo}
+}
+
+// GetAllAmenities: Mengambil katalog fasilitas (opsional per kategori)
+func (s *amenityServiceImpl) GetAllAmenities(category string) ([]models.Amenity, error) {
+	return s.amenityRepo.FindAll(category)
+}
+
+// GetAmenityByID: Mengambil detail fasilitas
+func (s *amenityServiceImpl) GetAmenityByID(amenityID uint) (*models.Amenity, error) {
+	amenity, err := s.amenityRepo.FindByID(amenityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrAmenityNotFound
+		}
+		return nil, err
+	}
+	return amenity, nil
+}
+
+// CreateAmenity: Menambah fasilitas baru (Admin Only)
+func (s *amenityServiceImpl) CreateAmenity(amenity *models.Amenity) (*models.Amenity, error) {
+	if err := s.amenityRepo.Create(amenity); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrAmenityAlreadyExists
+		}
+		return nil, err
+	}
+	return amenity, nil
+}
+
+// UpdateAmenity: Mengubah fasilitas (Admin Only)
+func (s *amenityServiceImpl) UpdateAmenity(amenity *models.Amenity) (*models.Amenity, error) {
+	if _, err := s.GetAmenityByID(amenity.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.amenityRepo.Update(amenity); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrAmenityAlreadyExists
+		}
+		return nil, err
+	}
+	return amenity, nil
+}
+
+// DeleteAmenity: Menghapus fasilitas beserta relasinya ke kamar (Admin Only)
+func (s *amenityServiceImpl) DeleteAmenity(amenityID uint) error {
+	if _, err := s.GetAmenityByID(amenityID); err != nil {
+		return err
+	}
+	return s.amenityRepo.Delete(amenityID)
+}
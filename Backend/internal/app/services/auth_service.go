@@ -6,5 +6,28 @@ import (
 
 type AuthService interface {
 	Register(user *models.User) (*models.User, error)
-	Login(username, password string) (string, *models.User, error)
+	// Login tahap pertama. Jika user memakai 2FA (atau diwajibkan kebijakan), hasilnya berupa
+	// challenge token yang diselesaikan lewat VerifyTwoFactor / ConfirmEnrollment.
+	Login(username, password string) (*models.LoginResult, error)
+	// LoginVerifiedUser melanjutkan login user yang sudah diverifikasi pihak lain (provider OIDC);
+	// kebijakan 2FA tetap berlaku seperti login dengan password
+	LoginVerifiedUser(user *models.User) (*models.LoginResult, error)
+
+	// Challenge login 2FA
+	// code boleh berupa kode TOTP 6 digit atau recovery code sekali pakai
+	VerifyTwoFactor(challengeToken, code string) (*models.LoginResult, error)
+	BeginEnrollment(challengeToken string) (*models.TwoFactorSetup, error)
+	ConfirmEnrollment(challengeToken, code string) (*models.LoginResult, []string, error)
+
+	// Pengelolaan 2FA oleh user yang sudah login
+	GetTwoFactorStatus(userID uint) (*models.TwoFactorStatus, error)
+	SetupTwoFactor(userID uint) (*models.TwoFactorSetup, error)
+	EnableTwoFactor(userID uint, code string) ([]string, error)
+	// DisableTwoFactor butuh password (atau login ulang untuk akun tanpa password) dan kode 2FA
+	DisableTwoFactor(userID uint, proof models.Reauthentication) error
+	RegenerateRecoveryCodes(userID uint, code string) ([]string, error)
+	// CheckActiveUser memastikan pemilik token akses masih ada dan tidak dinonaktifkan
+	CheckActiveUser(userID uint) error
+	// VerifySecondFactor mengonfirmasi aksi sensitif dengan kode TOTP atau recovery code (2FA harus aktif)
+	VerifySecondFactor(userID uint, code string) error
 }
\ No newline at end of file
@@ -3,22 +3,94 @@ package services
 import (
 	"backend/internal/config"
 	"backend/internal/domain/models"
+	"backend/internal/domain/ratelimit"
 	"backend/internal/domain/repositories"
+	"backend/pkg/totp"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
 // AuthService implementasi dari interface AuthService
 type authServiceImpl struct {
-	userRepo repositories.UserRepository
-	cfg      *config.Config
+	userRepo      repositories.UserRepository
+	twoFactorRepo repositories.TwoFactorRepository
+	limiterStore  ratelimit.Store
+	cfg           *config.Config
 }
 
 // NewAuthService adalah constructor
-func NewAuthService(userRepo repositories.UserRepository, cfg *config.Config) AuthService {
-	return &authServiceImpl{userRepo: userRepo, cfg: cfg}
+func NewAuthService(userRepo repositories.UserRepository, twoFactorRepo repositories.TwoFactorRepository, limiterStore ratelimit.Store, cfg *config.Config) AuthService {
+	return &authServiceImpl{userRepo: userRepo, twoFactorRepo: twoFactorRepo, limiterStore: limiterStore, cfg: cfg}
+}
+
+// loginFailureWindow: percobaan gagal dihitung ulang dari nol setelah window ini berakhir
+const loginFailureWindow = 24 * time.Hour
+
+// loginKey membuat key counter login per username (huruf besar/kecil disamakan)
+func loginKey(kind, username string) string {
+	return "login:" + kind + ":" + strings.ToLower(strings.TrimSpace(username))
+}
+
+// checkLockout menolak login selama akun masih dikunci
+func (s *authServiceImpl) checkLockout(username string) error {
+	locked, ttl, err := s.limiterStore.Get(context.Background(), loginKey("lock", username))
+	if err != nil {
+		// Store tidak tersedia: login tetap dilayani, bcrypt tetap memperlambat brute-force
+		log.Printf("⚠️ Gagal membaca status lockout login: %v", err)
+		return nil
+	}
+	if locked > 0 {
+		return models.WithRetryAfter(models.ErrAccountLocked, ttl)
+	}
+	return nil
+}
+
+// recordLoginFailure menghitung password salah berturut-turut. Setiap kelipatan threshold
+// akun dikunci, dengan durasi berlipat dua (1, 2, 4, ... menit) hingga batas maksimal.
+// Mengembalikan ErrAccountLocked jika percobaan ini membuat akun terkunci.
+func (s *authServiceImpl) recordLoginFailure(username string) error {
+	threshold := int64(s.cfg.LoginLockoutThreshold)
+	if threshold <= 0 {
+		return nil
+	}
+
+	failures, _, err := s.limiterStore.Increment(context.Background(), loginKey("fail", username), loginFailureWindow)
+	if err != nil {
+		log.Printf("⚠️ Gagal mencatat login gagal: %v", err)
+		return nil
+	}
+	if failures%threshold != 0 {
+		return nil
+	}
+
+	lockout := time.Duration(s.cfg.LoginLockoutMinutes) * time.Minute
+	maxLockout := time.Duration(s.cfg.LoginLockoutMaxMinutes) * time.Minute
+	for level := failures / threshold; level > 1 && lockout < maxLockout; level-- {
+		lockout *= 2
+	}
+	if lockout > maxLockout {
+		lockout = maxLockout
+	}
+	if lockout <= 0 {
+		return nil
+	}
+
+	if err := s.limiterStore.Set(context.Background(), loginKey("lock", username), 1, lockout); err != nil {
+		log.Printf("⚠️ Gagal mengunci akun: %v", err)
+		return nil
+	}
+	return models.WithRetryAfter(models.ErrAccountLocked, lockout)
 }
 
 // Helper: generateToken membuat JWT Token
@@ -30,6 +102,7 @@ func (s *authServiceImpl) generateToken(user *models.User) (string, error) {
 	claims := models.Claims{
 		UserID: user.ID,
 		Role:   user.Role,
+		Lang:   user.Language,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -57,6 +130,9 @@ func (s *authServiceImpl) Register(user *models.User) (*models.User, error) {
 
 	// 3. Simpan ke Database melalui Repository
 	if err := s.userRepo.Create(user); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrUserAlreadyExists
+		}
 		return nil, err
 	}
 
@@ -65,28 +141,412 @@ func (s *authServiceImpl) Register(user *models.User) (*models.User, error) {
 	return user, nil
 }
 
-// Login memverifikasi user, password, dan membuat token
-func (s *authServiceImpl) Login(username, password string) (string, *models.User, error) {
+// Login memverifikasi user dan password, lalu membuat token atau challenge 2FA
+func (s *authServiceImpl) Login(username, password string) (*models.LoginResult, error) {
+	// 0. Tolak selama akun dikunci karena terlalu banyak password/kode salah
+	if err := s.checkLockout(username); err != nil {
+		return nil, err
+	}
+
 	// 1. Cari User di DB berdasarkan username
 	user, err := s.userRepo.FindByUsername(username)
 	if err != nil {
-		// Gunakan error gorm.ErrRecordNotFound untuk penanganan di Handler
-		return "", nil, err
+		// Jangan bedakan user tidak ada dengan password salah (hindari enumerasi username),
+		// termasuk perilaku lockout-nya
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if lockErr := s.recordLoginFailure(username); lockErr != nil {
+				return nil, lockErr
+			}
+			return nil, models.ErrInvalidCredentials
+		}
+		return nil, err
 	}
 
 	// 2. Verifikasi Password
 	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password)); err != nil {
 		// Password salah
-		return "", nil, models.ErrInvalidCredentials
+		if lockErr := s.recordLoginFailure(username); lockErr != nil {
+			return nil, lockErr
+		}
+		return nil, models.ErrInvalidCredentials
+	}
+
+	// Akun yang dinonaktifkan admin baru diberi tahu setelah password benar (hindari enumerasi)
+	if user.DeactivatedAt != nil {
+		return nil, models.ErrAccountDeactivated
+	}
+
+	// 3. Password benar tetapi akun memakai 2FA: token baru diberikan setelah kode diverifikasi.
+	// Hitungan gagal tidak direset di sini agar kode 2FA tidak bisa ditebak ulang lewat login ulang.
+	if user.TwoFactorEnabled {
+		return s.newChallenge(user, models.ChallengeTwoFactorVerify)
+	}
+	if s.twoFactorRequired(user) {
+		return s.newChallenge(user, models.ChallengeTwoFactorEnroll)
+	}
+
+	// 4. Buat JWT Token
+	return s.completeLogin(user)
+}
+
+func (s *authServiceImpl) LoginVerifiedUser(user *models.User) (*models.LoginResult, error) {
+	if err := s.checkLockout(user.Username); err != nil {
+		return nil, err
+	}
+	if user.DeactivatedAt != nil {
+		return nil, models.ErrAccountDeactivated
+	}
+	if user.TwoFactorEnabled {
+		return s.newChallenge(user, models.ChallengeTwoFactorVerify)
+	}
+	if s.twoFactorRequired(user) {
+		return s.newChallenge(user, models.ChallengeTwoFactorEnroll)
+	}
+	return s.completeLogin(user)
+}
+
+// completeLogin mereset hitungan gagal dan membuat token akses
+func (s *authServiceImpl) completeLogin(user *models.User) (*models.LoginResult, error) {
+	// Dicek ulang untuk alur 2FA: akun bisa dinonaktifkan di antara login dan verifikasi kode
+	if user.DeactivatedAt != nil {
+		return nil, models.ErrAccountDeactivated
+	}
+
+	if err := s.limiterStore.Delete(context.Background(), loginKey("fail", user.Username)); err != nil {
+		log.Printf("⚠️ Gagal mereset hitungan login gagal: %v", err)
 	}
 
-	// 3. Buat JWT Token
 	tokenString, err := s.generateToken(user)
 	if err != nil {
-		return "", nil, err
+		return nil, err
 	}
 
 	// Sembunyikan password sebelum dikembalikan
 	user.Password = ""
-	return tokenString, user, nil
+	return &models.LoginResult{Token: tokenString, User: user}, nil
+}
+
+// --- Two-Factor Authentication ---
+
+// challengeTTL adalah masa berlaku challenge token antara password dan kode 2FA
+const challengeTTL = 5 * time.Minute
+
+// totpSkew menerima kode dari satu periode sebelum/sesudah (selisih jam perangkat ±30 detik)
+const totpSkew = 1
+
+// twoFactorRequired: kebijakan REQUIRE_ADMIN_2FA berlaku untuk role admin
+func (s *authServiceImpl) twoFactorRequired(user *models.User) bool {
+	return s.cfg.RequireAdminTwoFactor && user.Role == models.RoleAdmin
+}
+
+// newChallenge membuat token berumur pendek yang hanya bisa dipakai di endpoint 2FA
+func (s *authServiceImpl) newChallenge(user *models.User, purpose string) (*models.LoginResult, error) {
+	claims := models.Claims{
+		UserID:  user.ID,
+		Role:    user.Role,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(challengeTTL)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.cfg.JWTSecret))
+	if err != nil {
+		return nil, err
+	}
+	return &models.LoginResult{
+		ChallengeToken: token,
+		ChallengeType:  purpose,
+		ExpiresIn:      int(challengeTTL / time.Second),
+	}, nil
+}
+
+// parseChallenge memvalidasi challenge token dan memuat user-nya
+func (s *authServiceImpl) parseChallenge(challengeToken, purpose string) (*models.User, error) {
+	claims := &models.Claims{}
+	token, err := jwt.ParseWithClaims(challengeToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return []byte(s.cfg.JWTSecret), nil
+	})
+	if err != nil || !token.Valid || claims.Purpose != purpose {
+		return nil, models.ErrInvalidChallenge
+	}
+
+	user, err := s.userRepo.FindByID(claims.UserID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrInvalidChallenge
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// findUser memuat user yang sedang login
+func (s *authServiceImpl) findUser(userID uint) (*models.User, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// verifySecondFactor mencocokkan kode TOTP (6 digit) atau recovery code.
+// Kode salah dihitung bersama password salah sehingga lockout login juga melindungi 2FA.
+func (s *authServiceImpl) verifySecondFactor(user *models.User, code string) error {
+	if err := s.checkLockout(user.Username); err != nil {
+		return err
+	}
+
+	var valid bool
+	var err error
+	if step, ok := totp.Validate(user.TwoFactorSecret, code, time.Now(), totpSkew); ok {
+		// Kode yang sama tidak boleh dipakai dua kali dalam masa berlakunya
+		if valid, err = s.twoFactorRepo.ClaimStep(user.ID, step); valid {
+			user.TwoFactorLastStep = step
+		}
+	} else if user.TwoFactorEnabled {
+		valid, err = s.twoFactorRepo.UseRecoveryCode(user.ID, hashRecoveryCode(code))
+	}
+	if err != nil {
+		return err
+	}
+
+	if !valid {
+		if lockErr := s.recordLoginFailure(user.Username); lockErr != nil {
+			return lockErr
+		}
+		return models.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (s *authServiceImpl) VerifyTwoFactor(challengeToken, code string) (*models.LoginResult, error) {
+	user, err := s.parseChallenge(challengeToken, models.ChallengeTwoFactorVerify)
+	if err != nil {
+		return nil, err
+	}
+	// 2FA dinonaktifkan setelah challenge dibuat: minta login ulang
+	if !user.TwoFactorEnabled {
+		return nil, models.ErrInvalidChallenge
+	}
+
+	if err := s.verifySecondFactor(user, code); err != nil {
+		return nil, err
+	}
+	return s.completeLogin(user)
+}
+
+func (s *authServiceImpl) BeginEnrollment(challengeToken string) (*models.TwoFactorSetup, error) {
+	user, err := s.parseChallenge(challengeToken, models.ChallengeTwoFactorEnroll)
+	if err != nil {
+		return nil, err
+	}
+	return s.setup(user)
+}
+
+func (s *authServiceImpl) ConfirmEnrollment(challengeToken, code string) (*models.LoginResult, []string, error) {
+	user, err := s.parseChallenge(challengeToken, models.ChallengeTwoFactorEnroll)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	recoveryCodes, err := s.enable(user, code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := s.completeLogin(user)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, recoveryCodes, nil
+}
+
+func (s *authServiceImpl) GetTwoFactorStatus(userID uint) (*models.TwoFactorStatus, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.TwoFactorStatus{
+		Enabled:   user.TwoFactorEnabled,
+		EnabledAt: user.TwoFactorEnabledAt,
+		Required:  s.twoFactorRequired(user),
+	}
+	if user.TwoFactorEnabled {
+		if status.RecoveryCodesRemaining, err = s.twoFactorRepo.CountUnusedRecoveryCodes(user.ID); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+func (s *authServiceImpl) SetupTwoFactor(userID uint) (*models.TwoFactorSetup, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.setup(user)
+}
+
+// setup membuat secret baru (belum aktif sampai kode pertama dikonfirmasi).
+// Setup ulang sebelum konfirmasi mengganti secret sebelumnya.
+func (s *authServiceImpl) setup(user *models.User) (*models.TwoFactorSetup, error) {
+	if user.TwoFactorEnabled {
+		return nil, models.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	user.TwoFactorSecret = secret
+	user.TwoFactorLastStep = 0
+	if err := s.twoFactorRepo.SaveSettings(user); err != nil {
+		return nil, err
+	}
+
+	return &models.TwoFactorSetup{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.cfg.TwoFactorIssuer, user.Username, secret),
+	}, nil
+}
+
+func (s *authServiceImpl) EnableTwoFactor(userID uint, code string) ([]string, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.enable(user, code)
+}
+
+// enable mengaktifkan 2FA setelah kode pertama dari authenticator cocok, lalu membuat recovery code
+func (s *authServiceImpl) enable(user *models.User, code string) ([]string, error) {
+	if user.TwoFactorEnabled {
+		return nil, models.ErrTwoFactorAlreadyEnabled
+	}
+	if user.TwoFactorSecret == "" {
+		return nil, models.ErrTwoFactorSetupNotStarted
+	}
+	if err := s.verifySecondFactor(user, code); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user.TwoFactorEnabled = true
+	user.TwoFactorEnabledAt = &now
+	if err := s.twoFactorRepo.SaveSettings(user); err != nil {
+		return nil, err
+	}
+	return s.issueRecoveryCodes(user.ID)
+}
+
+func (s *authServiceImpl) DisableTwoFactor(userID uint, proof models.Reauthentication) error {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return err
+	}
+	if !user.TwoFactorEnabled {
+		return models.ErrTwoFactorNotEnabled
+	}
+	if s.twoFactorRequired(user) {
+		return models.ErrTwoFactorRequired
+	}
+
+	// Butuh bukti identitas dan kode sekaligus: token yang dicuri saja tidak cukup untuk mematikan 2FA.
+	// Kode sudah dipakai sebagai faktor kedua, jadi akun tanpa password membuktikan identitasnya
+	// lewat token akses yang baru diterbitkan (login ulang lewat provider).
+	code := proof.Code
+	proof.Code = ""
+	if err := verifyReauthentication(user, proof, nil); err != nil {
+		return err
+	}
+	if err := s.verifySecondFactor(user, code); err != nil {
+		return err
+	}
+
+	user.TwoFactorEnabled = false
+	user.TwoFactorEnabledAt = nil
+	user.TwoFactorSecret = ""
+	user.TwoFactorLastStep = 0
+	if err := s.twoFactorRepo.SaveSettings(user); err != nil {
+		return err
+	}
+	return s.twoFactorRepo.DeleteRecoveryCodes(user.ID)
+}
+
+// CheckActiveUser: token akses tetap valid sampai kedaluwarsa, sehingga akun yang dinonaktifkan admin
+// atau dihapus pemiliknya (soft delete) ditolak di sini pada setiap request
+func (s *authServiceImpl) CheckActiveUser(userID uint) error {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrAccountDeactivated
+		}
+		return err
+	}
+	if user.DeactivatedAt != nil {
+		return models.ErrAccountDeactivated
+	}
+	return nil
+}
+
+func (s *authServiceImpl) VerifySecondFactor(userID uint, code string) error {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return err
+	}
+	if !user.TwoFactorEnabled {
+		return models.ErrTwoFactorNotEnabled
+	}
+	return s.verifySecondFactor(user, code)
+}
+
+func (s *authServiceImpl) RegenerateRecoveryCodes(userID uint, code string) ([]string, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TwoFactorEnabled {
+		return nil, models.ErrTwoFactorNotEnabled
+	}
+	if err := s.verifySecondFactor(user, code); err != nil {
+		return nil, err
+	}
+	return s.issueRecoveryCodes(user.ID)
+}
+
+// issueRecoveryCodes membuat recovery code baru (menggantikan yang lama); hanya hash yang disimpan
+func (s *authServiceImpl) issueRecoveryCodes(userID uint) ([]string, error) {
+	codes := make([]string, 0, models.RecoveryCodeCount)
+	hashes := make([]string, 0, models.RecoveryCodeCount)
+	for i := 0; i < models.RecoveryCodeCount; i++ {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		// 40 bit -> 8 karakter base32, ditampilkan "xxxx-xxxx"
+		encoded := strings.ToLower(base32.StdEncoding.EncodeToString(raw))
+		code := encoded[:4] + "-" + encoded[4:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	if err := s.twoFactorRepo.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// hashRecoveryCode menormalkan penulisan (huruf besar/kecil, tanda hubung, spasi) lalu meng-hash
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
 }
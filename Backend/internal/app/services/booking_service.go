@@ -9,13 +9,42 @@ type BookingService interface {
 	GetUserBookings(userID uint, pagination *models.Pagination) ([]models.Booking, error)
 	CancelBooking(bookingID uint, userID uint) error
 	DeleteBooking(bookingID uint, userID uint) error
-	
+	// ModifyBooking mengubah tanggal, kamar, atau jumlah tamu; selisih harga ditagih atau di-refund
+	ModifyBooking(bookingID uint, userID uint, change *models.BookingChange) (*models.BookingModification, error)
+	GetBookingModifications(bookingID uint, userID uint) ([]models.BookingModification, error)
+
+	// Reservasi grup: beberapa kamar, satu tamu utama, satu pembayaran
+	CreateGroupBooking(group *models.BookingGroup, rooms []models.GroupRoom) (*models.BookingGroup, error) // Semua kamar tersimpan atau tidak sama sekali
+	GetUserGroups(userID uint, pagination *models.Pagination) ([]models.BookingGroup, error)
+	GetUserGroup(groupID uint, userID uint) (*models.BookingGroup, error)
+	CancelGroup(groupID uint, userID uint) (*models.BookingGroup, error)
+	UpdateRoomingList(groupID uint, userID uint, entries []models.RoomingListEntry) (*models.BookingGroup, error)
+
+	// ClaimWaitlistOffer membuat booking dari penawaran daftar tunggu (link klaim berbatas waktu)
+	ClaimWaitlistOffer(token string, booking *models.Booking) (*models.Booking, error)
+
+	// Layanan tambahan (sarapan, spa, dll): total booking dihitung ulang, selisih ditagih atau di-refund
+	AddExtra(bookingID uint, userID uint, extra models.BookingExtra) (*models.Booking, error)
+	RemoveExtra(bookingID uint, userID uint, bookingExtraID uint) (*models.Booking, error) // Hanya yang ditambahkan sendiri, sebelum check-in
+	GetInvoice(bookingID uint, userID uint) (*models.Invoice, error)
+
 	// Untuk Admin
-	GetAllBookings(pagination *models.Pagination) ([]models.Booking, error)
+	GetAllBookings(filter *models.BookingFilter, pagination *models.Pagination) ([]models.Booking, error)
 	GetBookingByID(bookingID uint) (*models.Booking, error)
 	UpdateBooking(booking *models.Booking) (*models.Booking, error)
 	UpdatePaymentStatus(bookingID uint, newStatus string) (*models.Booking, error)
-	
+	UpdateBookingStatus(bookingID uint, newStatus string) (*models.Booking, error) // completed = check-out, membuat tugas housekeeping
+	CheckInBooking(bookingID uint) (*models.Booking, error)                        // Menolak kamar yang belum bersih
+	ModifyBookingByAdmin(bookingID uint, staffID uint, change *models.BookingChange) (*models.BookingModification, error)
+	GetBookingModificationsByAdmin(bookingID uint) ([]models.BookingModification, error)
+	GetAllGroups(filter *models.BookingGroupFilter, pagination *models.Pagination) ([]models.BookingGroup, error)
+	GetGroupByID(groupID uint) (*models.BookingGroup, error)
+	CancelGroupByAdmin(groupID uint) (*models.BookingGroup, error)
+	UpdateRoomingListByAdmin(groupID uint, entries []models.RoomingListEntry) (*models.BookingGroup, error)
+	AddExtraByAdmin(bookingID uint, staffID uint, extra models.BookingExtra) (*models.Booking, error) // Penjualan di front desk
+	RemoveExtraByAdmin(bookingID uint, bookingExtraID uint) (*models.Booking, error)
+	GetInvoiceByAdmin(bookingID uint) (*models.Invoice, error)
+
 	// Fitur Review/Ulasan (setelah booking selesai)
 	CreateReview(review *models.Review) (*models.Review, error)
-}
\ No newline at end of file
+}
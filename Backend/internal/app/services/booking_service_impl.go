@@ -3,21 +3,34 @@ package services
 import (
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
+	"crypto/rand"
+	"encoding/base32"
 	"errors"
+	"fmt"
+	"log"
 	"math"
+	"slices"
+	"time"
 
 	"github.com/araddon/dateparse"
 	"gorm.io/gorm"
 )
 
 type bookingServiceImpl struct {
-	bookingRepo repositories.BookingRepository
-	roomRepo    repositories.RoomRepository
-	reviewRepo  repositories.ReviewRepository
+	bookingRepo      repositories.BookingRepository
+	roomRepo         repositories.RoomRepository
+	reviewRepo       repositories.ReviewRepository
+	housekeepingRepo repositories.HousekeepingRepository
+	guestRepo        repositories.GuestRepository
+	paymentRepo      repositories.PaymentRepository
+	groupRepo        repositories.BookingGroupRepository
+	extraRepo        repositories.ExtraRepository
+	propertyRepo     repositories.PropertyRepository
+	waitlist         WaitlistService
 }
 
-func NewBookingService(bRepo repositories.BookingRepository, rRepo repositories.RoomRepository, revRepo repositories.ReviewRepository) BookingService {
-	return &bookingServiceImpl{bookingRepo: bRepo, roomRepo: rRepo, reviewRepo: revRepo}
+func NewBookingService(bRepo repositories.BookingRepository, rRepo repositories.RoomRepository, revRepo repositories.ReviewRepository, hRepo repositories.HousekeepingRepository, gRepo repositories.GuestRepository, pRepo repositories.PaymentRepository, grpRepo repositories.BookingGroupRepository, eRepo repositories.ExtraRepository, propRepo repositories.PropertyRepository, waitlist WaitlistService) BookingService {
+	return &bookingServiceImpl{bookingRepo: bRepo, roomRepo: rRepo, reviewRepo: revRepo, housekeepingRepo: hRepo, guestRepo: gRepo, paymentRepo: pRepo, groupRepo: grpRepo, extraRepo: eRepo, propertyRepo: propRepo, waitlist: waitlist}
 }
 
 // Helper: calculateTotalPrice menghitung total harga berdasarkan hari
@@ -26,7 +39,7 @@ func calculateTotalPrice(pricePerNight float64, checkInStr, checkOutStr string)
 	out, errOut := dateparse.ParseAny(checkOutStr)
 
 	if errIn != nil || errOut != nil {
-		return 0, errors.New("format tanggal check-in/out tidak valid")
+		return 0, models.ErrInvalidDateFormat
 	}
 
 	// Hitung durasi hari
@@ -35,12 +48,48 @@ func calculateTotalPrice(pricePerNight float64, checkInStr, checkOutStr string)
 
 	// Minimal 1 malam jika check-out > check-in
 	if days < 1 {
-		return 0, errors.New("durasi pemesanan minimal 1 malam")
+		return 0, models.ErrMinimumStay
 	}
 
 	return pricePerNight * days, nil
 }
 
+// priceBooking mengecek okupansi kamar lalu menghitung total harga booking:
+// tarif kamar + tamu di atas BaseOccupancy + extra bed (masing-masing per malam) + layanan tambahan
+func priceBooking(room *models.Room, booking *models.Booking) error {
+	party := booking.Party()
+	if err := room.CheckOccupancy(party); err != nil {
+		return err
+	}
+
+	checkIn, checkOut := booking.CheckInDate.Format("2006-01-02"), booking.CheckOutDate.Format("2006-01-02")
+	roomTotal, err := calculateTotalPrice(room.Price, checkIn, checkOut)
+	if err != nil {
+		return err
+	}
+	extraPerson, _ := calculateTotalPrice(room.ExtraPersonRate(party), checkIn, checkOut)
+	extraBed, _ := calculateTotalPrice(room.ExtraBedRate(party), checkIn, checkOut)
+
+	booking.ExtraPersonCharge = roundMoney(extraPerson)
+	booking.ExtraBedCharge = roundMoney(extraBed)
+	booking.TotalPrice = roundMoney(roomTotal + extraPerson + extraBed + priceExtras(booking))
+	return nil
+}
+
+// priceExtras menghitung ulang total setiap layanan tambahan dari jumlah malam & tamu booking.
+// ExtrasCharge diperbarui dan dikembalikan.
+func priceExtras(booking *models.Booking) float64 {
+	nights, guests := booking.Nights(), booking.Party().Guests()
+	total := 0.0
+	for i := range booking.Extras {
+		extra := &booking.Extras[i]
+		extra.Total = roundMoney(extra.UnitPrice * float64(extra.Quantity*extra.Units(nights, guests)))
+		total += extra.Total
+	}
+	booking.ExtrasCharge = roundMoney(total)
+	return booking.ExtrasCharge
+}
+
 // -------------------------------------------------------------------------
 // --- OPERASI MEMBER ---
 // -------------------------------------------------------------------------
@@ -51,38 +100,102 @@ func (s *bookingServiceImpl) CreateBooking(booking *models.Booking) (*models.Boo
 	room, err := s.roomRepo.FindByID(booking.RoomID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("kamar tidak ditemukan")
+			return nil, models.ErrRoomNotFound
 		}
 		return nil, err
 	}
 
+	// Tamu yang datang hari ini tidak boleh diberi kamar yang belum dibersihkan
+	if booking.CheckInDate.Format("2006-01-02") == today() && !models.IsRoomReady(room.HousekeepingStatus) {
+		return nil, models.ErrRoomNotReady
+	}
+
 	// 2. Cek Overlap (Fitur Pencegahan Double Booking)
-	isOverlap, err := s.bookingRepo.CheckOverlap(booking.RoomID, booking.CheckInDate.Format("2006-01-02"), booking.CheckOutDate.Format("2006-01-02"))
-	if err != nil {
+	if err := s.checkAvailability(booking.RoomID, booking.CheckInDate.Format("2006-01-02"), booking.CheckOutDate.Format("2006-01-02"), 0, booking.UserID); err != nil {
 		return nil, err
 	}
-	if isOverlap {
-		return nil, errors.New("kamar sudah dibooking pada periode tersebut")
-	}
 
-	// 3. Hitung Total Harga
-	totalPrice, err := calculateTotalPrice(room.Price, booking.CheckInDate.Format("2006-01-02"), booking.CheckOutDate.Format("2006-01-02"))
-	if err != nil {
+	// 3. Cek Okupansi & Hitung Total Harga (client lama hanya mengirim number_of_guests)
+	if booking.Adults == 0 {
+		booking.Adults = booking.NumberOfGuests - booking.Children
+	}
+	booking.SetParty(booking.Party())
+	for i := range booking.Extras {
+		if err := s.fillExtra(&booking.Extras[i], room.PropertyID, booking.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if err := priceBooking(room, booking); err != nil {
 		return nil, err
 	}
-	booking.TotalPrice = totalPrice
+	booking.PropertyID = room.PropertyID
 
 	// 4. Set Status Default
 	booking.PaymentStatus = models.StatusPending
 	booking.BookingStatus = models.StatusConfirmed
 
-	// 5. Simpan Transaksi
+	// 5. Tautkan ke profil tamu (dedup berdasarkan email / nomor identitas)
+	if err := s.linkGuest(booking); err != nil {
+		return nil, err
+	}
+
+	// 6. Simpan Transaksi
 	if err := s.bookingRepo.Create(booking); err != nil {
 		return nil, err
 	}
 	return booking, nil
 }
 
+// linkGuest menautkan booking ke profil tamu yang sudah ada atau membuat profil baru
+func (s *bookingServiceImpl) linkGuest(booking *models.Booking) error {
+	guest, changed, err := s.matchGuest(booking)
+	if err != nil {
+		return err
+	}
+	switch {
+	case guest.ID == 0:
+		err = s.guestRepo.Create(guest)
+	case changed:
+		err = s.guestRepo.Update(guest)
+	}
+	if err != nil {
+		return err
+	}
+	booking.GuestID = &guest.ID
+	return nil
+}
+
+// matchGuest mencari profil tamu untuk booking tanpa menyimpannya: profil baru (ID 0) jika belum ada.
+// Data kontak yang masih kosong di profil dilengkapi dari booking; nama di profil tidak ditimpa.
+// changed = profil lama dilengkapi dan perlu disimpan.
+func (s *bookingServiceImpl) matchGuest(booking *models.Booking) (*models.Guest, bool, error) {
+	email := normalizeEmail(booking.GuestEmail)
+	guest, err := s.guestRepo.FindMatch(email, string(booking.GuestIDNumber))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, err
+		}
+		return &models.Guest{
+			FullName: booking.GuestName,
+			Email:    email,
+			Phone:    booking.GuestPhone,
+			IDNumber: booking.GuestIDNumber,
+		}, false, nil
+	}
+
+	changed := false
+	if guest.Email == "" && email != "" {
+		guest.Email, changed = email, true
+	}
+	if guest.Phone == "" && booking.GuestPhone != "" {
+		guest.Phone, changed = booking.GuestPhone, true
+	}
+	if guest.IDNumber == "" && booking.GuestIDNumber != "" {
+		guest.IDNumber, changed = booking.GuestIDNumber, true
+	}
+	return guest, changed, nil
+}
+
 // GetUserBookings: Mengambil riwayat pemesanan member
 func (s *bookingServiceImpl) GetUserBookings(userID uint, pagination *models.Pagination) ([]models.Booking, error) {
 	return s.bookingRepo.FindByUserID(userID, pagination)
@@ -92,65 +205,360 @@ func (s *bookingServiceImpl) GetUserBookings(userID uint, pagination *models.Pag
 func (s *bookingServiceImpl) CancelBooking(bookingID uint, userID uint) error {
 	booking, err := s.bookingRepo.FindByID(bookingID)
 	if err != nil {
-		return errors.New("booking tidak ditemukan")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrBookingNotFound
+		}
+		return err
 	}
 
 	// Logika Bisnis: Hanya user yang bersangkutan yang boleh membatalkan
 	if booking.UserID != userID {
-		return errors.New("anda tidak memiliki izin membatalkan pemesanan ini")
+		return models.ErrBookingForbidden
 	}
 
 	// Logika Bisnis: Hanya boleh dibatalkan jika statusnya belum Completed atau sudah Cancelled
 	if booking.BookingStatus == models.StatusCompleted {
-		return errors.New("pemesanan yang sudah selesai tidak dapat dibatalkan")
+		return models.ErrBookingAlreadyCompleted
 	}
 
 	if booking.BookingStatus == models.StatusCancelled {
-		return errors.New("pemesanan sudah dibatalkan sebelumnya")
+		return models.ErrBookingAlreadyCancelled
 	}
 
 	// Update status ke cancelled
-	return s.bookingRepo.UpdateStatus(bookingID, models.StatusCancelled)
+	if err := s.bookingRepo.UpdateStatus(bookingID, models.StatusCancelled); err != nil {
+		return err
+	}
+	s.offerReleasedRooms()
+	return nil
+}
+
+// checkAvailability memastikan kamar tidak dibooking orang lain dan tidak sedang ditahan
+// untuk penawaran daftar tunggu member lain (member yang ditawari tetap boleh memesannya)
+func (s *bookingServiceImpl) checkAvailability(roomID uint, checkIn, checkOut string, excludeBookingID, userID uint) error {
+	isOverlap, err := s.bookingRepo.CheckOverlap(roomID, checkIn, checkOut, excludeBookingID)
+	if err != nil {
+		return err
+	}
+	if isOverlap {
+		return models.ErrRoomAlreadyBooked
+	}
+	isHeld, err := s.waitlist.IsHeld(roomID, checkIn, checkOut, userID)
+	if err != nil {
+		return err
+	}
+	if isHeld {
+		return models.ErrRoomAlreadyBooked
+	}
+	return nil
+}
+
+// offerReleasedRooms menawarkan kamar yang baru dilepas ke antrean daftar tunggu.
+// Pembatalan sudah tersimpan, jadi kegagalan hanya dicatat di log (scheduler akan mencoba lagi).
+func (s *bookingServiceImpl) offerReleasedRooms() {
+	if _, err := s.waitlist.ProcessWaitlist(time.Now()); err != nil {
+		log.Printf("⚠️ Gagal memproses daftar tunggu: %v", err)
+	}
+}
+
+// ClaimWaitlistOffer: Member mengklaim kamar yang ditawarkan dari daftar tunggu.
+// Kamar, tanggal, dan susunan tamu diambil dari penawaran; data tamu dari request.
+func (s *bookingServiceImpl) ClaimWaitlistOffer(token string, booking *models.Booking) (*models.Booking, error) {
+	entry, err := s.waitlist.FindOffer(token, booking.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	booking.RoomID = *entry.OfferedRoomID
+	booking.CheckInDate = entry.CheckInDate
+	booking.CheckOutDate = entry.CheckOutDate
+	booking.SetParty(entry.Party())
+	created, err := s.CreateBooking(booking)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.waitlist.CompleteClaim(entry, created.ID); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 // DeleteBooking: Menghapus booking yang cancelled dan belum dibayar
 func (s *bookingServiceImpl) DeleteBooking(bookingID uint, userID uint) error {
 	booking, err := s.bookingRepo.FindByID(bookingID)
 	if err != nil {
-		return errors.New("booking tidak ditemukan")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrBookingNotFound
+		}
+		return err
 	}
 
 	// Hanya user yang bersangkutan yang boleh menghapus
 	if booking.UserID != userID {
-		return errors.New("anda tidak memiliki izin menghapus pemesanan ini")
+		return models.ErrBookingForbidden
 	}
 
 	// Hanya bisa dihapus jika status cancelled
 	if booking.BookingStatus != models.StatusCancelled {
-		return errors.New("hanya booking yang cancelled yang bisa dihapus")
+		return models.ErrBookingNotCancelled
 	}
 
 	// Tidak bisa dihapus jika sudah dibayar
 	if booking.PaymentStatus == models.StatusPaid {
-		return errors.New("booking yang sudah dibayar tidak bisa dihapus")
+		return models.ErrBookingAlreadyPaid
 	}
 
 	// Hapus booking (soft delete)
 	return s.bookingRepo.Delete(bookingID)
 }
 
+// ModifyBooking: Mengubah booking milik member sendiri
+func (s *bookingServiceImpl) ModifyBooking(bookingID uint, userID uint, change *models.BookingChange) (*models.BookingModification, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	return s.modifyBooking(booking, userID, change)
+}
+
+// GetBookingModifications: Riwayat perubahan booking milik member sendiri
+func (s *bookingServiceImpl) GetBookingModifications(bookingID uint, userID uint) ([]models.BookingModification, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	return s.bookingRepo.FindModifications(bookingID)
+}
+
+// modifyBooking: cek ketersediaan (tanpa booking itu sendiri), hitung ulang harga, sesuaikan pembayaran, catat riwayat
+func (s *bookingServiceImpl) modifyBooking(booking *models.Booking, actorID uint, change *models.BookingChange) (*models.BookingModification, error) {
+	if booking.BookingStatus != models.StatusConfirmed {
+		return nil, models.ErrBookingNotModifiable
+	}
+
+	modification := &models.BookingModification{
+		BookingID:         booking.ID,
+		ModifiedBy:        actorID,
+		Reason:            change.Reason,
+		OldRoomID:         booking.RoomID,
+		OldCheckInDate:    booking.CheckInDate,
+		OldCheckOutDate:   booking.CheckOutDate,
+		OldNumberOfGuests: booking.NumberOfGuests,
+		OldAdults:         booking.Adults,
+		OldChildren:       booking.Children,
+		OldExtraBeds:      booking.ExtraBeds,
+		OldTotalPrice:     booking.TotalPrice,
+	}
+
+	// Field kosong = tetap
+	roomID, checkIn, checkOut, party := booking.RoomID, booking.CheckInDate, booking.CheckOutDate, booking.Party()
+	if change.RoomID != 0 {
+		roomID = change.RoomID
+	}
+	if !change.CheckInDate.IsZero() {
+		checkIn = change.CheckInDate
+	}
+	if !change.CheckOutDate.IsZero() {
+		checkOut = change.CheckOutDate
+	}
+	if change.Adults > 0 {
+		party.Adults = change.Adults
+	}
+	if change.Children != nil {
+		party.Children = *change.Children
+	}
+	if change.ExtraBeds != nil {
+		party.ExtraBeds = *change.ExtraBeds
+	}
+
+	inStr, outStr := checkIn.Format("2006-01-02"), checkOut.Format("2006-01-02")
+	roomChanged := roomID != booking.RoomID
+	checkInChanged := inStr != booking.CheckInDate.Format("2006-01-02")
+	checkOutChanged := outStr != booking.CheckOutDate.Format("2006-01-02")
+	if !roomChanged && !checkInChanged && !checkOutChanged && party == booking.Party() {
+		return nil, models.ErrBookingUnchanged
+	}
+
+	// Tamu yang sudah check-in hanya boleh memperpanjang/mempercepat check-out atau mengubah susunan tamu
+	now := today()
+	if booking.CheckedInAt != nil {
+		if roomChanged || checkInChanged {
+			return nil, models.ErrBookingInHouseChange
+		}
+	} else if booking.CheckInDate.Format("2006-01-02") < now || (checkInChanged && inStr < now) {
+		return nil, models.ErrModificationDatePast
+	}
+	if checkOutChanged && outStr < now {
+		return nil, models.ErrModificationDatePast
+	}
+
+	room, err := s.roomRepo.FindByID(roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+	if room.PropertyID != booking.PropertyID {
+		return nil, models.ErrRoomPropertyMismatch
+	}
+	if roomChanged && inStr == now && booking.CheckedInAt == nil && !models.IsRoomReady(room.HousekeepingStatus) {
+		return nil, models.ErrRoomNotReady
+	}
+
+	if err := s.checkAvailability(roomID, inStr, outStr, booking.ID, booking.UserID); err != nil {
+		return nil, err
+	}
+
+	booking.RoomID = roomID
+	booking.CheckInDate = checkIn
+	booking.CheckOutDate = checkOut
+	booking.SetParty(party)
+	if err := priceBooking(room, booking); err != nil {
+		return nil, err
+	}
+
+	payments, err := s.settlePriceChange(booking, modification.OldTotalPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	modification.NewRoomID = roomID
+	modification.NewCheckInDate = checkIn
+	modification.NewCheckOutDate = checkOut
+	modification.NewNumberOfGuests = booking.NumberOfGuests
+	modification.NewAdults = booking.Adults
+	modification.NewChildren = booking.Children
+	modification.NewExtraBeds = booking.ExtraBeds
+	modification.NewTotalPrice = booking.TotalPrice
+	modification.PriceDifference = roundMoney(booking.TotalPrice - modification.OldTotalPrice)
+
+	if err := s.bookingRepo.SaveModification(booking, modification, payments); err != nil {
+		return nil, err
+	}
+	// Kamar / malam yang dilepas bisa ditawarkan ke daftar tunggu
+	if roomChanged || checkInChanged || checkOutChanged {
+		s.offerReleasedRooms()
+	}
+	return modification, nil
+}
+
+// settlePriceChange menyesuaikan transaksi booking dengan total harga baru.
+// Tagihan pending lama dibatalkan; sisa kekurangan dari yang sudah dibayar ditagih (adjustment),
+// kelebihan bayar di-refund. Booking yang belum pernah dibayar cukup diperbarui tagihan pembayarannya.
+// Mengembalikan transaksi yang perlu disimpan (baru atau diubah); booking.PaymentStatus ikut diperbarui.
+func (s *bookingServiceImpl) settlePriceChange(booking *models.Booking, oldTotal float64) ([]*models.Payment, error) {
+	existing, err := s.paymentRepo.FindByBookingID(booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []*models.Payment
+	for i := range existing {
+		if existing[i].IsCharge() && existing[i].Status == models.StatusPending {
+			pending = append(pending, &existing[i])
+		}
+	}
+	paid := paidAmount(booking, existing, oldTotal)
+	balance := roundMoney(booking.TotalPrice - paid)
+
+	var changed []*models.Payment
+	// Belum ada yang dibayar: tagihan pembayaran yang masih pending cukup disesuaikan nominalnya
+	if paid <= 0 {
+		for i, payment := range pending {
+			if i == 0 {
+				payment.Amount = booking.TotalPrice
+			} else {
+				payment.Status = models.PaymentCancelled
+			}
+			changed = append(changed, payment)
+		}
+		return changed, nil
+	}
+
+	for _, payment := range pending {
+		payment.Status = models.PaymentCancelled
+		changed = append(changed, payment)
+	}
+
+	now := time.Now().Unix()
+	switch {
+	case balance > 0:
+		changed = append(changed, &models.Payment{
+			BookingID:     &booking.ID,
+			Type:          models.PaymentTypeAdjustment,
+			Amount:        balance,
+			PaymentMethod: booking.PaymentMethod,
+			Status:        models.StatusPending,
+			TransactionID: fmt.Sprintf("ADJ-%d-%d", booking.ID, now),
+		})
+		booking.PaymentStatus = models.StatusPending
+	case balance < 0:
+		changed = append(changed, &models.Payment{
+			BookingID:     &booking.ID,
+			Type:          models.PaymentTypeRefund,
+			Amount:        -balance,
+			PaymentMethod: booking.PaymentMethod,
+			Status:        models.StatusRefunded,
+			TransactionID: fmt.Sprintf("RFD-%d-%d", booking.ID, now),
+		})
+		booking.PaymentStatus = models.StatusPaid
+	default:
+		booking.PaymentStatus = models.StatusPaid
+	}
+	return changed, nil
+}
+
+// paidAmount menghitung uang yang sudah diterima untuk booking: transaksi sukses dikurangi refund.
+// Status paid tanpa transaksi sukses (ditandai manual oleh staf / dibayar lewat reservasi grup):
+// total dianggap sudah dibayar.
+func paidAmount(booking *models.Booking, payments []models.Payment, total float64) float64 {
+	var paid float64
+	for _, payment := range payments {
+		switch {
+		case payment.IsCharge() && payment.Status == "success":
+			paid += payment.Amount
+		case !payment.IsCharge() && (payment.Status == "success" || payment.Status == models.StatusRefunded):
+			paid -= payment.Amount
+		}
+	}
+	if paid <= 0 && booking.PaymentStatus == models.StatusPaid {
+		paid = total
+	}
+	return roundMoney(paid)
+}
+
+// roundMoney membulatkan nominal ke 2 desimal (sesuai kolom decimal(10,2))
+func roundMoney(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
 // -------------------------------------------------------------------------
 // --- OPERASI ADMIN ---
 // -------------------------------------------------------------------------
 
-// GetAllBookings: Mengambil semua riwayat booking
-func (s *bookingServiceImpl) GetAllBookings(pagination *models.Pagination) ([]models.Booking, error) {
-	return s.bookingRepo.FindAll(pagination)
+// GetAllBookings: Mengambil semua riwayat booking (opsional difilter per properti)
+func (s *bookingServiceImpl) GetAllBookings(filter *models.BookingFilter, pagination *models.Pagination) ([]models.Booking, error) {
+	return s.bookingRepo.FindAll(filter, pagination)
 }
 
 // GetBookingByID: Mengambil detail booking berdasarkan ID
 func (s *bookingServiceImpl) GetBookingByID(bookingID uint) (*models.Booking, error) {
-	return s.bookingRepo.FindByID(bookingID)
+	booking, err := s.bookingRepo.FindByID(bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	return booking, nil
 }
 
 // UpdateBooking: Update booking data
@@ -165,6 +573,9 @@ func (s *bookingServiceImpl) UpdateBooking(booking *models.Booking) (*models.Boo
 func (s *bookingServiceImpl) UpdatePaymentStatus(bookingID uint, newStatus string) (*models.Booking, error) {
 	booking, err := s.bookingRepo.FindByID(bookingID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
 		return nil, err
 	}
 
@@ -176,6 +587,601 @@ func (s *bookingServiceImpl) UpdatePaymentStatus(bookingID uint, newStatus strin
 	return booking, nil
 }
 
+// UpdateBookingStatus: Mengubah status booking.
+// Booking yang menjadi completed dianggap check-out: kamar ditandai dirty dan tugas housekeeping dibuat.
+func (s *bookingServiceImpl) UpdateBookingStatus(bookingID uint, newStatus string) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkedOut := newStatus == models.StatusCompleted && booking.BookingStatus != models.StatusCompleted
+	cancelled := newStatus == models.StatusCancelled && booking.BookingStatus != models.StatusCancelled
+	booking.BookingStatus = newStatus
+	if err := s.bookingRepo.Update(booking); err != nil {
+		return nil, err
+	}
+	if cancelled {
+		s.offerReleasedRooms()
+	}
+
+	if checkedOut {
+		task := models.HousekeepingTask{
+			RoomID:     booking.RoomID,
+			PropertyID: booking.PropertyID,
+			BookingID:  &booking.ID,
+			TaskDate:   dateOnly(time.Now()),
+			Type:       models.TaskTypeCheckout,
+			Status:     models.TaskPending,
+		}
+		if _, err := s.housekeepingRepo.CreateTasks([]models.HousekeepingTask{task}); err != nil {
+			return nil, err
+		}
+	}
+	return booking, nil
+}
+
+// CheckInBooking: Mencatat kedatangan tamu (Front Desk)
+func (s *bookingServiceImpl) CheckInBooking(bookingID uint) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	if booking.CheckedInAt != nil {
+		return nil, models.ErrBookingAlreadyCheckedIn
+	}
+	// Hanya booking confirmed, dan hanya mulai tanggal check-in sampai sebelum tanggal check-out
+	now := today()
+	if booking.BookingStatus != models.StatusConfirmed ||
+		now < booking.CheckInDate.Format("2006-01-02") || now >= booking.CheckOutDate.Format("2006-01-02") {
+		return nil, models.ErrCheckInNotAllowed
+	}
+
+	room, err := s.roomRepo.FindByID(booking.RoomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+	if !models.IsRoomReady(room.HousekeepingStatus) {
+		return nil, models.ErrRoomNotReady
+	}
+
+	checkedInAt := time.Now()
+	booking.CheckedInAt = &checkedInAt
+	if err := s.bookingRepo.Update(booking); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// ModifyBookingByAdmin: Mengubah booking oleh staf (akses properti dicek di handler)
+func (s *bookingServiceImpl) ModifyBookingByAdmin(bookingID uint, staffID uint, change *models.BookingChange) (*models.BookingModification, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	return s.modifyBooking(booking, staffID, change)
+}
+
+// GetBookingModificationsByAdmin: Riwayat perubahan booking untuk staf
+func (s *bookingServiceImpl) GetBookingModificationsByAdmin(bookingID uint) ([]models.BookingModification, error) {
+	if _, err := s.GetBookingByID(bookingID); err != nil {
+		return nil, err
+	}
+	return s.bookingRepo.FindModifications(bookingID)
+}
+
+// -------------------------------------------------------------------------
+// --- RESERVASI GRUP ---
+// -------------------------------------------------------------------------
+
+// CreateGroupBooking: Membuat reservasi beberapa kamar sekaligus dengan satu tamu utama.
+// Setiap kamar dicek dan dihitung seperti CreateBooking; satu kamar gagal = seluruh grup ditolak.
+func (s *bookingServiceImpl) CreateGroupBooking(group *models.BookingGroup, rooms []models.GroupRoom) (*models.BookingGroup, error) {
+	if len(rooms) < 2 || len(rooms) > models.MaxGroupRooms {
+		return nil, models.ErrGroupRoomCount
+	}
+
+	// 1. Cek dan hitung setiap kamar; kamar tanpa nama penghuni memakai data tamu utama
+	bookings := make([]models.Booking, 0, len(rooms))
+	for i, request := range rooms {
+		room, err := s.roomRepo.FindByID(request.RoomID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, models.ErrRoomNotFound
+			}
+			return nil, err
+		}
+		if i == 0 {
+			group.PropertyID = room.PropertyID
+		} else if room.PropertyID != group.PropertyID {
+			return nil, models.ErrGroupPropertyMismatch
+		}
+
+		inStr, outStr := request.CheckInDate.Format("2006-01-02"), request.CheckOutDate.Format("2006-01-02")
+		if inStr == today() && !models.IsRoomReady(room.HousekeepingStatus) {
+			return nil, models.ErrRoomNotReady
+		}
+
+		// Kamar yang sama boleh diminta dua kali hanya jika tanggalnya tidak bertumpuk
+		for _, previous := range rooms[:i] {
+			if previous.RoomID == request.RoomID &&
+				previous.CheckOutDate.Format("2006-01-02") > inStr && previous.CheckInDate.Format("2006-01-02") < outStr {
+				return nil, models.ErrGroupRoomConflict
+			}
+		}
+		if err := s.checkAvailability(request.RoomID, inStr, outStr, 0, group.UserID); err != nil {
+			return nil, err
+		}
+
+		booking := models.Booking{
+			UserID:          group.UserID,
+			RoomID:          request.RoomID,
+			PropertyID:      room.PropertyID,
+			CheckInDate:     request.CheckInDate,
+			CheckOutDate:    request.CheckOutDate,
+			PaymentMethod:   group.PaymentMethod,
+			PaymentStatus:   models.StatusPending,
+			BookingStatus:   models.StatusConfirmed,
+			GuestName:       group.LeadGuestName,
+			GuestEmail:      group.LeadGuestEmail,
+			GuestPhone:      group.LeadGuestPhone,
+			SpecialRequests: group.SpecialRequests,
+		}
+		if request.GuestName != "" {
+			booking.GuestName = request.GuestName
+		}
+		booking.SetParty(request.Party)
+		if err := priceBooking(room, &booking); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+
+	// 2. Tautkan tamu utama ke profil tamu; penghuni lain belum punya profil
+	// sampai datanya dilengkapi lewat rooming list. Profil baru/dilengkapi disimpan bersama grup,
+	// dan LeadGuestID menunjuk ke leadGuest.ID sehingga ID profil baru ikut terisi saat disimpan.
+	leadGuest, changed, err := s.matchGuest(&models.Booking{
+		GuestName:  group.LeadGuestName,
+		GuestEmail: group.LeadGuestEmail,
+		GuestPhone: group.LeadGuestPhone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	group.LeadGuestID = &leadGuest.ID
+	for i := range bookings {
+		if bookings[i].GuestName == group.LeadGuestName {
+			bookings[i].GuestID = group.LeadGuestID
+		}
+	}
+	if leadGuest.ID != 0 && !changed {
+		leadGuest = nil
+	}
+
+	// 3. Simpan profil tamu utama, grup, dan seluruh kamar dalam satu transaksi
+	code, err := generateGroupCode()
+	if err != nil {
+		return nil, err
+	}
+	group.Code = code
+	group.Status = models.StatusConfirmed
+	group.PaymentStatus = models.StatusPending
+	group.Bookings = bookings
+	if err := s.groupRepo.Create(group, leadGuest); err != nil {
+		return nil, err
+	}
+	fillGroupTotal(group)
+	return group, nil
+}
+
+// generateGroupCode membuat kode reservasi grup, contoh "GRP-K3T9QZ2M" (40 bit acak)
+func generateGroupCode() (string, error) {
+	raw := make([]byte, 5)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return "GRP-" + base32.StdEncoding.EncodeToString(raw), nil
+}
+
+// fillGroupTotal mengisi total harga grup dari kamar yang tidak dibatalkan
+func fillGroupTotal(group *models.BookingGroup) {
+	total := 0.0
+	for _, booking := range group.ActiveBookings() {
+		total += booking.TotalPrice
+	}
+	group.TotalPrice = roundMoney(total)
+}
+
+// GetUserGroups: Daftar reservasi grup milik member
+func (s *bookingServiceImpl) GetUserGroups(userID uint, pagination *models.Pagination) ([]models.BookingGroup, error) {
+	return s.GetAllGroups(&models.BookingGroupFilter{UserID: userID}, pagination)
+}
+
+// GetUserGroup: Detail reservasi grup milik member sendiri
+func (s *bookingServiceImpl) GetUserGroup(groupID uint, userID uint) (*models.BookingGroup, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	return group, nil
+}
+
+// CancelGroup: Member membatalkan seluruh kamar dalam reservasi grup
+func (s *bookingServiceImpl) CancelGroup(groupID uint, userID uint) (*models.BookingGroup, error) {
+	group, err := s.GetUserGroup(groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.cancelGroup(group)
+}
+
+// UpdateRoomingList: Member melengkapi data penghuni tiap kamar
+func (s *bookingServiceImpl) UpdateRoomingList(groupID uint, userID uint, entries []models.RoomingListEntry) (*models.BookingGroup, error) {
+	group, err := s.GetUserGroup(groupID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateRoomingList(group, entries)
+}
+
+// cancelGroup membatalkan grup beserta kamar yang belum dibatalkan.
+// Ditolak jika ada kamar yang tamunya sudah check-in atau sudah selesai; batalkan per kamar sebagai gantinya.
+func (s *bookingServiceImpl) cancelGroup(group *models.BookingGroup) (*models.BookingGroup, error) {
+	if group.Status == models.StatusCancelled {
+		return nil, models.ErrGroupAlreadyCancelled
+	}
+	active := group.ActiveBookings()
+	for _, booking := range active {
+		if booking.CheckedInAt != nil || booking.BookingStatus == models.StatusCompleted {
+			return nil, models.ErrGroupNotCancellable
+		}
+	}
+
+	group.Status = models.StatusCancelled
+	for _, booking := range active {
+		booking.BookingStatus = models.StatusCancelled
+	}
+	if err := s.groupRepo.Save(group, active); err != nil {
+		return nil, err
+	}
+	s.offerReleasedRooms()
+	fillGroupTotal(group)
+	return group, nil
+}
+
+// updateRoomingList mengganti data penghuni kamar grup. Profil tamu ditautkan hanya jika email atau
+// nomor identitas diisi; kontak yang kosong diisi kontak tamu utama agar front desk tetap bisa menghubungi.
+func (s *bookingServiceImpl) updateRoomingList(group *models.BookingGroup, entries []models.RoomingListEntry) (*models.BookingGroup, error) {
+	if group.Status == models.StatusCancelled {
+		return nil, models.ErrGroupAlreadyCancelled
+	}
+
+	rooms := make(map[uint]*models.Booking)
+	for _, booking := range group.ActiveBookings() {
+		if booking.BookingStatus == models.StatusConfirmed {
+			rooms[booking.ID] = booking
+		}
+	}
+
+	changed := make([]*models.Booking, 0, len(entries))
+	for _, entry := range entries {
+		booking, ok := rooms[entry.BookingID]
+		if !ok {
+			return nil, models.ErrRoomingListBooking
+		}
+
+		occupant := &models.Booking{
+			GuestName:     entry.GuestName,
+			GuestEmail:    entry.GuestEmail,
+			GuestPhone:    models.SensitiveString(entry.GuestPhone),
+			GuestIDNumber: models.SensitiveString(entry.GuestIDNumber),
+		}
+		switch {
+		case entry.GuestEmail != "" || entry.GuestIDNumber != "":
+			if err := s.linkGuest(occupant); err != nil {
+				return nil, err
+			}
+		case entry.GuestName == group.LeadGuestName:
+			occupant.GuestID = group.LeadGuestID
+		}
+
+		booking.GuestID = occupant.GuestID
+		booking.GuestName = entry.GuestName
+		booking.GuestEmail = entry.GuestEmail
+		if booking.GuestEmail == "" {
+			booking.GuestEmail = group.LeadGuestEmail
+		}
+		booking.GuestPhone = occupant.GuestPhone
+		if booking.GuestPhone == "" {
+			booking.GuestPhone = group.LeadGuestPhone
+		}
+		booking.GuestIDNumber = occupant.GuestIDNumber
+		changed = append(changed, booking)
+	}
+
+	if err := s.groupRepo.Save(group, changed); err != nil {
+		return nil, err
+	}
+	fillGroupTotal(group)
+	return group, nil
+}
+
+// GetAllGroups: Daftar reservasi grup (admin: difilter per properti)
+func (s *bookingServiceImpl) GetAllGroups(filter *models.BookingGroupFilter, pagination *models.Pagination) ([]models.BookingGroup, error) {
+	groups, err := s.groupRepo.FindAll(filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		fillGroupTotal(&groups[i])
+	}
+	return groups, nil
+}
+
+// GetGroupByID: Detail reservasi grup beserta rooming list
+func (s *bookingServiceImpl) GetGroupByID(groupID uint) (*models.BookingGroup, error) {
+	group, err := s.groupRepo.FindByID(groupID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrGroupNotFound
+		}
+		return nil, err
+	}
+	fillGroupTotal(group)
+	return group, nil
+}
+
+// CancelGroupByAdmin: Membatalkan reservasi grup oleh staf (akses properti dicek di handler)
+func (s *bookingServiceImpl) CancelGroupByAdmin(groupID uint) (*models.BookingGroup, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.cancelGroup(group)
+}
+
+// UpdateRoomingListByAdmin: Front desk mengisi rooming list (akses properti dicek di handler)
+func (s *bookingServiceImpl) UpdateRoomingListByAdmin(groupID uint, entries []models.RoomingListEntry) (*models.BookingGroup, error) {
+	group, err := s.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateRoomingList(group, entries)
+}
+
+// -------------------------------------------------------------------------
+// --- LAYANAN TAMBAHAN & TAGIHAN ---
+// -------------------------------------------------------------------------
+
+// AddExtra: Member menambah layanan tambahan ke booking sendiri
+func (s *bookingServiceImpl) AddExtra(bookingID uint, userID uint, extra models.BookingExtra) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	return s.addExtra(booking, userID, extra)
+}
+
+// RemoveExtra: Member menghapus layanan tambahan yang ia tambahkan sendiri, selama belum check-in
+func (s *bookingServiceImpl) RemoveExtra(bookingID uint, userID uint, bookingExtraID uint) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	index, err := findBookingExtra(booking, bookingExtraID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Extras[index].AddedBy != userID || booking.CheckedInAt != nil {
+		return nil, models.ErrBookingExtraForbidden
+	}
+	return s.removeExtra(booking, index)
+}
+
+// GetInvoice: Rincian tagihan booking sendiri
+func (s *bookingServiceImpl) GetInvoice(bookingID uint, userID uint) (*models.Invoice, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	return s.buildInvoice(booking)
+}
+
+// AddExtraByAdmin: Penjualan layanan tambahan di front desk (akses properti dicek di handler)
+func (s *bookingServiceImpl) AddExtraByAdmin(bookingID uint, staffID uint, extra models.BookingExtra) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	return s.addExtra(booking, staffID, extra)
+}
+
+// RemoveExtraByAdmin: Staf menghapus layanan tambahan dari booking (akses properti dicek di handler)
+func (s *bookingServiceImpl) RemoveExtraByAdmin(bookingID uint, bookingExtraID uint) (*models.Booking, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	index, err := findBookingExtra(booking, bookingExtraID)
+	if err != nil {
+		return nil, err
+	}
+	return s.removeExtra(booking, index)
+}
+
+// GetInvoiceByAdmin: Rincian tagihan booking untuk staf (akses properti dicek di handler)
+func (s *bookingServiceImpl) GetInvoiceByAdmin(bookingID uint) (*models.Invoice, error) {
+	booking, err := s.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	return s.buildInvoice(booking)
+}
+
+// fillExtra melengkapi layanan tambahan booking dari katalog. Layanan harus dijual di properti booking;
+// nama, cara hitung, dan harga disalin agar perubahan katalog tidak mengubah tagihan.
+func (s *bookingServiceImpl) fillExtra(extra *models.BookingExtra, propertyID uint, actorID uint) error {
+	if extra.Quantity < 1 || extra.Quantity > models.MaxExtraQuantity {
+		return models.ErrInvalidExtraQuantity
+	}
+	item, err := s.extraRepo.FindByID(extra.ExtraID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrExtraNotFound
+		}
+		return err
+	}
+	if item.PropertyID != propertyID {
+		return models.ErrExtraPropertyMismatch
+	}
+	if !item.IsActive {
+		return models.ErrExtraInactive
+	}
+
+	extra.Name = item.Name
+	extra.PricingType = item.PricingType
+	extra.UnitPrice = item.Price
+	extra.AddedBy = actorID
+	return nil
+}
+
+// findBookingExtra mencari posisi layanan tambahan di booking
+func findBookingExtra(booking *models.Booking, bookingExtraID uint) (int, error) {
+	for i := range booking.Extras {
+		if booking.Extras[i].ID == bookingExtraID {
+			return i, nil
+		}
+	}
+	return 0, models.ErrBookingExtraNotFound
+}
+
+// addExtra menambah layanan tambahan ke booking yang masih confirmed (termasuk tamu yang sedang menginap)
+func (s *bookingServiceImpl) addExtra(booking *models.Booking, actorID uint, extra models.BookingExtra) (*models.Booking, error) {
+	if booking.BookingStatus != models.StatusConfirmed {
+		return nil, models.ErrBookingNotModifiable
+	}
+	if err := s.fillExtra(&extra, booking.PropertyID, actorID); err != nil {
+		return nil, err
+	}
+	booking.Extras = append(booking.Extras, extra)
+	return s.saveExtras(booking, nil)
+}
+
+// removeExtra menghapus layanan tambahan ke-index dari booking yang masih confirmed
+func (s *bookingServiceImpl) removeExtra(booking *models.Booking, index int) (*models.Booking, error) {
+	if booking.BookingStatus != models.StatusConfirmed {
+		return nil, models.ErrBookingNotModifiable
+	}
+	removedID := booking.Extras[index].ID
+	booking.Extras = slices.Delete(booking.Extras, index, index+1)
+	return s.saveExtras(booking, []uint{removedID})
+}
+
+// saveExtras menghitung ulang total booking setelah layanan tambahan berubah (tarif kamar tidak dihitung ulang)
+// lalu menyesuaikan pembayaran seperti perubahan booking: kekurangan ditagih, kelebihan di-refund
+func (s *bookingServiceImpl) saveExtras(booking *models.Booking, removedIDs []uint) (*models.Booking, error) {
+	oldTotal, oldExtras := booking.TotalPrice, booking.ExtrasCharge
+	extras := priceExtras(booking)
+	booking.TotalPrice = roundMoney(oldTotal - oldExtras + extras)
+
+	payments, err := s.settlePriceChange(booking, oldTotal)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.bookingRepo.SaveExtras(booking, removedIDs, payments); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// buildInvoice menyusun rincian tagihan booking. Baris kamar adalah sisa total setelah biaya lain
+// sehingga jumlah seluruh baris selalu sama dengan TotalPrice walau tarif kamar sudah berubah.
+func (s *bookingServiceImpl) buildInvoice(booking *models.Booking) (*models.Invoice, error) {
+	property, err := s.propertyRepo.FindByID(booking.PropertyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPropertyNotFound
+		}
+		return nil, err
+	}
+	payments, err := s.paymentRepo.FindByBookingID(booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kamar yang sudah dihapus tetap ditagih, hanya tanpa nomor & tipe kamar
+	description := ""
+	room, err := s.roomRepo.FindByID(booking.RoomID)
+	switch {
+	case err == nil:
+		description = room.RoomNumber + " - " + room.Type
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+
+	nights, guests := booking.Nights(), booking.Party().Guests()
+	perNight := func(lineType, description string, amount float64) models.InvoiceLine {
+		return models.InvoiceLine{
+			Type:        lineType,
+			Description: description,
+			Quantity:    nights,
+			UnitPrice:   roundMoney(amount / float64(nights)),
+			Amount:      amount,
+		}
+	}
+
+	roomCharge := roundMoney(booking.TotalPrice - booking.ExtraPersonCharge - booking.ExtraBedCharge - booking.ExtrasCharge)
+	lines := []models.InvoiceLine{perNight(models.InvoiceLineRoom, description, roomCharge)}
+	if booking.ExtraPersonCharge > 0 {
+		lines = append(lines, perNight(models.InvoiceLineExtraPerson, "", booking.ExtraPersonCharge))
+	}
+	if booking.ExtraBedCharge > 0 {
+		lines = append(lines, perNight(models.InvoiceLineExtraBed, "", booking.ExtraBedCharge))
+	}
+	for _, extra := range booking.Extras {
+		lines = append(lines, models.InvoiceLine{
+			Type:        models.InvoiceLineExtra,
+			Description: extra.Name,
+			Quantity:    extra.Quantity * extra.Units(nights, guests),
+			UnitPrice:   extra.UnitPrice,
+			Amount:      extra.Total,
+		})
+	}
+
+	paid := paidAmount(booking, payments, booking.TotalPrice)
+	return &models.Invoice{
+		BookingID:    booking.ID,
+		PropertyID:   property.ID,
+		PropertyName: property.Name,
+		Currency:     property.Currency,
+		GuestName:    booking.GuestName,
+		CheckInDate:  booking.CheckInDate,
+		CheckOutDate: booking.CheckOutDate,
+		Nights:       nights,
+		Lines:        lines,
+		Total:        booking.TotalPrice,
+		Paid:         paid,
+		Balance:      roundMoney(booking.TotalPrice - paid),
+		Payments:     payments,
+	}, nil
+}
+
 // -------------------------------------------------------------------------
 // --- FITUR ULASAN ---
 // -------------------------------------------------------------------------
@@ -185,22 +1191,25 @@ func (s *bookingServiceImpl) CreateReview(review *models.Review) (*models.Review
 	// 1. Validasi: Pastikan Booking ID ada
 	booking, err := s.bookingRepo.FindByID(review.BookingID)
 	if err != nil {
-		return nil, errors.New("booking terkait tidak ditemukan")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
 	}
 
 	// 2. Validasi: Pastikan Booking sudah selesai (Completed)
 	if booking.BookingStatus != models.StatusCompleted {
-		return nil, errors.New("ulasan hanya dapat dibuat untuk pemesanan yang sudah selesai")
+		return nil, models.ErrReviewBookingNotCompleted
 	}
 
 	// 3. Validasi: Pastikan Rating antara 1 sampai 5
 	if review.Rating < 1 || review.Rating > 5 {
-		return nil, errors.New("rating harus antara 1 sampai 5")
+		return nil, models.ErrInvalidRating
 	}
 
 	// 4. Validasi: Pastikan hanya 1 review per booking
 	if _, err := s.reviewRepo.FindByBookingID(review.BookingID); err == nil {
-		return nil, errors.New("anda sudah memberikan ulasan untuk pemesanan ini")
+		return nil, models.ErrReviewAlreadyExists
 	}
 
 	// Set UserID dari Booking
@@ -0,0 +1,16 @@
+package services
+
+import "backend/internal/domain/models"
+
+// ExtraService mendefinisikan kontrak untuk katalog layanan tambahan per properti
+type ExtraService interface {
+	// Untuk Publik (pilihan saat booking)
+	GetPropertyExtras(propertyID uint) ([]models.Extra, error) // Hanya yang sedang dijual
+
+	// Untuk Admin
+	GetAllExtras(filter *models.ExtraFilter) ([]models.Extra, error)
+	GetExtraByID(extraID uint) (*models.Extra, error)
+	CreateExtra(extra *models.Extra) (*models.Extra, error) // PropertyID 0 = properti default
+	UpdateExtra(extra *models.Extra) (*models.Extra, error)
+	DeleteExtra(extraID uint) error
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+type extraServiceImpl struct {
+	extraRepo    repositories.ExtraRepository
+	propertyRepo repositories.PropertyRepository
+}
+
+func NewExtraService(extraRepo repositories.ExtraRepository, propertyRepo repositories.PropertyRepository) ExtraService {
+	return &extraServiceImpl{extraRepo: extraRepo, propertyRepo: propertyRepo}
+}
+
+// GetPropertyExtras: Layanan tambahan yang bisa dipilih tamu di satu properti
+func (s *extraServiceImpl) GetPropertyExtras(propertyID uint) ([]models.Extra, error) {
+	if _, err := s.propertyRepo.FindByID(propertyID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPropertyNotFound
+		}
+		return nil, err
+	}
+	return s.extraRepo.FindAll(&models.ExtraFilter{PropertyID: propertyID, ActiveOnly: true})
+}
+
+// GetAllExtras: Katalog layanan tambahan termasuk yang nonaktif (Admin)
+func (s *extraServiceImpl) GetAllExtras(filter *models.ExtraFilter) ([]models.Extra, error) {
+	return s.extraRepo.FindAll(filter)
+}
+
+// GetExtraByID: Mengambil detail layanan tambahan
+func (s *extraServiceImpl) GetExtraByID(extraID uint) (*models.Extra, error) {
+	extra, err := s.extraRepo.FindByID(extraID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrExtraNotFound
+		}
+		return nil, err
+	}
+	return extra, nil
+}
+
+// CreateExtra: Menambah layanan tambahan ke katalog properti (Admin)
+func (s *extraServiceImpl) CreateExtra(extra *models.Extra) (*models.Extra, error) {
+	if !models.IsValidPricingType(extra.PricingType) || extra.Price < 0 {
+		return nil, models.ErrInvalidExtraPricing
+	}
+
+	var property *models.Property
+	var err error
+	if extra.PropertyID == 0 {
+		property, err = s.propertyRepo.FindDefault()
+	} else {
+		property, err = s.propertyRepo.FindByID(extra.PropertyID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPropertyNotFound
+		}
+		return nil, err
+	}
+	extra.PropertyID = property.ID
+
+	if err := s.extraRepo.Create(extra); err != nil {
+		return nil, err
+	}
+	return extra, nil
+}
+
+// UpdateExtra: Mengubah layanan tambahan (Admin). Perubahan harga hanya berlaku untuk
+// penambahan berikutnya; booking yang sudah memesan tetap memakai harga lama.
+func (s *extraServiceImpl) UpdateExtra(extra *models.Extra) (*models.Extra, error) {
+	if !models.IsValidPricingType(extra.PricingType) || extra.Price < 0 {
+		return nil, models.ErrInvalidExtraPricing
+	}
+	if _, err := s.GetExtraByID(extra.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.extraRepo.Update(extra); err != nil {
+		return nil, err
+	}
+	return extra, nil
+}
+
+// DeleteExtra: Menghapus layanan tambahan dari katalog (Admin); booking lama tidak berubah
+func (s *extraServiceImpl) DeleteExtra(extraID uint) error {
+	if _, err := s.GetExtraByID(extraID); err != nil {
+		return err
+	}
+	return s.extraRepo.Delete(extraID)
+}
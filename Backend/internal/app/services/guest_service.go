@@ -0,0 +1,15 @@
+package services
+
+import "backend/internal/domain/models"
+
+// GuestService mendefinisikan kontrak untuk profil tamu (terpisah dari akun user)
+type GuestService interface {
+	// Untuk Staf (scope membatasi tamu yang pernah booking di properti yang dikelola)
+	GetGuests(filter *models.GuestFilter, pagination *models.Pagination) ([]models.Guest, error)
+	GetGuestByID(guestID uint, scope *models.PropertyScope) (*models.Guest, error) // Termasuk statistik menginap
+	GetGuestBookings(guestID uint, scope *models.PropertyScope, pagination *models.Pagination) ([]models.Booking, error)
+	UpdateGuest(guest *models.Guest) (*models.Guest, error)
+
+	// MergeGuests menggabungkan profil duplikat ke profil target; booking ikut dipindahkan
+	MergeGuests(targetID uint, sourceIDs []uint, scope *models.PropertyScope) (*models.Guest, error)
+}
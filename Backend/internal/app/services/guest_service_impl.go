@@ -0,0 +1,179 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+type guestServiceImpl struct {
+	guestRepo   repositories.GuestRepository
+	bookingRepo repositories.BookingRepository
+}
+
+func NewGuestService(gRepo repositories.GuestRepository, bRepo repositories.BookingRepository) GuestService {
+	return &guestServiceImpl{guestRepo: gRepo, bookingRepo: bRepo}
+}
+
+// Helper: normalizeEmail menyamakan format email agar deduplikasi tidak peka huruf besar
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Helper: scopeIDs mengembalikan properti dari scope (nil = semua properti)
+func scopeIDs(scope *models.PropertyScope) []uint {
+	if scope.IsGlobal() {
+		return nil
+	}
+	return scope.PropertyIDs
+}
+
+func (s *guestServiceImpl) GetGuests(filter *models.GuestFilter, pagination *models.Pagination) ([]models.Guest, error) {
+	return s.guestRepo.FindAll(filter, pagination)
+}
+
+// findGuest mengambil profil tamu dan memastikan tamu pernah booking di properti dalam scope
+func (s *guestServiceImpl) findGuest(guestID uint, scope *models.PropertyScope) (*models.Guest, error) {
+	guest, err := s.guestRepo.FindByID(guestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrGuestNotFound
+		}
+		return nil, err
+	}
+
+	if !scope.IsGlobal() {
+		allowed, err := s.guestRepo.HasBookingIn(guestID, scope.PropertyIDs)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, models.ErrPropertyForbidden
+		}
+	}
+	return guest, nil
+}
+
+// GetGuestByID: Detail tamu beserta jumlah menginap dan total belanja (dalam scope properti)
+func (s *guestServiceImpl) GetGuestByID(guestID uint, scope *models.PropertyScope) (*models.Guest, error) {
+	guest, err := s.findGuest(guestID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.guestRepo.Stats(guestID, scopeIDs(scope))
+	if err != nil {
+		return nil, err
+	}
+	guest.Stats = stats
+	return guest, nil
+}
+
+// GetGuestBookings: Riwayat menginap tamu (dalam scope properti)
+func (s *guestServiceImpl) GetGuestBookings(guestID uint, scope *models.PropertyScope, pagination *models.Pagination) ([]models.Booking, error) {
+	if _, err := s.findGuest(guestID, scope); err != nil {
+		return nil, err
+	}
+
+	filter := &models.BookingFilter{GuestID: guestID, PropertyIDs: scopeIDs(scope)}
+	return s.bookingRepo.FindAll(filter, pagination)
+}
+
+// UpdateGuest: Mengubah profil tamu. Email/nomor identitas milik profil lain ditolak
+// agar duplikat diselesaikan lewat merge (riwayat booking ikut dipindahkan).
+func (s *guestServiceImpl) UpdateGuest(guest *models.Guest) (*models.Guest, error) {
+	guest.Email = normalizeEmail(guest.Email)
+
+	if err := s.checkUnique(guest.ID, guest.Email, ""); err != nil {
+		return nil, err
+	}
+	if err := s.checkUnique(guest.ID, "", string(guest.IDNumber)); err != nil {
+		return nil, err
+	}
+
+	if err := s.guestRepo.Update(guest); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+// checkUnique menolak email atau nomor identitas yang sudah dipakai profil lain
+func (s *guestServiceImpl) checkUnique(guestID uint, email, idNumber string) error {
+	if email == "" && idNumber == "" {
+		return nil
+	}
+	other, err := s.guestRepo.FindMatch(email, idNumber)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if other.ID != guestID {
+		return models.ErrGuestAlreadyExists
+	}
+	return nil
+}
+
+// MergeGuests: Menggabungkan profil duplikat ke profil target.
+// Data kosong di target diisi dari profil sumber, status VIP dipertahankan,
+// preferensi & catatan digabung, lalu profil sumber dihapus.
+func (s *guestServiceImpl) MergeGuests(targetID uint, sourceIDs []uint, scope *models.PropertyScope) (*models.Guest, error) {
+	slices.Sort(sourceIDs)
+	sourceIDs = slices.Compact(sourceIDs)
+	if len(sourceIDs) == 0 || slices.Contains(sourceIDs, targetID) {
+		return nil, models.ErrInvalidGuestMerge
+	}
+
+	target, err := s.findGuest(targetID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := s.guestRepo.FindByIDs(sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) != len(sourceIDs) {
+		return nil, models.ErrGuestNotFound
+	}
+
+	for _, source := range sources {
+		if _, err := s.findGuest(source.ID, scope); err != nil {
+			return nil, err
+		}
+		if target.Email == "" {
+			target.Email = source.Email
+		}
+		if target.Phone == "" {
+			target.Phone = source.Phone
+		}
+		if target.IDNumber == "" {
+			target.IDNumber = source.IDNumber
+		}
+		target.IsVIP = target.IsVIP || source.IsVIP
+		target.Preferences = appendNote(target.Preferences, source.Preferences)
+		target.Notes = appendNote(target.Notes, source.Notes)
+	}
+
+	if err := s.guestRepo.Merge(target, sourceIDs); err != nil {
+		return nil, err
+	}
+	return s.GetGuestByID(targetID, scope)
+}
+
+// Helper: appendNote menambahkan teks di baris baru jika belum ada
+func appendNote(base, extra string) string {
+	extra = strings.TrimSpace(extra)
+	if extra == "" || strings.Contains(base, extra) {
+		return base
+	}
+	if base == "" {
+		return extra
+	}
+	return base + "\n" + extra
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"time"
+)
+
+// HousekeepingService mendefinisikan kontrak untuk tugas kebersihan kamar
+type HousekeepingService interface {
+	GetTasks(filter *models.HousekeepingTaskFilter, pagination *models.Pagination) ([]models.HousekeepingTask, error)
+	GetTaskByID(taskID uint) (*models.HousekeepingTask, error)
+
+	// GenerateStayoverTasks membuat tugas harian untuk kamar yang masih ditempati (idempoten)
+	GenerateStayoverTasks(date time.Time) (int, error)
+
+	// Alur tugas: pending -> in_progress -> completed -> inspected
+	// canManage = staf dengan permission housekeeping:manage (boleh mengerjakan tugas staf lain)
+	AssignTask(taskID, assigneeID uint) (*models.HousekeepingTask, error)
+	StartTask(taskID, actorID uint, canManage bool) (*models.HousekeepingTask, error)
+	CompleteTask(taskID, actorID uint, canManage bool, notes string) (*models.HousekeepingTask, error)
+	InspectTask(taskID, inspectorID uint, passed bool, notes string) (*models.HousekeepingTask, error)
+
+	// SetRoomStatus mengubah status kebersihan kamar secara manual
+	SetRoomStatus(roomID uint, status string) (*models.Room, error)
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type housekeepingServiceImpl struct {
+	housekeepingRepo repositories.HousekeepingRepository
+	bookingRepo      repositories.BookingRepository
+	roomRepo         repositories.RoomRepository
+	userRepo         repositories.UserRepository
+}
+
+func NewHousekeepingService(hRepo repositories.HousekeepingRepository, bRepo repositories.BookingRepository, rRepo repositories.RoomRepository, uRepo repositories.UserRepository) HousekeepingService {
+	return &housekeepingServiceImpl{housekeepingRepo: hRepo, bookingRepo: bRepo, roomRepo: rRepo, userRepo: uRepo}
+}
+
+// Helper: dateOnly membuang jam agar tanggal tugas konsisten dengan kolom DATE
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// Helper: today mengembalikan tanggal hari ini dalam format YYYY-MM-DD
+func today() string {
+	return time.Now().Format("2006-01-02")
+}
+
+func (s *housekeepingServiceImpl) GetTasks(filter *models.HousekeepingTaskFilter, pagination *models.Pagination) ([]models.HousekeepingTask, error) {
+	return s.housekeepingRepo.FindAll(filter, pagination)
+}
+
+func (s *housekeepingServiceImpl) GetTaskByID(taskID uint) (*models.HousekeepingTask, error) {
+	task, err := s.housekeepingRepo.FindByID(taskID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrTaskNotFound
+		}
+		return nil, err
+	}
+	return task, nil
+}
+
+// GenerateStayoverTasks: Satu tugas per kamar yang tamunya sudah check-in dan belum check-out
+func (s *housekeepingServiceImpl) GenerateStayoverTasks(date time.Time) (int, error) {
+	taskDate := dateOnly(date)
+	bookings, err := s.bookingRepo.FindInHouse(taskDate.Format("2006-01-02"))
+	if err != nil {
+		return 0, err
+	}
+
+	tasks := make([]models.HousekeepingTask, 0, len(bookings))
+	for _, booking := range bookings {
+		bookingID := booking.ID
+		tasks = append(tasks, models.HousekeepingTask{
+			RoomID:     booking.RoomID,
+			PropertyID: booking.PropertyID,
+			BookingID:  &bookingID,
+			TaskDate:   taskDate,
+			Type:       models.TaskTypeStayover,
+			Status:     models.TaskPending,
+		})
+	}
+	if len(tasks) == 0 {
+		return 0, nil
+	}
+	return s.housekeepingRepo.CreateTasks(tasks)
+}
+
+// AssignTask: Menugaskan tugas ke staf yang punya permission housekeeping:update
+func (s *housekeepingServiceImpl) AssignTask(taskID, assigneeID uint) (*models.HousekeepingTask, error) {
+	task, err := s.GetTaskByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status == models.TaskCompleted || task.Status == models.TaskInspected {
+		return nil, models.ErrInvalidTaskTransition
+	}
+
+	assignee, err := s.userRepo.FindByID(assigneeID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !models.HasPermission(assignee.Role, models.PermHousekeepingUpdate) {
+		return nil, models.ErrInvalidTaskAssignee
+	}
+
+	task.AssignedToID = &assignee.ID
+	return s.save(task, "")
+}
+
+// StartTask: pending -> in_progress, kamar menjadi cleaning.
+// Tugas yang belum ditugaskan otomatis diambil oleh staf yang memulainya.
+func (s *housekeepingServiceImpl) StartTask(taskID, actorID uint, canManage bool) (*models.HousekeepingTask, error) {
+	task, err := s.GetTaskByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAssignee(task, actorID, canManage); err != nil {
+		return nil, err
+	}
+	if task.Status != models.TaskPending {
+		return nil, models.ErrInvalidTaskTransition
+	}
+
+	now := time.Now()
+	if task.AssignedToID == nil {
+		task.AssignedToID = &actorID
+	}
+	task.Status = models.TaskInProgress
+	task.StartedAt = &now
+	return s.save(task, models.HousekeepingCleaning)
+}
+
+// CompleteTask: pending/in_progress -> completed, kamar menjadi clean
+func (s *housekeepingServiceImpl) CompleteTask(taskID, actorID uint, canManage bool, notes string) (*models.HousekeepingTask, error) {
+	task, err := s.GetTaskByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAssignee(task, actorID, canManage); err != nil {
+		return nil, err
+	}
+	if task.Status != models.TaskPending && task.Status != models.TaskInProgress {
+		return nil, models.ErrInvalidTaskTransition
+	}
+
+	now := time.Now()
+	if task.AssignedToID == nil {
+		task.AssignedToID = &actorID
+	}
+	if task.StartedAt == nil {
+		task.StartedAt = &now
+	}
+	task.Status = models.TaskCompleted
+	task.CompletedAt = &now
+	if notes != "" {
+		task.Notes = notes
+	}
+	return s.save(task, models.HousekeepingClean)
+}
+
+// InspectTask: completed -> inspected (kamar siap dijual).
+// Jika tidak lolos inspeksi, tugas kembali ke pending dan kamar kembali dirty.
+func (s *housekeepingServiceImpl) InspectTask(taskID, inspectorID uint, passed bool, notes string) (*models.HousekeepingTask, error) {
+	task, err := s.GetTaskByID(taskID)
+	if err != nil {
+		return nil, err
+	}
+	if task.Status != models.TaskCompleted {
+		return nil, models.ErrInvalidTaskTransition
+	}
+	if notes != "" {
+		task.Notes = notes
+	}
+
+	if !passed {
+		task.Status = models.TaskPending
+		task.StartedAt = nil
+		task.CompletedAt = nil
+		return s.save(task, models.HousekeepingDirty)
+	}
+
+	now := time.Now()
+	task.Status = models.TaskInspected
+	task.InspectedByID = &inspectorID
+	task.InspectedAt = &now
+	return s.save(task, models.HousekeepingInspected)
+}
+
+// SetRoomStatus: Koreksi manual status kebersihan kamar (tanpa tugas)
+func (s *housekeepingServiceImpl) SetRoomStatus(roomID uint, status string) (*models.Room, error) {
+	if _, err := s.roomRepo.FindByID(roomID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	if err := s.roomRepo.UpdateHousekeepingStatus(roomID, status); err != nil {
+		return nil, err
+	}
+	return s.roomRepo.FindByID(roomID)
+}
+
+// Helper: checkAssignee menolak staf yang mengerjakan tugas milik staf lain
+func checkAssignee(task *models.HousekeepingTask, actorID uint, canManage bool) error {
+	if task.AssignedToID != nil && *task.AssignedToID != actorID && !canManage {
+		return models.ErrTaskAssignedToOther
+	}
+	return nil
+}
+
+// Helper: save menyimpan tugas + status kamar ("" = tidak diubah) lalu memuat ulang relasinya
+func (s *housekeepingServiceImpl) save(task *models.HousekeepingTask, roomStatus string) (*models.HousekeepingTask, error) {
+	if err := s.housekeepingRepo.Update(task, roomStatus); err != nil {
+		return nil, err
+	}
+	return s.GetTaskByID(task.ID)
+}
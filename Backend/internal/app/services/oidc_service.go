@@ -0,0 +1,20 @@
+package services
+
+import "backend/internal/domain/models"
+
+// OIDCService mendefinisikan kontrak login & penautan akun lewat provider OpenID Connect
+type OIDCService interface {
+	GetProviders() []models.OIDCProviderInfo
+
+	// BeginLogin membuat state + PKCE dan mengembalikan URL halaman login provider
+	BeginLogin(provider string) (*models.OIDCAuthorization, error)
+	// CompleteLogin menukar code dari callback; identitas baru ditautkan ke member dengan email
+	// yang sama atau dibuatkan akun member baru. Hasilnya sama seperti AuthService.Login (bisa challenge 2FA).
+	CompleteLogin(provider, code, state, language string) (*models.LoginResult, error)
+
+	// Penautan identitas ke akun yang sedang login
+	BeginLink(userID uint, provider string) (*models.OIDCAuthorization, error)
+	CompleteLink(userID uint, provider, code, state string) (*models.UserIdentity, error)
+	GetIdentities(userID uint) ([]models.UserIdentity, error)
+	UnlinkIdentity(userID, identityID uint) error
+}
@@ -0,0 +1,320 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/oidc"
+	"backend/internal/domain/repositories"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type oidcServiceImpl struct {
+	providers    map[string]oidc.Provider
+	order        []string
+	identityRepo repositories.IdentityRepository
+	userRepo     repositories.UserRepository
+	authService  AuthService
+}
+
+func NewOIDCService(providers []oidc.Provider, iRepo repositories.IdentityRepository, uRepo repositories.UserRepository, authService AuthService) OIDCService {
+	s := &oidcServiceImpl{
+		providers:    map[string]oidc.Provider{},
+		identityRepo: iRepo,
+		userRepo:     uRepo,
+		authService:  authService,
+	}
+	for _, provider := range providers {
+		s.providers[provider.Name()] = provider
+		s.order = append(s.order, provider.Name())
+	}
+	return s
+}
+
+func (s *oidcServiceImpl) GetProviders() []models.OIDCProviderInfo {
+	infos := make([]models.OIDCProviderInfo, 0, len(s.order))
+	for _, name := range s.order {
+		infos = append(infos, models.OIDCProviderInfo{Name: name, DisplayName: s.providers[name].DisplayName()})
+	}
+	return infos
+}
+
+func (s *oidcServiceImpl) BeginLogin(provider string) (*models.OIDCAuthorization, error) {
+	return s.begin(provider, nil)
+}
+
+func (s *oidcServiceImpl) BeginLink(userID uint, provider string) (*models.OIDCAuthorization, error) {
+	return s.begin(provider, &userID)
+}
+
+// begin menyimpan state, nonce, dan code verifier di server; yang dikirim ke browser hanya state
+func (s *oidcServiceImpl) begin(providerName string, userID *uint) (*models.OIDCAuthorization, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, models.ErrOIDCProviderNotFound
+	}
+
+	loginState := &models.OIDCLoginState{
+		State:        randomURLToken(),
+		Provider:     providerName,
+		CodeVerifier: randomURLToken(),
+		Nonce:        randomURLToken(),
+		UserID:       userID,
+		ExpiresAt:    time.Now().Add(models.OIDCStateTTL),
+	}
+
+	authURL, err := provider.AuthCodeURL(context.Background(), loginState.State, loginState.Nonce, loginState.CodeVerifier)
+	if err != nil {
+		log.Printf("⚠️ OIDC %s: %v", providerName, err)
+		return nil, models.ErrOIDCLoginFailed
+	}
+	if err := s.identityRepo.CreateState(loginState); err != nil {
+		return nil, err
+	}
+
+	return &models.OIDCAuthorization{
+		AuthorizationURL: authURL,
+		State:            loginState.State,
+		ExpiresIn:        int(models.OIDCStateTTL / time.Second),
+	}, nil
+}
+
+// exchange memvalidasi state sekali pakai lalu menukar code dengan identitas terverifikasi.
+// userID nil untuk alur login; terisi untuk alur penautan dan harus sama dengan pembuat state.
+func (s *oidcServiceImpl) exchange(providerName, code, state string, userID *uint) (*oidc.Identity, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, models.ErrOIDCProviderNotFound
+	}
+
+	loginState, err := s.identityRepo.ConsumeState(state)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrInvalidOIDCState
+		}
+		return nil, err
+	}
+	if loginState.Provider != providerName || (loginState.UserID == nil) != (userID == nil) ||
+		(userID != nil && *loginState.UserID != *userID) {
+		return nil, models.ErrInvalidOIDCState
+	}
+
+	identity, err := provider.Exchange(context.Background(), code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		log.Printf("⚠️ OIDC %s: %v", providerName, err)
+		return nil, models.ErrOIDCLoginFailed
+	}
+	return identity, nil
+}
+
+func (s *oidcServiceImpl) CompleteLogin(providerName, code, state, language string) (*models.LoginResult, error) {
+	external, err := s.exchange(providerName, code, state, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.resolveUser(providerName, external, language)
+	if err != nil {
+		return nil, err
+	}
+	return s.authService.LoginVerifiedUser(user)
+}
+
+// resolveUser mencari akun untuk identitas eksternal:
+// 1. Identitas sudah tertaut -> user pemiliknya
+// 2. Email terverifikasi milik member -> ditautkan otomatis
+// 3. Email belum terdaftar -> akun member baru (tanpa password)
+// Akun staf tidak ditautkan otomatis; staf menautkan sendiri setelah login dengan password (dan 2FA).
+func (s *oidcServiceImpl) resolveUser(providerName string, external *oidc.Identity, language string) (*models.User, error) {
+	now := time.Now()
+
+	identity, err := s.identityRepo.FindByProviderSubject(providerName, external.Subject)
+	if err == nil {
+		user, err := s.userRepo.FindByID(identity.UserID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, models.ErrOIDCLoginFailed
+			}
+			return nil, err
+		}
+		identity.Email = external.Email
+		identity.LastLoginAt = &now
+		if err := s.identityRepo.Update(identity); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(external.Email))
+	if email == "" || !external.EmailVerified {
+		return nil, models.ErrOIDCEmailNotVerified
+	}
+	identity = &models.UserIdentity{
+		Provider:    providerName,
+		Subject:     external.Subject,
+		Email:       email,
+		LastLoginAt: &now,
+	}
+
+	existing, err := s.userRepo.FindByEmail(email)
+	if err == nil {
+		if existing.Role != models.RoleMember {
+			return nil, models.ErrOIDCAccountExists
+		}
+		identity.UserID = existing.ID
+		if err := s.identityRepo.Create(identity); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	username, err := s.availableUsername(email)
+	if err != nil {
+		return nil, err
+	}
+	fullName := strings.TrimSpace(external.Name)
+	if fullName == "" {
+		fullName = username
+	}
+	// Password kosong: akun hanya bisa login lewat provider (bcrypt tidak pernah cocok dengan hash kosong)
+	user := &models.User{
+		Username: username,
+		Email:    email,
+		FullName: fullName,
+		Role:     models.RoleMember,
+		Language: language,
+	}
+	if err := s.identityRepo.CreateUserWithIdentity(user, identity); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrUserAlreadyExists
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// usernameUnsafe adalah karakter yang dibuang dari bagian lokal email saat membuat username
+var usernameUnsafe = regexp.MustCompile(`[^a-z0-9._]`)
+
+// availableUsername membuat username dari email, contoh "budi.santoso" atau "budi.santoso_4821"
+func (s *oidcServiceImpl) availableUsername(email string) (string, error) {
+	base := usernameUnsafe.ReplaceAllString(strings.SplitN(email, "@", 2)[0], "")
+	if len(base) > 40 {
+		base = base[:40]
+	}
+	if len(base) < 3 {
+		base = "member"
+	}
+
+	candidate := base
+	for attempt := 0; attempt < 10; attempt++ {
+		if _, err := s.userRepo.FindByUsername(candidate); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return candidate, nil
+			}
+			return "", err
+		}
+		suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s_%04d", base, suffix.Int64())
+	}
+	return "", models.ErrUserAlreadyExists
+}
+
+func (s *oidcServiceImpl) CompleteLink(userID uint, providerName, code, state string) (*models.UserIdentity, error) {
+	external, err := s.exchange(providerName, code, state, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.identityRepo.FindByProviderSubject(providerName, external.Subject)
+	if err == nil {
+		if identity.UserID != userID {
+			return nil, models.ErrIdentityAlreadyLinked
+		}
+		// Sudah tertaut ke akun ini: cukup perbarui email
+		identity.Email = strings.ToLower(external.Email)
+		if err := s.identityRepo.Update(identity); err != nil {
+			return nil, err
+		}
+		return identity, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	identity = &models.UserIdentity{
+		UserID:   userID,
+		Provider: providerName,
+		Subject:  external.Subject,
+		Email:    strings.ToLower(external.Email),
+	}
+	if err := s.identityRepo.Create(identity); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrIdentityAlreadyLinked
+		}
+		return nil, err
+	}
+	return identity, nil
+}
+
+func (s *oidcServiceImpl) GetIdentities(userID uint) ([]models.UserIdentity, error) {
+	return s.identityRepo.FindByUserID(userID)
+}
+
+func (s *oidcServiceImpl) UnlinkIdentity(userID, identityID uint) error {
+	identity, err := s.identityRepo.FindByID(identityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrIdentityNotFound
+		}
+		return err
+	}
+	if identity.UserID != userID {
+		return models.ErrIdentityNotFound
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return err
+	}
+	// Akun tanpa password (dibuat lewat provider) harus menyisakan minimal satu identitas
+	if user.Password == "" {
+		identities, err := s.identityRepo.FindByUserID(userID)
+		if err != nil {
+			return err
+		}
+		if len(identities) <= 1 {
+			return models.ErrLastLoginMethod
+		}
+	}
+
+	return s.identityRepo.Delete(identity.ID)
+}
+
+// randomURLToken membuat token acak 256 bit (43 karakter base64url), dipakai untuk state,
+// nonce, dan PKCE code verifier (RFC 7636 mensyaratkan 43-128 karakter)
+func randomURLToken() string {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,395 @@
+package services
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	infraoidc "backend/internal/infra/oidc"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// --- Provider OIDC tiruan: discovery, JWKS, dan token endpoint yang memeriksa PKCE ---
+
+const mockClientID = "hotel-app"
+
+type mockGrant struct {
+	challenge string // code_challenge (S256) dari URL login
+	nonce     string
+}
+
+type mockOIDCProvider struct {
+	t      *testing.T
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu     sync.Mutex
+	grants map[string]mockGrant
+
+	// Claim ID token berikutnya; nonceOverride terisi = provider mengirim nonce lain
+	subject       string
+	email         string
+	emailVerified bool
+	nonceOverride string
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &mockOIDCProvider{t: t, key: key, grants: map[string]mockGrant{}, subject: "sub-1", email: "budi@example.com", emailVerified: true}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/token", p.token)
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *mockOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{
+		"issuer":                                p.server.URL,
+		"authorization_endpoint":                p.server.URL + "/authorize",
+		"token_endpoint":                        p.server.URL + "/token",
+		"jwks_uri":                              p.server.URL + "/jwks",
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (p *mockOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"alg": "RS256",
+		"use": "sig",
+		"kid": "test",
+		"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+	}}})
+}
+
+// token menukar code hanya jika SHA256(code_verifier) sama dengan code_challenge saat login
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p.mu.Lock()
+	grant, ok := p.grants[r.PostForm.Get("code")]
+	delete(p.grants, r.PostForm.Get("code"))
+	p.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+		return
+	}
+
+	nonce := grant.nonce
+	if p.nonceOverride != "" {
+		nonce = p.nonceOverride
+	}
+	now := time.Now()
+	writeJSON(w, map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token": p.sign(map[string]any{
+			"iss":            p.server.URL,
+			"aud":            mockClientID,
+			"sub":            p.subject,
+			"email":          p.email,
+			"email_verified": p.emailVerified,
+			"name":           "Budi Santoso",
+			"nonce":          nonce,
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}),
+	})
+}
+
+func (p *mockOIDCProvider) sign(claims map[string]any) string {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": "test"})
+	payload, _ := json.Marshal(claims)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// authorize meniru user yang login di halaman provider: code diterbitkan untuk challenge & nonce di URL
+func (p *mockOIDCProvider) authorize(authURL string) string {
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	query := parsed.Query()
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		p.t.Fatalf("URL login tanpa PKCE S256: %s", authURL)
+	}
+	code := randomURLToken()
+	p.mu.Lock()
+	p.grants[code] = mockGrant{challenge: query.Get("code_challenge"), nonce: query.Get("nonce")}
+	p.mu.Unlock()
+	return code
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// --- Repository & AuthService in-memory ---
+
+type memoryIdentityRepo struct {
+	repositories.IdentityRepository
+	states     map[string]*models.OIDCLoginState
+	identities []*models.UserIdentity
+	users      *memoryUserRepo
+}
+
+func (r *memoryIdentityRepo) CreateState(state *models.OIDCLoginState) error {
+	r.states[state.State] = state
+	return nil
+}
+
+func (r *memoryIdentityRepo) ConsumeState(state string) (*models.OIDCLoginState, error) {
+	loginState, ok := r.states[state]
+	if !ok || loginState.ExpiresAt.Before(time.Now()) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	delete(r.states, state)
+	return loginState, nil
+}
+
+func (r *memoryIdentityRepo) FindByProviderSubject(provider, subject string) (*models.UserIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryIdentityRepo) Create(identity *models.UserIdentity) error {
+	identity.ID = uint(len(r.identities) + 1)
+	r.identities = append(r.identities, identity)
+	return nil
+}
+
+func (r *memoryIdentityRepo) Update(identity *models.UserIdentity) error {
+	return nil
+}
+
+func (r *memoryIdentityRepo) CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error {
+	if err := r.users.Create(user); err != nil {
+		return err
+	}
+	identity.UserID = user.ID
+	return r.Create(identity)
+}
+
+type memoryUserRepo struct {
+	repositories.UserRepository
+	users []*models.User
+}
+
+func (r *memoryUserRepo) Create(user *models.User) error {
+	user.ID = uint(len(r.users) + 1)
+	r.users = append(r.users, user)
+	return nil
+}
+
+func (r *memoryUserRepo) FindByID(id uint) (*models.User, error) {
+	for _, user := range r.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryUserRepo) FindByEmail(email string) (*models.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memoryUserRepo) FindByUsername(username string) (*models.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type stubAuthService struct {
+	AuthService
+}
+
+func (s *stubAuthService) LoginVerifiedUser(user *models.User) (*models.LoginResult, error) {
+	return &models.LoginResult{Token: "jwt", User: user}, nil
+}
+
+type oidcFixture struct {
+	provider   *mockOIDCProvider
+	service    OIDCService
+	users      *memoryUserRepo
+	identities *memoryIdentityRepo
+}
+
+func newOIDCFixture(t *testing.T) *oidcFixture {
+	provider := newMockOIDCProvider(t)
+	users := &memoryUserRepo{}
+	identities := &memoryIdentityRepo{states: map[string]*models.OIDCLoginState{}, users: users}
+	providers := infraoidc.NewProviders(&config.Config{OIDCProviders: []config.OIDCProviderConfig{{
+		Name:        "mock",
+		DisplayName: "Mock",
+		IssuerURL:   provider.server.URL,
+		ClientID:    mockClientID,
+		RedirectURL: "http://localhost/callback",
+		Scopes:      []string{"openid", "email", "profile"},
+	}}})
+	return &oidcFixture{
+		provider:   provider,
+		service:    NewOIDCService(providers, identities, users, &stubAuthService{}),
+		users:      users,
+		identities: identities,
+	}
+}
+
+// login menjalankan alur lengkap: BeginLogin -> halaman provider -> CompleteLogin
+func (f *oidcFixture) login(t *testing.T) (*models.LoginResult, string, error) {
+	authorization, err := f.service.BeginLogin("mock")
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+	code := f.provider.authorize(authorization.AuthorizationURL)
+	result, err := f.service.CompleteLogin("mock", code, authorization.State, "id")
+	return result, authorization.State, err
+}
+
+// --- Test ---
+
+func TestOIDCLoginPKCERoundTrip(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	authorization, err := f.service.BeginLogin("mock")
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+	loginState := f.identities.states[authorization.State]
+	if loginState == nil {
+		t.Fatal("state tidak disimpan di server")
+	}
+	sum := sha256.Sum256([]byte(loginState.CodeVerifier))
+	challenge, _ := url.Parse(authorization.AuthorizationURL)
+	if challenge.Query().Get("code_challenge") != base64.RawURLEncoding.EncodeToString(sum[:]) {
+		t.Fatal("code_challenge bukan S256 dari code verifier yang disimpan")
+	}
+	if challenge.Query().Get("code_verifier") != "" {
+		t.Fatal("code verifier tidak boleh dikirim ke browser")
+	}
+
+	code := f.provider.authorize(authorization.AuthorizationURL)
+	// Verifier diganti: token endpoint provider harus menolak
+	loginState.CodeVerifier = randomURLToken()
+	if _, err := f.service.CompleteLogin("mock", code, authorization.State, "id"); !errors.Is(err, models.ErrOIDCLoginFailed) {
+		t.Fatalf("verifier salah: ingin ErrOIDCLoginFailed, dapat %v", err)
+	}
+
+	if _, _, err := f.login(t); err != nil {
+		t.Fatalf("verifier benar: %v", err)
+	}
+}
+
+func TestOIDCLoginRejectsReusedState(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	_, state, err := f.login(t)
+	if err != nil {
+		t.Fatalf("login pertama: %v", err)
+	}
+
+	// Code baru untuk state yang sama tetap ditolak karena state sekali pakai
+	authorization, _ := f.service.BeginLogin("mock")
+	code := f.provider.authorize(authorization.AuthorizationURL)
+	if _, err := f.service.CompleteLogin("mock", code, state, "id"); !errors.Is(err, models.ErrInvalidOIDCState) {
+		t.Fatalf("state dipakai ulang: ingin ErrInvalidOIDCState, dapat %v", err)
+	}
+}
+
+func TestOIDCLoginRejectsNonceMismatch(t *testing.T) {
+	f := newOIDCFixture(t)
+	f.provider.nonceOverride = "nonce-lain"
+
+	if _, _, err := f.login(t); !errors.Is(err, models.ErrOIDCLoginFailed) {
+		t.Fatalf("nonce berbeda: ingin ErrOIDCLoginFailed, dapat %v", err)
+	}
+	if len(f.users.users) != 0 || len(f.identities.identities) != 0 {
+		t.Fatal("akun/identitas tidak boleh dibuat dari ID token yang ditolak")
+	}
+}
+
+func TestOIDCLoginUnverifiedEmailDoesNotLinkExistingAccount(t *testing.T) {
+	f := newOIDCFixture(t)
+	_ = f.users.Create(&models.User{Username: "budi", Email: "budi@example.com", Password: "hash", Role: models.RoleMember})
+	f.provider.emailVerified = false
+
+	if _, _, err := f.login(t); !errors.Is(err, models.ErrOIDCEmailNotVerified) {
+		t.Fatalf("email belum terverifikasi: ingin ErrOIDCEmailNotVerified, dapat %v", err)
+	}
+	if len(f.identities.identities) != 0 {
+		t.Fatal("identitas tidak boleh ditautkan ke akun yang sudah ada")
+	}
+}
+
+func TestOIDCFirstLoginCreatesMember(t *testing.T) {
+	f := newOIDCFixture(t)
+
+	result, _, err := f.login(t)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	user := result.User
+	if user == nil || user.ID == 0 || user.Role != models.RoleMember || user.Email != "budi@example.com" {
+		t.Fatalf("member baru tidak sesuai: %+v", user)
+	}
+	if user.Password != "" || user.Username != "budi" || user.FullName != "Budi Santoso" {
+		t.Fatalf("data member baru tidak sesuai: %+v", user)
+	}
+	if len(f.identities.identities) != 1 || f.identities.identities[0].UserID != user.ID {
+		t.Fatal("identitas provider tidak ditautkan ke member baru")
+	}
+
+	// Login berikutnya memakai identitas yang sama, bukan akun baru
+	again, _, err := f.login(t)
+	if err != nil || again.User.ID != user.ID || len(f.users.users) != 1 {
+		t.Fatalf("login kedua harus memakai akun yang sama: %v", err)
+	}
+}
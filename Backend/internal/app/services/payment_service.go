@@ -3,7 +3,14 @@ package services
 import "backend/internal/domain/models"
 
 type PaymentService interface {
-	CreatePayment(bookingID uint, paymentMethod string) (*models.Payment, error)
-	ProcessPayment(paymentID uint) error
-	GetPaymentByBookingID(bookingID uint) (*models.Payment, error)
+	// CreatePayment menagih sisa yang belum dibayar dari booking milik member (ditolak jika batal, lunas, atau masih ada tagihan pending)
+	CreatePayment(bookingID uint, userID uint, paymentMethod string) (*models.Payment, error)
+	// ProcessPayment hanya untuk tagihan pending milik member yang login
+	ProcessPayment(paymentID uint, userID uint) error
+	GetPaymentByBookingID(bookingID uint, userID uint) (*models.Payment, error)
+	// CreateGroupPayment menagih seluruh kamar aktif reservasi grup dalam satu pembayaran
+	CreateGroupPayment(groupID uint, userID uint, paymentMethod string) (*models.Payment, error)
+
+	// Admin-only methods
+	RefundPayment(paymentID uint, scope *models.PropertyScope) (*models.Payment, error)
 }
@@ -1,37 +1,81 @@
 package services
 
 import (
-	"fmt"
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
+	"errors"
+	"fmt"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type PaymentServiceImpl struct {
 	paymentRepo repositories.PaymentRepository
 	bookingRepo repositories.BookingRepository
+	groupRepo   repositories.BookingGroupRepository
 }
 
-func NewPaymentService(paymentRepo repositories.PaymentRepository, bookingRepo repositories.BookingRepository) *PaymentServiceImpl {
+func NewPaymentService(paymentRepo repositories.PaymentRepository, bookingRepo repositories.BookingRepository, groupRepo repositories.BookingGroupRepository) *PaymentServiceImpl {
 	return &PaymentServiceImpl{
 		paymentRepo: paymentRepo,
 		bookingRepo: bookingRepo,
+		groupRepo:   groupRepo,
 	}
 }
 
-func (s *PaymentServiceImpl) CreatePayment(bookingID uint, paymentMethod string) (*models.Payment, error) {
+// CreatePayment menagih sisa yang belum dibayar dari booking milik member.
+// Booking yang sudah pernah dibayar sebagian (misalnya refund tagihan selisih) ditagih sebagai adjustment,
+// sehingga uang yang diterima tidak pernah melebihi total harga booking.
+func (s *PaymentServiceImpl) CreatePayment(bookingID uint, userID uint, paymentMethod string) (*models.Payment, error) {
 	booking, err := s.bookingRepo.FindByID(bookingID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
 		return nil, err
 	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	// Kamar reservasi grup ditagih sekaligus lewat CreateGroupPayment
+	if booking.GroupID != nil {
+		return nil, models.ErrGroupBookingPayment
+	}
+	if booking.BookingStatus == models.StatusCancelled {
+		return nil, models.ErrBookingAlreadyCancelled
+	}
+	if booking.PaymentStatus == models.StatusPaid {
+		return nil, models.ErrBookingFullyPaid
+	}
+
+	// Tagihan pending (termasuk tagihan selisih dari perubahan booking) harus diproses dulu
+	payments, err := s.paymentRepo.FindByBookingID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range payments {
+		if existing.IsCharge() && existing.Status == models.StatusPending {
+			return nil, models.ErrPaymentAlreadyPending
+		}
+	}
+	paid := paidAmount(booking, payments, booking.TotalPrice)
+	balance := roundMoney(booking.TotalPrice - paid)
+	if balance <= 0 {
+		return nil, models.ErrBookingFullyPaid
+	}
 
 	payment := &models.Payment{
-		BookingID:     bookingID,
-		Amount:        booking.TotalPrice,
+		BookingID:     &bookingID,
+		Amount:        balance,
 		PaymentMethod: paymentMethod,
 		Status:        "pending",
 		TransactionID: fmt.Sprintf("TRX-%d-%d", bookingID, time.Now().Unix()),
 	}
+	if paid > 0 {
+		payment.Type = models.PaymentTypeAdjustment
+		payment.TransactionID = fmt.Sprintf("ADJ-%d-%d", bookingID, time.Now().Unix())
+	}
 
 	if err := s.paymentRepo.Create(payment); err != nil {
 		return nil, err
@@ -40,26 +84,224 @@ func (s *PaymentServiceImpl) CreatePayment(bookingID uint, paymentMethod string)
 	return payment, nil
 }
 
-func (s *PaymentServiceImpl) ProcessPayment(paymentID uint) error {
+// CreateGroupPayment membuat satu tagihan untuk seluruh kamar aktif reservasi grup milik member
+func (s *PaymentServiceImpl) CreateGroupPayment(groupID uint, userID uint, paymentMethod string) (*models.Payment, error) {
+	group, err := s.findGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+	if group.Status == models.StatusCancelled {
+		return nil, models.ErrGroupAlreadyCancelled
+	}
+	if group.PaymentStatus == models.StatusPaid {
+		return nil, models.ErrGroupAlreadyPaid
+	}
+
+	total := 0.0
+	for _, booking := range group.ActiveBookings() {
+		total += booking.TotalPrice
+	}
+
+	payment := &models.Payment{
+		GroupID:       &groupID,
+		Amount:        roundMoney(total),
+		PaymentMethod: paymentMethod,
+		Status:        "pending",
+		TransactionID: fmt.Sprintf("TRX-G%d-%d", groupID, time.Now().Unix()),
+	}
+
+	if err := s.paymentRepo.Create(payment); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+func (s *PaymentServiceImpl) findGroup(groupID uint) (*models.BookingGroup, error) {
+	group, err := s.groupRepo.FindByID(groupID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrGroupNotFound
+		}
+		return nil, err
+	}
+	return group, nil
+}
+
+// setGroupPaymentStatus memperbarui status bayar grup dan seluruh kamar aktifnya sekaligus
+func (s *PaymentServiceImpl) setGroupPaymentStatus(group *models.BookingGroup, status string) error {
+	group.PaymentStatus = status
+	active := group.ActiveBookings()
+	for _, booking := range active {
+		booking.PaymentStatus = status
+	}
+	return s.groupRepo.Save(group, active)
+}
+
+// ProcessPayment menandai tagihan pending milik member sebagai sukses dan memperbarui status bayar booking
+func (s *PaymentServiceImpl) ProcessPayment(paymentID uint, userID uint) error {
 	payment, err := s.paymentRepo.GetByID(paymentID)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrPaymentNotFound
+		}
 		return err
 	}
+	if payment.Status != models.StatusPending {
+		return models.ErrPaymentNotPending
+	}
+
+	if payment.GroupID != nil {
+		return s.processGroupPayment(payment, userID)
+	}
+
+	booking, err := s.bookingRepo.FindByID(*payment.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrBookingNotFound
+		}
+		return err
+	}
+	if booking.UserID != userID {
+		return models.ErrBookingForbidden
+	}
+	// Tagihan penuh per kamar tidak berlaku untuk reservasi grup; tagihan selisih (booking diubah) tetap per kamar
+	if booking.GroupID != nil && payment.Type != models.PaymentTypeAdjustment {
+		return models.ErrGroupBookingPayment
+	}
 
 	payment.Status = "success"
 	if err := s.paymentRepo.Update(payment); err != nil {
 		return err
 	}
 
-	booking, err := s.bookingRepo.FindByID(payment.BookingID)
+	booking.PaymentStatus = "paid"
+	return s.bookingRepo.Update(booking)
+}
+
+// processGroupPayment memproses tagihan grup milik member; status bayar grup dan seluruh kamar aktifnya menjadi paid
+func (s *PaymentServiceImpl) processGroupPayment(payment *models.Payment, userID uint) error {
+	group, err := s.findGroup(*payment.GroupID)
 	if err != nil {
 		return err
 	}
+	if group.UserID != userID {
+		return models.ErrBookingForbidden
+	}
+	if group.Status == models.StatusCancelled {
+		return models.ErrGroupAlreadyCancelled
+	}
 
-	booking.PaymentStatus = "paid"
-	return s.bookingRepo.Update(booking)
+	payment.Status = "success"
+	if err := s.paymentRepo.Update(payment); err != nil {
+		return err
+	}
+	return s.setGroupPaymentStatus(group, "paid")
+}
+
+// RefundPayment menandai pembayaran sukses sebagai refund dan menghitung ulang status bayar booking
+// dari sisa uang yang diterima (refund tagihan selisih tidak me-refund seluruh booking).
+// Scope dipakai untuk memastikan booking termasuk properti yang dikelola staf.
+func (s *PaymentServiceImpl) RefundPayment(paymentID uint, scope *models.PropertyScope) (*models.Payment, error) {
+	payment, err := s.paymentRepo.GetByID(paymentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	if payment.GroupID != nil {
+		return s.refundGroupPayment(payment, scope)
+	}
+
+	booking, err := s.bookingRepo.FindByID(*payment.BookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if !scope.Allows(booking.PropertyID) {
+		return nil, models.ErrPropertyForbidden
+	}
+
+	if payment.Status != "success" {
+		return nil, models.ErrPaymentNotRefundable
+	}
+
+	// Uang yang diterima dihitung sebelum refund; refund tagihan selisih (adjustment) hanya mengurangi sebagian
+	payments, err := s.paymentRepo.FindByBookingID(booking.ID)
+	if err != nil {
+		return nil, err
+	}
+	paid := roundMoney(paidAmount(booking, payments, booking.TotalPrice) - payment.Amount)
+
+	payment.Status = models.StatusRefunded
+	if err := s.paymentRepo.Update(payment); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case paid <= 0:
+		booking.PaymentStatus = models.StatusRefunded
+	case paid < booking.TotalPrice:
+		booking.PaymentStatus = models.StatusPending
+	default:
+		booking.PaymentStatus = models.StatusPaid
+	}
+	if err := s.bookingRepo.Update(booking); err != nil {
+		return nil, err
+	}
+	return payment, nil
 }
 
-func (s *PaymentServiceImpl) GetPaymentByBookingID(bookingID uint) (*models.Payment, error) {
-	return s.paymentRepo.GetByBookingID(bookingID)
+// refundGroupPayment me-refund pembayaran grup; status bayar grup dan seluruh kamarnya ikut berubah
+func (s *PaymentServiceImpl) refundGroupPayment(payment *models.Payment, scope *models.PropertyScope) (*models.Payment, error) {
+	group, err := s.findGroup(*payment.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	if !scope.Allows(group.PropertyID) {
+		return nil, models.ErrPropertyForbidden
+	}
+
+	if payment.Status != "success" {
+		return nil, models.ErrPaymentNotRefundable
+	}
+
+	payment.Status = models.StatusRefunded
+	if err := s.paymentRepo.Update(payment); err != nil {
+		return nil, err
+	}
+	if err := s.setGroupPaymentStatus(group, models.StatusRefunded); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// GetPaymentByBookingID mengambil pembayaran utama booking milik member
+func (s *PaymentServiceImpl) GetPaymentByBookingID(bookingID uint, userID uint) (*models.Payment, error) {
+	booking, err := s.bookingRepo.FindByID(bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if booking.UserID != userID {
+		return nil, models.ErrBookingForbidden
+	}
+
+	payment, err := s.paymentRepo.GetByBookingID(bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	return payment, nil
 }
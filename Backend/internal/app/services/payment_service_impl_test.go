@@ -0,0 +1,199 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// memoryBookingRepo menyimpan booking beserta transaksinya di memori
+type memoryBookingRepo struct {
+	repositories.BookingRepository
+	bookings map[uint]models.Booking
+	payments *memoryPaymentRepo
+}
+
+func (r *memoryBookingRepo) FindByID(id uint) (*models.Booking, error) {
+	booking, ok := r.bookings[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &booking, nil
+}
+
+func (r *memoryBookingRepo) Update(booking *models.Booking) error {
+	r.bookings[booking.ID] = *booking
+	return nil
+}
+
+func (r *memoryBookingRepo) CheckOverlap(roomID uint, checkInDate, checkOutDate string, excludeBookingID uint) (bool, error) {
+	return false, nil
+}
+
+func (r *memoryBookingRepo) SaveModification(booking *models.Booking, modification *models.BookingModification, payments []*models.Payment) error {
+	r.bookings[booking.ID] = *booking
+	for _, payment := range payments {
+		if payment.ID == 0 {
+			_ = r.payments.Create(payment)
+		} else {
+			_ = r.payments.Update(payment)
+		}
+	}
+	return nil
+}
+
+type memoryPaymentRepo struct {
+	repositories.PaymentRepository
+	payments []models.Payment
+}
+
+func (r *memoryPaymentRepo) Create(payment *models.Payment) error {
+	payment.ID = uint(len(r.payments) + 1)
+	r.payments = append(r.payments, *payment)
+	return nil
+}
+
+func (r *memoryPaymentRepo) GetByID(id uint) (*models.Payment, error) {
+	if id == 0 || int(id) > len(r.payments) {
+		return nil, gorm.ErrRecordNotFound
+	}
+	payment := r.payments[id-1]
+	return &payment, nil
+}
+
+func (r *memoryPaymentRepo) FindByBookingID(bookingID uint) ([]models.Payment, error) {
+	var payments []models.Payment
+	for _, payment := range r.payments {
+		if payment.BookingID != nil && *payment.BookingID == bookingID {
+			payments = append(payments, payment)
+		}
+	}
+	return payments, nil
+}
+
+func (r *memoryPaymentRepo) Update(payment *models.Payment) error {
+	r.payments[payment.ID-1] = *payment
+	return nil
+}
+
+type memoryRoomRepo struct {
+	repositories.RoomRepository
+	room models.Room
+}
+
+func (r *memoryRoomRepo) FindByID(id uint) (*models.Room, error) {
+	room := r.room
+	return &room, nil
+}
+
+// idleWaitlist: tidak ada kamar yang ditahan dan tidak ada antrean
+type idleWaitlist struct {
+	WaitlistService
+}
+
+func (w idleWaitlist) IsHeld(roomID uint, checkInDate, checkOutDate string, userID uint) (bool, error) {
+	return false, nil
+}
+
+func (w idleWaitlist) ProcessWaitlist(now time.Time) (int, error) {
+	return 0, nil
+}
+
+// pendingCharge mengembalikan satu-satunya tagihan pending booking
+func pendingCharge(t *testing.T, repo *memoryPaymentRepo) *models.Payment {
+	t.Helper()
+	var found *models.Payment
+	for i := range repo.payments {
+		if repo.payments[i].IsCharge() && repo.payments[i].Status == models.StatusPending {
+			if found != nil {
+				t.Fatalf("lebih dari satu tagihan pending: %+v", repo.payments)
+			}
+			found = &repo.payments[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("tidak ada tagihan pending: %+v", repo.payments)
+	}
+	return found
+}
+
+func TestPaymentBalanceAcrossModifications(t *testing.T) {
+	const memberID = 7
+	checkIn := time.Now().AddDate(0, 0, 10).Truncate(24 * time.Hour)
+	room := models.Room{PropertyID: 1, Price: 100, MaxOccupancy: 2}
+	room.ID = 1
+
+	paymentRepo := &memoryPaymentRepo{}
+	booking := models.Booking{
+		UserID: memberID, RoomID: room.ID, PropertyID: 1,
+		CheckInDate: checkIn, CheckOutDate: checkIn.AddDate(0, 0, 2), Adults: 1, NumberOfGuests: 1,
+		TotalPrice: 200, BookingStatus: models.StatusConfirmed, PaymentStatus: models.StatusPending,
+	}
+	booking.ID = 1
+	bookingRepo := &memoryBookingRepo{bookings: map[uint]models.Booking{1: booking}, payments: paymentRepo}
+	payments := NewPaymentService(paymentRepo, bookingRepo, nil)
+	bookings := NewBookingService(bookingRepo, &memoryRoomRepo{room: room}, nil, nil, nil, paymentRepo, nil, nil, nil, idleWaitlist{})
+
+	if _, err := payments.CreatePayment(1, memberID+1, "transfer"); !errors.Is(err, models.ErrBookingForbidden) {
+		t.Fatalf("booking milik member lain: ingin ErrBookingForbidden, dapat %v", err)
+	}
+	if _, err := payments.GetPaymentByBookingID(1, memberID+1); !errors.Is(err, models.ErrBookingForbidden) {
+		t.Fatalf("pembayaran booking milik member lain: ingin ErrBookingForbidden, dapat %v", err)
+	}
+
+	// Bayar penuh, lalu tagihan kedua ditolak
+	payment, err := payments.CreatePayment(1, memberID, "transfer")
+	if err != nil || payment.Amount != 200 {
+		t.Fatalf("tagihan pertama: %+v, %v", payment, err)
+	}
+	if _, err := payments.CreatePayment(1, memberID, "transfer"); !errors.Is(err, models.ErrPaymentAlreadyPending) {
+		t.Fatalf("tagihan kedua selagi pending: ingin ErrPaymentAlreadyPending, dapat %v", err)
+	}
+	if err := payments.ProcessPayment(payment.ID, memberID); err != nil {
+		t.Fatalf("ProcessPayment: %v", err)
+	}
+	if _, err := payments.CreatePayment(1, memberID, "transfer"); !errors.Is(err, models.ErrBookingFullyPaid) {
+		t.Fatalf("booking lunas: ingin ErrBookingFullyPaid, dapat %v", err)
+	}
+
+	// Tambah satu malam: selisih ditagih lewat adjustment, bukan tagihan penuh baru
+	if _, err := bookings.ModifyBooking(1, memberID, &models.BookingChange{CheckOutDate: checkIn.AddDate(0, 0, 3)}); err != nil {
+		t.Fatalf("ModifyBooking (tambah malam): %v", err)
+	}
+	adjustment := pendingCharge(t, paymentRepo)
+	if adjustment.Type != models.PaymentTypeAdjustment || adjustment.Amount != 100 {
+		t.Fatalf("tagihan selisih: %+v", adjustment)
+	}
+	if _, err := payments.CreatePayment(1, memberID, "transfer"); !errors.Is(err, models.ErrPaymentAlreadyPending) {
+		t.Fatalf("tagihan penuh selagi selisih pending: ingin ErrPaymentAlreadyPending, dapat %v", err)
+	}
+	if err := payments.ProcessPayment(adjustment.ID, memberID); err != nil {
+		t.Fatalf("ProcessPayment (selisih): %v", err)
+	}
+
+	// Kurangi dua malam: refund dihitung dari uang yang benar-benar diterima (300)
+	if _, err := bookings.ModifyBooking(1, memberID, &models.BookingChange{CheckOutDate: checkIn.AddDate(0, 0, 1)}); err != nil {
+		t.Fatalf("ModifyBooking (kurangi malam): %v", err)
+	}
+	last := paymentRepo.payments[len(paymentRepo.payments)-1]
+	if last.Type != models.PaymentTypeRefund || last.Amount != 200 {
+		t.Fatalf("refund selisih: ingin 200, dapat %+v", last)
+	}
+	current, _ := bookingRepo.FindByID(1)
+	history, _ := paymentRepo.FindByBookingID(1)
+	if paid := paidAmount(current, history, current.TotalPrice); paid != 100 || current.PaymentStatus != models.StatusPaid {
+		t.Fatalf("setelah refund: dibayar %v, status %s", paid, current.PaymentStatus)
+	}
+
+	// Booking yang dibatalkan tidak bisa ditagih lagi
+	current.BookingStatus = models.StatusCancelled
+	current.PaymentStatus = models.StatusRefunded
+	_ = bookingRepo.Update(current)
+	if _, err := payments.CreatePayment(1, memberID, "transfer"); !errors.Is(err, models.ErrBookingAlreadyCancelled) {
+		t.Fatalf("booking batal: ingin ErrBookingAlreadyCancelled, dapat %v", err)
+	}
+}
@@ -0,0 +1,27 @@
+package services
+
+import "backend/internal/domain/models"
+
+// PrivacyService mendefinisikan kontrak akses data pribadi tamu yang tercatat di log audit
+type PrivacyService interface {
+	// Reveal* mengembalikan telepon & nomor identitas lengkap setelah akses dicatat.
+	// access diisi handler (UserID, Reason, IPAddress); jika pencatatan gagal, data tidak dikirim.
+	RevealGuestIdentity(guestID uint, scope *models.PropertyScope, access *models.PIIAccessLog) (*models.GuestIdentity, error)
+	RevealBookingIdentity(bookingID uint, scope *models.PropertyScope, access *models.PIIAccessLog) (*models.GuestIdentity, error)
+
+	GetPIIAccessLogs(filter *models.PIIAccessFilter, pagination *models.Pagination) ([]models.PIIAccessLog, error)
+
+	// ExportUserData mengembalikan arsip zip berisi seluruh data pribadi member (JSON per jenis data)
+	ExportUserData(userID uint) ([]byte, error)
+	// RequestErasure mencatat permintaan penghapusan data; eksekusi menunggu persetujuan admin
+	RequestErasure(userID uint, reason string) (*models.PrivacyRequest, error)
+	GetUserPrivacyRequests(userID uint) ([]models.PrivacyRequest, error)
+	// DeleteAccount langsung menganonimkan & menghapus akun atas permintaan member sendiri
+	// (password sudah diverifikasi pemanggil), dicatat sebagai permintaan penghapusan yang selesai
+	DeleteAccount(userID uint) (*models.PrivacyRequest, error)
+
+	GetPrivacyRequests(filter *models.PrivacyRequestFilter, pagination *models.Pagination) ([]models.PrivacyRequest, error)
+	// ApproveErasure menganonimkan data member; pembayaran & nominal booking tetap untuk pembukuan
+	ApproveErasure(requestID, reviewerID uint, note string) (*models.PrivacyRequest, error)
+	RejectErasure(requestID, reviewerID uint, note string) (*models.PrivacyRequest, error)
+}
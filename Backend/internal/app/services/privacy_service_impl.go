@@ -0,0 +1,313 @@
+package services
+
+import (
+	"archive/zip"
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type privacyServiceImpl struct {
+	auditRepo   repositories.AuditRepository
+	privacyRepo repositories.PrivacyRepository
+	guestRepo   repositories.GuestRepository
+	bookingRepo repositories.BookingRepository
+	userRepo    repositories.UserRepository
+}
+
+func NewPrivacyService(aRepo repositories.AuditRepository, pRepo repositories.PrivacyRepository, gRepo repositories.GuestRepository, bRepo repositories.BookingRepository, uRepo repositories.UserRepository) PrivacyService {
+	return &privacyServiceImpl{auditRepo: aRepo, privacyRepo: pRepo, guestRepo: gRepo, bookingRepo: bRepo, userRepo: uRepo}
+}
+
+// piiFields adalah kolom yang dibuka oleh endpoint identitas (dicatat di log)
+const piiFields = "phone,id_number"
+
+// RevealGuestIdentity: Data identitas lengkap dari profil tamu
+func (s *privacyServiceImpl) RevealGuestIdentity(guestID uint, scope *models.PropertyScope, access *models.PIIAccessLog) (*models.GuestIdentity, error) {
+	guest, err := s.guestRepo.FindByID(guestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrGuestNotFound
+		}
+		return nil, err
+	}
+	if !scope.IsGlobal() {
+		allowed, err := s.guestRepo.HasBookingIn(guestID, scope.PropertyIDs)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, models.ErrPropertyForbidden
+		}
+	}
+
+	access.ResourceType = models.PIIResourceGuest
+	access.ResourceID = guest.ID
+	access.GuestID = &guest.ID
+	access.Fields = piiFields
+	if err := s.auditRepo.CreatePIIAccess(access); err != nil {
+		return nil, err
+	}
+
+	return &models.GuestIdentity{
+		FullName: guest.FullName,
+		Phone:    string(guest.Phone),
+		IDNumber: string(guest.IDNumber),
+	}, nil
+}
+
+// RevealBookingIdentity: Data identitas lengkap seperti yang diisi saat booking
+func (s *privacyServiceImpl) RevealBookingIdentity(bookingID uint, scope *models.PropertyScope, access *models.PIIAccessLog) (*models.GuestIdentity, error) {
+	booking, err := s.bookingRepo.FindByID(bookingID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
+	}
+	if !scope.Allows(booking.PropertyID) {
+		return nil, models.ErrPropertyForbidden
+	}
+
+	access.ResourceType = models.PIIResourceBooking
+	access.ResourceID = booking.ID
+	access.GuestID = booking.GuestID
+	access.Fields = piiFields
+	if err := s.auditRepo.CreatePIIAccess(access); err != nil {
+		return nil, err
+	}
+
+	return &models.GuestIdentity{
+		FullName: booking.GuestName,
+		Phone:    string(booking.GuestPhone),
+		IDNumber: string(booking.GuestIDNumber),
+	}, nil
+}
+
+func (s *privacyServiceImpl) GetPIIAccessLogs(filter *models.PIIAccessFilter, pagination *models.Pagination) ([]models.PIIAccessLog, error) {
+	if !filter.To.After(filter.From) {
+		return nil, models.ErrInvalidReportPeriod
+	}
+	return s.auditRepo.FindPIIAccess(filter, pagination)
+}
+
+// ExportUserData: Arsip profile.json, bookings.json, payments.json, reviews.json, saved_guests.json
+func (s *privacyServiceImpl) ExportUserData(userID uint) ([]byte, error) {
+	data, err := s.privacyRepo.CollectUserData(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	files := []struct {
+		name    string
+		content interface{}
+	}{
+		{"profile.json", data.Profile},
+		{"bookings.json", data.Bookings},
+		{"booking_groups.json", data.BookingGroups},
+		{"payments.json", data.Payments},
+		{"reviews.json", data.Reviews},
+		{"saved_guests.json", data.SavedGuests},
+	}
+	for _, file := range files {
+		w, err := archive.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.content); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+
+	// Ekspor langsung selesai, tetap dicatat sebagai bukti pemenuhan hak akses data
+	now := time.Now()
+	request := &models.PrivacyRequest{
+		UserID:      userID,
+		Type:        models.PrivacyRequestExport,
+		Status:      models.PrivacyRequestCompleted,
+		CompletedAt: &now,
+	}
+	if err := s.privacyRepo.CreateRequest(request); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *privacyServiceImpl) RequestErasure(userID uint, reason string) (*models.PrivacyRequest, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+	// Akun staf dinonaktifkan lewat manajemen user, bukan lewat permintaan penghapusan
+	if user.Role != models.RoleMember {
+		return nil, models.ErrErasureNotAllowed
+	}
+
+	pending, err := s.privacyRepo.FindRequests(&models.PrivacyRequestFilter{
+		Type:   models.PrivacyRequestErasure,
+		Status: models.PrivacyRequestPending,
+		UserID: userID,
+	}, &models.Pagination{Limit: 1, Sort: "id"})
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) > 0 {
+		return nil, models.ErrErasureAlreadyRequested
+	}
+
+	if err := s.ensureNoActiveBookings(userID); err != nil {
+		return nil, err
+	}
+
+	request := &models.PrivacyRequest{
+		UserID: userID,
+		Type:   models.PrivacyRequestErasure,
+		Status: models.PrivacyRequestPending,
+		Reason: reason,
+	}
+	if err := s.privacyRepo.CreateRequest(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// selfDeletionReason mengisi alasan permintaan penghapusan yang dibuat dari hapus akun mandiri
+const selfDeletionReason = "Hapus akun mandiri oleh member"
+
+func (s *privacyServiceImpl) DeleteAccount(userID uint) (*models.PrivacyRequest, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if user.Role != models.RoleMember {
+		return nil, models.ErrErasureNotAllowed
+	}
+	if err := s.ensureNoActiveBookings(userID); err != nil {
+		return nil, err
+	}
+
+	// Permintaan penghapusan yang masih menunggu admin ikut diselesaikan
+	pending, err := s.privacyRepo.FindRequests(&models.PrivacyRequestFilter{
+		Type:   models.PrivacyRequestErasure,
+		Status: models.PrivacyRequestPending,
+		UserID: userID,
+	}, &models.Pagination{Limit: 1, Sort: "id"})
+	if err != nil {
+		return nil, err
+	}
+	request := &models.PrivacyRequest{
+		UserID: userID,
+		Type:   models.PrivacyRequestErasure,
+		Reason: selfDeletionReason,
+	}
+	if len(pending) > 0 {
+		request = &pending[0]
+	}
+
+	now := time.Now()
+	request.Status = models.PrivacyRequestCompleted
+	request.CompletedAt = &now
+	if err := s.privacyRepo.CompleteErasure(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *privacyServiceImpl) GetUserPrivacyRequests(userID uint) ([]models.PrivacyRequest, error) {
+	return s.privacyRepo.FindRequests(&models.PrivacyRequestFilter{UserID: userID}, &models.Pagination{Sort: "created_at desc"})
+}
+
+func (s *privacyServiceImpl) GetPrivacyRequests(filter *models.PrivacyRequestFilter, pagination *models.Pagination) ([]models.PrivacyRequest, error) {
+	return s.privacyRepo.FindRequests(filter, pagination)
+}
+
+func (s *privacyServiceImpl) ApproveErasure(requestID, reviewerID uint, note string) (*models.PrivacyRequest, error) {
+	request, err := s.findPendingErasure(requestID)
+	if err != nil {
+		return nil, err
+	}
+	// Booking bisa saja dibuat setelah permintaan diajukan
+	if err := s.ensureNoActiveBookings(request.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = models.PrivacyRequestCompleted
+	request.ReviewedByID = &reviewerID
+	request.ReviewNote = note
+	request.ReviewedAt = &now
+	request.CompletedAt = &now
+	if err := s.privacyRepo.CompleteErasure(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (s *privacyServiceImpl) RejectErasure(requestID, reviewerID uint, note string) (*models.PrivacyRequest, error) {
+	request, err := s.findPendingErasure(requestID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = models.PrivacyRequestRejected
+	request.ReviewedByID = &reviewerID
+	request.ReviewNote = note
+	request.ReviewedAt = &now
+	if err := s.privacyRepo.UpdateRequest(request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// findPendingErasure memastikan permintaan adalah penghapusan yang belum diproses
+func (s *privacyServiceImpl) findPendingErasure(requestID uint) (*models.PrivacyRequest, error) {
+	request, err := s.privacyRepo.FindRequestByID(requestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPrivacyRequestNotFound
+		}
+		return nil, err
+	}
+	if request.Type != models.PrivacyRequestErasure {
+		return nil, models.ErrPrivacyRequestNotFound
+	}
+	if request.Status != models.PrivacyRequestPending {
+		return nil, models.ErrPrivacyRequestProcessed
+	}
+	return request, nil
+}
+
+// ensureNoActiveBookings menolak penghapusan selama masih ada booking yang belum selesai
+func (s *privacyServiceImpl) ensureNoActiveBookings(userID uint) error {
+	active, err := s.privacyRepo.CountActiveBookings(userID, today())
+	if err != nil {
+		return err
+	}
+	if active > 0 {
+		return models.ErrErasureActiveBookings
+	}
+	return nil
+}
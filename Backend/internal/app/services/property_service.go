@@ -0,0 +1,21 @@
+package services
+
+import "backend/internal/domain/models"
+
+// PropertyService mendefinisikan kontrak untuk properti (hotel) dan scope admin per properti
+type PropertyService interface {
+	// Untuk Publik & Admin (scope nil = semua properti)
+	GetAllProperties(scope *models.PropertyScope) ([]models.Property, error)
+	GetPropertyByID(propertyID uint) (*models.Property, error)
+
+	// Untuk Admin
+	CreateProperty(property *models.Property) (*models.Property, error)
+	UpdateProperty(property *models.Property) (*models.Property, error)
+	DeleteProperty(propertyID uint) error
+
+	// Scope admin: properti yang boleh dikelola seorang admin
+	GetAdminScope(userID uint) (*models.PropertyScope, error)
+	GetUserProperties(userID uint) ([]models.Property, error)
+	// SetUserProperties: daftar kosong tanpa groupAdmin mencabut semua akses properti
+	SetUserProperties(userID uint, propertyIDs []uint, groupAdmin bool) ([]models.Property, error)
+}
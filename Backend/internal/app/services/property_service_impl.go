@@ -0,0 +1,195 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type propertyServiceImpl struct {
+	propertyRepo repositories.PropertyRepository
+	userRepo     repositories.UserRepository
+}
+
+func NewPropertyService(pRepo repositories.PropertyRepository, uRepo repositories.UserRepository) PropertyService {
+	return &propertyServiceImpl{propertyRepo: pRepo, userRepo: uRepo}
+}
+
+// GetAllProperties: Mengambil semua properti yang termasuk dalam scope
+func (s *propertyServiceImpl) GetAllProperties(scope *models.PropertyScope) ([]models.Property, error) {
+	if scope.IsGlobal() {
+		return s.propertyRepo.FindAll(nil)
+	}
+	return s.propertyRepo.FindAll(scope.PropertyIDs)
+}
+
+// GetPropertyByID: Mengambil detail properti
+func (s *propertyServiceImpl) GetPropertyByID(propertyID uint) (*models.Property, error) {
+	property, err := s.propertyRepo.FindByID(propertyID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrPropertyNotFound
+		}
+		return nil, err
+	}
+	return property, nil
+}
+
+// CreateProperty: Menambah properti baru (Admin Grup)
+func (s *propertyServiceImpl) CreateProperty(property *models.Property) (*models.Property, error) {
+	if _, err := time.LoadLocation(property.Timezone); err != nil {
+		return nil, models.ErrInvalidTimezone
+	}
+
+	if err := s.propertyRepo.Create(property); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrPropertyAlreadyExists
+		}
+		return nil, err
+	}
+	return property, nil
+}
+
+// UpdateProperty: Mengubah data properti
+func (s *propertyServiceImpl) UpdateProperty(property *models.Property) (*models.Property, error) {
+	if _, err := s.GetPropertyByID(property.ID); err != nil {
+		return nil, err
+	}
+	if _, err := time.LoadLocation(property.Timezone); err != nil {
+		return nil, models.ErrInvalidTimezone
+	}
+
+	if err := s.propertyRepo.Update(property); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrPropertyAlreadyExists
+		}
+		return nil, err
+	}
+	return property, nil
+}
+
+// DeleteProperty: Menghapus properti yang sudah tidak memiliki kamar (Admin Grup)
+func (s *propertyServiceImpl) DeleteProperty(propertyID uint) error {
+	if _, err := s.GetPropertyByID(propertyID); err != nil {
+		return err
+	}
+
+	roomCount, err := s.propertyRepo.CountRooms(propertyID)
+	if err != nil {
+		return err
+	}
+	if roomCount > 0 {
+		return models.ErrPropertyHasRooms
+	}
+
+	return s.propertyRepo.Delete(propertyID)
+}
+
+// GetAdminScope: Menentukan properti yang boleh dikelola staf beserta role terbarunya.
+// Hanya admin grup yang mendapat scope global; staf tanpa penugasan ditolak.
+func (s *propertyServiceImpl) GetAdminScope(userID uint) (*models.PropertyScope, error) {
+	// Staf yang dinonaktifkan (atau dihapus) langsung kehilangan akses admin walau token masih berlaku
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrAccountDeactivated
+		}
+		return nil, err
+	}
+	if user.DeactivatedAt != nil {
+		return nil, models.ErrAccountDeactivated
+	}
+	// Role diturunkan menjadi member: akses admin dicabut tanpa menunggu token kedaluwarsa
+	if !models.IsStaffRole(user.Role) {
+		return nil, models.ErrStaffRoleRequired
+	}
+	if user.IsGroupAdmin && user.Role == models.RoleAdmin {
+		return &models.PropertyScope{Global: true, Role: user.Role}, nil
+	}
+
+	// Tanpa penugasan (atau semua properti yang ditugaskan sudah dihapus) = tidak ada akses
+	propertyIDs, err := s.propertyRepo.FindIDsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(propertyIDs) == 0 {
+		return nil, models.ErrNoPropertyAssigned
+	}
+	return &models.PropertyScope{PropertyIDs: propertyIDs, Role: user.Role}, nil
+}
+
+// GetUserProperties: Mengambil properti yang ditugaskan ke user
+func (s *propertyServiceImpl) GetUserProperties(userID uint) ([]models.Property, error) {
+	if _, err := s.findStaff(userID); err != nil {
+		return nil, err
+	}
+
+	propertyIDs, err := s.propertyRepo.FindIDsByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.propertyRepo.FindByIDs(propertyIDs)
+}
+
+// SetUserProperties: Mengganti penugasan properti staf (daftar kosong = akses dicabut).
+// groupAdmin memberi akses ke semua properti dan hanya untuk role admin.
+func (s *propertyServiceImpl) SetUserProperties(userID uint, propertyIDs []uint, groupAdmin bool) ([]models.Property, error) {
+	user, err := s.findStaff(userID)
+	if err != nil {
+		return nil, err
+	}
+	if groupAdmin && user.Role != models.RoleAdmin {
+		return nil, models.ErrGroupAdminRoleRequired
+	}
+	// Mencabut admin grup aktif terakhir akan mengunci semua orang dari pengelolaan staf & properti
+	if !groupAdmin && user.IsGroupAdmin && user.DeactivatedAt == nil {
+		count, err := s.userRepo.CountActiveAdmins()
+		if err != nil {
+			return nil, err
+		}
+		if count <= 1 {
+			return nil, models.ErrLastAdmin
+		}
+	}
+
+	properties, err := s.propertyRepo.FindByIDs(propertyIDs)
+	if err != nil {
+		return nil, err
+	}
+	unique := map[uint]bool{}
+	for _, id := range propertyIDs {
+		unique[id] = true
+	}
+	if len(properties) != len(unique) {
+		return nil, models.ErrPropertyNotFound
+	}
+
+	if err := s.propertyRepo.ReplaceUserProperties(userID, properties); err != nil {
+		return nil, err
+	}
+	if user.IsGroupAdmin != groupAdmin {
+		user.IsGroupAdmin = groupAdmin
+		if err := s.userRepo.Update(user); err != nil {
+			return nil, err
+		}
+	}
+	return properties, nil
+}
+
+// Helper: findStaff memastikan user ada dan merupakan staf (bukan member biasa)
+func (s *propertyServiceImpl) findStaff(userID uint) (*models.User, error) {
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !models.IsStaffRole(user.Role) {
+		return nil, models.ErrPropertyAssignNonStaff
+	}
+	return user, nil
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"testing"
+)
+
+// memoryPropertyRepo menyimpan penugasan properti staf (properti yang sudah dihapus tidak ikut dikembalikan)
+type memoryPropertyRepo struct {
+	repositories.PropertyRepository
+	assignments map[uint][]uint
+}
+
+func (r *memoryPropertyRepo) FindIDsByUserID(userID uint) ([]uint, error) {
+	return r.assignments[userID], nil
+}
+
+func (r *memoryPropertyRepo) FindByIDs(ids []uint) ([]models.Property, error) {
+	properties := make([]models.Property, 0, len(ids))
+	for _, id := range ids {
+		property := models.Property{}
+		property.ID = id
+		properties = append(properties, property)
+	}
+	return properties, nil
+}
+
+func (r *memoryPropertyRepo) ReplaceUserProperties(userID uint, properties []models.Property) error {
+	ids := make([]uint, 0, len(properties))
+	for _, property := range properties {
+		ids = append(ids, property.ID)
+	}
+	r.assignments[userID] = ids
+	return nil
+}
+
+func (r *memoryUserRepo) Update(user *models.User) error {
+	return nil
+}
+
+func (r *memoryUserRepo) CountActiveAdmins() (int64, error) {
+	var count int64
+	for _, user := range r.users {
+		if user.Role == models.RoleAdmin && user.IsGroupAdmin && user.DeactivatedAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func newScopeFixture(users ...*models.User) (PropertyService, *memoryPropertyRepo) {
+	userRepo := &memoryUserRepo{}
+	for _, user := range users {
+		_ = userRepo.Create(user)
+	}
+	propertyRepo := &memoryPropertyRepo{assignments: map[uint][]uint{}}
+	return NewPropertyService(propertyRepo, userRepo), propertyRepo
+}
+
+func TestGetAdminScopeOnlyGroupAdminIsGlobal(t *testing.T) {
+	groupAdmin := &models.User{Role: models.RoleAdmin, IsGroupAdmin: true}
+	frontDesk := &models.User{Role: models.RoleFrontDesk}
+	flaggedFrontDesk := &models.User{Role: models.RoleFrontDesk, IsGroupAdmin: true}
+	service, propertyRepo := newScopeFixture(groupAdmin, frontDesk, flaggedFrontDesk)
+
+	scope, err := service.GetAdminScope(groupAdmin.ID)
+	if err != nil || !scope.IsGlobal() {
+		t.Fatalf("admin grup harus mendapat scope global: %+v, %v", scope, err)
+	}
+
+	// Staf baru tanpa penugasan (atau properti yang ditugaskan sudah dihapus) tidak punya akses
+	for _, user := range []*models.User{frontDesk, flaggedFrontDesk} {
+		if _, err := service.GetAdminScope(user.ID); !errors.Is(err, models.ErrNoPropertyAssigned) {
+			t.Fatalf("%s tanpa penugasan: ingin ErrNoPropertyAssigned, dapat %v", user.Role, err)
+		}
+	}
+
+	propertyRepo.assignments[frontDesk.ID] = []uint{2}
+	scope, err = service.GetAdminScope(frontDesk.ID)
+	if err != nil || scope.IsGlobal() || !scope.Allows(2) || scope.Allows(3) {
+		t.Fatalf("scope front desk harus dibatasi properti 2: %+v, %v", scope, err)
+	}
+}
+
+func TestSetUserPropertiesEmptyListRevokesAccess(t *testing.T) {
+	groupAdmin := &models.User{Role: models.RoleAdmin, IsGroupAdmin: true}
+	otherAdmin := &models.User{Role: models.RoleAdmin, IsGroupAdmin: true}
+	frontDesk := &models.User{Role: models.RoleFrontDesk}
+	service, propertyRepo := newScopeFixture(groupAdmin, otherAdmin, frontDesk)
+	propertyRepo.assignments[frontDesk.ID] = []uint{1}
+
+	if _, err := service.SetUserProperties(frontDesk.ID, []uint{}, false); err != nil {
+		t.Fatalf("SetUserProperties: %v", err)
+	}
+	if _, err := service.GetAdminScope(frontDesk.ID); !errors.Is(err, models.ErrNoPropertyAssigned) {
+		t.Fatalf("array kosong harus mencabut akses, dapat %v", err)
+	}
+
+	if _, err := service.SetUserProperties(frontDesk.ID, nil, true); !errors.Is(err, models.ErrGroupAdminRoleRequired) {
+		t.Fatalf("admin grup untuk non-admin: ingin ErrGroupAdminRoleRequired, dapat %v", err)
+	}
+
+	// Admin grup dicabut menjadi admin properti; admin grup terakhir tidak bisa dicabut
+	if _, err := service.SetUserProperties(otherAdmin.ID, []uint{1}, false); err != nil {
+		t.Fatalf("cabut admin grup: %v", err)
+	}
+	if scope, err := service.GetAdminScope(otherAdmin.ID); err != nil || scope.IsGlobal() {
+		t.Fatalf("admin yang dicabut tidak boleh global: %+v, %v", scope, err)
+	}
+	if _, err := service.SetUserProperties(groupAdmin.ID, nil, false); !errors.Is(err, models.ErrLastAdmin) {
+		t.Fatalf("admin grup terakhir: ingin ErrLastAdmin, dapat %v", err)
+	}
+}
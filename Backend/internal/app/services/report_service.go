@@ -0,0 +1,8 @@
+package services
+
+import "backend/internal/domain/models"
+
+// ReportService mendefinisikan kontrak untuk laporan admin
+type ReportService interface {
+	GetPropertySummary(filter *models.ReportFilter) ([]models.PropertyReport, error)
+}
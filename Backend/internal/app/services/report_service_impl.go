@@ -0,0 +1,22 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+)
+
+type reportServiceImpl struct {
+	reportRepo repositories.ReportRepository
+}
+
+func NewReportService(rRepo repositories.ReportRepository) ReportService {
+	return &reportServiceImpl{reportRepo: rRepo}
+}
+
+// GetPropertySummary: Ringkasan booking, malam terjual, dan pendapatan per properti
+func (s *reportServiceImpl) GetPropertySummary(filter *models.ReportFilter) ([]models.PropertyReport, error) {
+	if !filter.To.After(filter.From) {
+		return nil, models.ErrInvalidReportPeriod
+	}
+	return s.reportRepo.SummaryByProperty(filter)
+}
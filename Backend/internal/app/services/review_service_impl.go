@@ -22,22 +22,25 @@ func (s *reviewServiceImpl) CreateReview(review *models.Review) (*models.Review,
 	// 1. Validasi: Pastikan Booking ID ada
 	booking, err := s.bookingRepo.FindByID(review.BookingID)
 	if err != nil {
-		return nil, errors.New("booking terkait tidak ditemukan")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrBookingNotFound
+		}
+		return nil, err
 	}
 
 	// 2. Validasi: Pastikan Booking sudah selesai (Completed)
 	if booking.BookingStatus != models.StatusCompleted {
-		return nil, errors.New("ulasan hanya dapat dibuat untuk pemesanan yang sudah selesai")
+		return nil, models.ErrReviewBookingNotCompleted
 	}
 
 	// 3. Validasi: Pastikan Rating antara 1 sampai 5
 	if review.Rating < 1 || review.Rating > 5 {
-		return nil, errors.New("rating harus antara 1 sampai 5")
+		return nil, models.ErrInvalidRating
 	}
 
 	// 4. Validasi: Pastikan hanya 1 review per booking
 	if _, err := s.reviewRepo.FindByBookingID(review.BookingID); err == nil {
-		return nil, errors.New("anda sudah memberikan ulasan untuk pemesanan ini")
+		return nil, models.ErrReviewAlreadyExists
 	}
 
 	// Set UserID dari Booking
@@ -69,7 +72,7 @@ func (s *reviewServiceImpl) GetReviewByID(reviewID uint) (*models.Review, error)
 	review, err := s.reviewRepo.FindByID(reviewID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("ulasan tidak ditemukan")
+			return nil, models.ErrReviewNotFound
 		}
 		return nil, err
 	}
@@ -81,7 +84,7 @@ func (s *reviewServiceImpl) DeleteReview(reviewID uint) error {
 	_, err := s.reviewRepo.FindByID(reviewID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("ulasan tidak ditemukan")
+			return models.ErrReviewNotFound
 		}
 		return err
 	}
@@ -2,22 +2,46 @@ package services
 
 import (
 	"backend/internal/domain/models"
+	"io"
 )
 
 // RoomService mendefinisikan kontrak untuk semua operasi kamar
 type RoomService interface {
 	// Untuk Member & Admin
-	GetAllRooms(pagination *models.Pagination) ([]models.Room, error)
+	GetAllRooms(filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error)
 	GetRoomByID(roomID uint) (*models.Room, error)
-	GetAvailableRooms(checkInDate, checkOutDate string, pagination *models.Pagination) ([]models.Room, error)
+	GetAvailableRooms(checkInDate, checkOutDate string, filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error)
 
 	// Untuk Admin
 	CreateRoom(room *models.Room) (*models.Room, error)
 	UpdateRoom(room *models.Room) (*models.Room, error)
 	DeleteRoom(roomID uint) error
+	UpdateRoomStatus(roomID uint, status string) (*models.Room, error)
+	SetRoomAmenities(roomID uint, amenityIDs []uint) (*models.Room, error)
 
 	// Untuk Galeri Foto
 	AddRoomImage(image *models.RoomImage) (*models.RoomImage, error)
-	DeleteRoomImage(imageID uint) error
+	UploadRoomImages(roomID uint, uploads []RoomImageUpload) ([]models.RoomImage, error)
+	UpdateRoomImage(roomID, imageID uint, update RoomImageUpdate) (*models.RoomImage, error)
+	ReorderRoomImages(roomID uint, imageIDs []uint, primaryImageID uint) ([]models.RoomImage, error)
+	DeleteRoomImage(roomID, imageID uint) error
 	DeleteRoomImages(roomID uint) error
 }
+
+// RoomImageUpload adalah satu file gambar beserta metadatanya untuk UploadRoomImages
+type RoomImageUpload struct {
+	Filename  string
+	File      io.Reader
+	Size      int64
+	AltText   string
+	Caption   string
+	IsPrimary bool
+}
+
+// RoomImageUpdate berisi field gambar yang diubah (nil = tidak diubah).
+// IsPrimary hanya bisa di-set true; primary lama otomatis dilepas.
+type RoomImageUpdate struct {
+	AltText   *string
+	Caption   *string
+	IsPrimary bool
+}
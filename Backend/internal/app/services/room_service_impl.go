@@ -1,9 +1,20 @@
 package services
 
 import (
+	"backend/internal/config"
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
+	"backend/internal/domain/storage"
+	"backend/pkg/imaging"
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -11,15 +22,71 @@ import (
 type roomServiceImpl struct {
 	roomRepo      repositories.RoomRepository
 	roomImageRepo repositories.RoomImageRepository
+	amenityRepo   repositories.AmenityRepository
+	propertyRepo  repositories.PropertyRepository
+	fileStorage   storage.FileStorage
+	cfg           *config.Config
 }
 
-func NewRoomService(rRepo repositories.RoomRepository, riRepo repositories.RoomImageRepository) RoomService {
-	return &roomServiceImpl{roomRepo: rRepo, roomImageRepo: riRepo}
+func NewRoomService(rRepo repositories.RoomRepository, riRepo repositories.RoomImageRepository, aRepo repositories.AmenityRepository, pRepo repositories.PropertyRepository, fileStorage storage.FileStorage, cfg *config.Config) RoomService {
+	return &roomServiceImpl{roomRepo: rRepo, roomImageRepo: riRepo, amenityRepo: aRepo, propertyRepo: pRepo, fileStorage: fileStorage, cfg: cfg}
 }
 
-// GetAllRooms: Mengambil semua kamar dengan pagination
-func (s *roomServiceImpl) GetAllRooms(pagination *models.Pagination) ([]models.Room, error) {
-	return s.roomRepo.FindAll(pagination)
+// Helper: resolveImageURL mengisi ImageURL dan URL varian dari FileStorage (bisa berupa signed URL).
+// Gambar tanpa varian (URL eksternal / upload lama) memakai ImageURL untuk semua varian.
+func (s *roomServiceImpl) resolveImageURL(image *models.RoomImage) {
+	if image.StorageKey != "" {
+		image.ImageURL = s.storageURL(image.ID, image.StorageKey, image.ImageURL)
+	}
+	image.ThumbURL = s.storageURL(image.ID, image.ThumbKey, image.ImageURL)
+	image.CardURL = s.storageURL(image.ID, image.CardKey, image.ImageURL)
+	image.FullURL = s.storageURL(image.ID, image.FullKey, image.ImageURL)
+	image.WebpURL = s.storageURL(image.ID, image.WebpKey, image.ImageURL)
+}
+
+// Helper: storageURL membuat URL untuk key, atau fallback jika key kosong / gagal
+func (s *roomServiceImpl) storageURL(imageID uint, key, fallback string) string {
+	if key == "" {
+		return fallback
+	}
+	url, err := s.fileStorage.URL(context.Background(), key)
+	if err != nil {
+		log.Printf("gagal membuat URL gambar %d (%s): %v", imageID, key, err)
+		return fallback
+	}
+	return url
+}
+
+// Helper: prepareRoom mengisi URL semua gambar dan PrimaryImage kamar
+func (s *roomServiceImpl) prepareRoom(room *models.Room) {
+	room.PrimaryImage = nil
+	for i := range room.Images {
+		s.resolveImageURL(&room.Images[i])
+		if room.Images[i].IsPrimary && room.PrimaryImage == nil {
+			room.PrimaryImage = &room.Images[i]
+		}
+	}
+	// Kamar tanpa gambar primary memakai gambar pertama di galeri
+	if room.PrimaryImage == nil && len(room.Images) > 0 {
+		room.PrimaryImage = &room.Images[0]
+	}
+}
+
+// Helper: prepareRooms menjalankan prepareRoom untuk daftar kamar
+func (s *roomServiceImpl) prepareRooms(rooms []models.Room) {
+	for i := range rooms {
+		s.prepareRoom(&rooms[i])
+	}
+}
+
+// GetAllRooms: Mengambil semua kamar dengan filter dan pagination
+func (s *roomServiceImpl) GetAllRooms(filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error) {
+	rooms, err := s.roomRepo.FindAll(filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	s.prepareRooms(rooms)
+	return rooms, nil
 }
 
 // GetRoomByID: Mengambil detail kamar berdasarkan ID
@@ -27,43 +94,97 @@ func (s *roomServiceImpl) GetRoomByID(roomID uint) (*models.Room, error) {
 	room, err := s.roomRepo.FindByID(roomID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("kamar tidak ditemukan")
+			return nil, models.ErrRoomNotFound
 		}
 		return nil, err
 	}
+	s.prepareRoom(room)
 	return room, nil
 }
 
 // GetAvailableRooms: Mengambil kamar yang tersedia pada periode tertentu
-func (s *roomServiceImpl) GetAvailableRooms(checkInDate, checkOutDate string, pagination *models.Pagination) ([]models.Room, error) {
-	return s.roomRepo.FindAvailable(checkInDate, checkOutDate, pagination)
+func (s *roomServiceImpl) GetAvailableRooms(checkInDate, checkOutDate string, filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error) {
+	// Tamu yang datang hari ini hanya boleh mendapat kamar yang sudah bersih
+	if checkInDate <= today() {
+		if filter == nil {
+			filter = &models.RoomFilter{}
+		}
+		filter.ReadyOnly = true
+	}
+
+	rooms, err := s.roomRepo.FindAvailable(checkInDate, checkOutDate, filter, pagination)
+	if err != nil {
+		return nil, err
+	}
+	s.prepareRooms(rooms)
+	return rooms, nil
 }
 
 // CreateRoom: Membuat kamar baru (Admin Only)
 func (s *roomServiceImpl) CreateRoom(room *models.Room) (*models.Room, error) {
 	// Validasi input
-	if room.RoomNumber == "" || room.Type == "" || room.Price <= 0 || room.MaxOccupancy <= 0 {
-		return nil, errors.New("data kamar tidak lengkap atau tidak valid")
+	if room.RoomNumber == "" || room.Type == "" || room.Price <= 0 || room.MaxOccupancy <= 0 || !room.ValidOccupancyRules() {
+		return nil, models.ErrInvalidRoomData
+	}
+	if err := s.resolveProperty(room); err != nil {
+		return nil, err
 	}
 
 	if err := s.roomRepo.Create(room); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrRoomNumberTaken
+		}
 		return nil, err
 	}
 	return room, nil
 }
 
+// Helper: resolveProperty memastikan properti kamar ada.
+// property_id kosong = properti default (instalasi satu hotel).
+func (s *roomServiceImpl) resolveProperty(room *models.Room) error {
+	var property *models.Property
+	var err error
+	if room.PropertyID == 0 {
+		property, err = s.propertyRepo.FindDefault()
+	} else {
+		property, err = s.propertyRepo.FindByID(room.PropertyID)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrPropertyNotFound
+		}
+		return err
+	}
+	room.PropertyID = property.ID
+	return nil
+}
+
 // UpdateRoom: Mengubah data kamar (Admin Only)
 func (s *roomServiceImpl) UpdateRoom(room *models.Room) (*models.Room, error) {
 	// Verifikasi kamar ada
-	_, err := s.roomRepo.FindByID(room.ID)
+	existing, err := s.roomRepo.FindByID(room.ID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("kamar tidak ditemukan")
+			return nil, models.ErrRoomNotFound
 		}
 		return nil, err
 	}
 
+	if !room.ValidOccupancyRules() {
+		return nil, models.ErrInvalidRoomData
+	}
+
+	// Kamar tetap di properti lama jika property_id tidak diisi
+	if room.PropertyID == 0 {
+		room.PropertyID = existing.PropertyID
+	} else if err := s.resolveProperty(room); err != nil {
+		return nil, err
+	}
+
 	if err := s.roomRepo.Update(room); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrRoomNumberTaken
+		}
 		return nil, err
 	}
 	return room, nil
@@ -75,49 +196,391 @@ func (s *roomServiceImpl) DeleteRoom(roomID uint) error {
 	_, err := s.roomRepo.FindByID(roomID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("kamar tidak ditemukan")
+			return models.ErrRoomNotFound
 		}
 		return err
 	}
 
-	// Hapus semua gambar kamar terlebih dahulu
-	if err := s.roomImageRepo.DeleteByRoomID(roomID); err != nil {
+	// Hapus semua gambar kamar (file + record) terlebih dahulu
+	if err := s.DeleteRoomImages(roomID); err != nil {
 		return err
 	}
 
 	return s.roomRepo.Delete(roomID)
 }
 
-// AddRoomImage: Menambah gambar kamar (Admin Only)
+// UpdateRoomStatus: Mengubah status kamar saja (Front Desk & Housekeeping)
+func (s *roomServiceImpl) UpdateRoomStatus(roomID uint, status string) (*models.Room, error) {
+	room, err := s.roomRepo.FindByID(roomID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	room.Status = status
+	if err := s.roomRepo.Update(room); err != nil {
+		return nil, err
+	}
+	s.prepareRoom(room)
+	return room, nil
+}
+
+// SetRoomAmenities: Mengganti seluruh fasilitas kamar (Admin Only)
+func (s *roomServiceImpl) SetRoomAmenities(roomID uint, amenityIDs []uint) (*models.Room, error) {
+	if _, err := s.roomRepo.FindByID(roomID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	// Semua ID harus ada di katalog (duplikat diabaikan)
+	amenities, err := s.amenityRepo.FindByIDs(amenityIDs)
+	if err != nil {
+		return nil, err
+	}
+	unique := map[uint]bool{}
+	for _, id := range amenityIDs {
+		unique[id] = true
+	}
+	if len(amenities) != len(unique) {
+		return nil, models.ErrAmenityNotFound
+	}
+
+	if err := s.roomRepo.ReplaceAmenities(roomID, amenities); err != nil {
+		return nil, err
+	}
+	return s.GetRoomByID(roomID)
+}
+
+// AddRoomImage: Menambah gambar kamar dari URL eksternal (Admin Only)
 func (s *roomServiceImpl) AddRoomImage(image *models.RoomImage) (*models.RoomImage, error) {
 	// Verifikasi kamar ada
 	_, err := s.roomRepo.FindByID(image.RoomID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("kamar tidak ditemukan")
+			return nil, models.ErrRoomNotFound
 		}
 		return nil, err
 	}
 
+	if err := s.arrangeNewImages(image.RoomID, []*models.RoomImage{image}); err != nil {
+		return nil, err
+	}
 	if err := s.roomImageRepo.Create(image); err != nil {
 		return nil, err
 	}
+	s.resolveImageURL(image)
 	return image, nil
 }
 
-// DeleteRoomImage: Menghapus satu gambar kamar (Admin Only)
-func (s *roomServiceImpl) DeleteRoomImage(imageID uint) error {
-	_, err := s.roomImageRepo.FindByID(imageID)
+// Helper: arrangeNewImages menaruh gambar baru di akhir galeri dan menjaga tepat satu primary:
+// hanya gambar pertama yang ditandai primary yang dipakai, dan jika kamar belum punya
+// gambar primary maka gambar baru pertama otomatis menjadi primary
+func (s *roomServiceImpl) arrangeNewImages(roomID uint, images []*models.RoomImage) error {
+	existing, err := s.roomImageRepo.FindByRoomID(roomID)
 	if err != nil {
+		return err
+	}
+
+	hasPrimary := false
+	nextOrder := 1
+	for _, image := range existing {
+		hasPrimary = hasPrimary || image.IsPrimary
+		nextOrder = max(nextOrder, image.SortOrder+1)
+	}
+
+	primaryChosen := false
+	for i, image := range images {
+		image.SortOrder = nextOrder + i
+		if image.IsPrimary && !primaryChosen {
+			primaryChosen = true
+			continue
+		}
+		image.IsPrimary = false
+	}
+	if !hasPrimary && !primaryChosen && len(images) > 0 {
+		images[0].IsPrimary = true
+	}
+	return nil
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// UploadRoomImages: Memvalidasi dan memproses beberapa gambar sekaligus lalu menyimpan file asli
+// beserta varian thumb/card/full/webp ke FileStorage (Admin Only).
+// Semua atau tidak sama sekali: satu file tidak valid menggagalkan seluruh upload.
+func (s *roomServiceImpl) UploadRoomImages(roomID uint, uploads []RoomImageUpload) ([]models.RoomImage, error) {
+	// Verifikasi kamar ada sebelum memproses file
+	if _, err := s.roomRepo.FindByID(roomID); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("gambar tidak ditemukan")
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	if len(uploads) == 0 {
+		return nil, models.ErrInvalidImage
+	}
+	if len(uploads) > s.cfg.UploadMaxFiles {
+		return nil, models.ErrTooManyImages
+	}
+
+	// Proses semua file terlebih dahulu agar tidak ada yang tersimpan jika salah satu ditolak
+	maxBytes := int64(s.cfg.UploadMaxImageMB) << 20
+	processed := make([]*imaging.Result, len(uploads))
+	for i, upload := range uploads {
+		// Tolak lebih awal jika ukuran dari header multipart sudah melebihi batas
+		if upload.Size > maxBytes {
+			return nil, models.ErrImageTooLarge
+		}
+		// Tipe file ditentukan dari isi file (bukan Content-Type/ekstensi dari client)
+		result, err := imaging.Process(upload.File, imaging.Options{MaxBytes: maxBytes})
+		if err != nil {
+			return nil, imageProcessingError(err)
+		}
+		processed[i] = result
+	}
+
+	ctx := context.Background()
+	var storedKeys []string
+	// Jangan tinggalkan file yatim jika salah satu langkah gagal
+	cleanup := func() {
+		for _, key := range storedKeys {
+			_ = s.fileStorage.Delete(ctx, key)
 		}
+	}
+
+	images := make([]*models.RoomImage, len(uploads))
+	for i, upload := range uploads {
+		image := &models.RoomImage{
+			RoomID:    roomID,
+			AltText:   upload.AltText,
+			Caption:   upload.Caption,
+			IsPrimary: upload.IsPrimary,
+			Width:     processed[i].Original.Width,
+			Height:    processed[i].Original.Height,
+		}
+		keys, err := s.storeImageFiles(ctx, roomID, upload.Filename, processed[i], image)
+		storedKeys = append(storedKeys, keys...)
+		if err != nil {
+			cleanup()
+			return nil, err
+		}
+		images[i] = image
+	}
+
+	if err := s.arrangeNewImages(roomID, images); err != nil {
+		cleanup()
+		return nil, err
+	}
+	if err := s.roomImageRepo.CreateMany(images); err != nil {
+		cleanup()
+		return nil, err
+	}
+
+	result := make([]models.RoomImage, len(images))
+	for i, image := range images {
+		s.resolveImageURL(image)
+		result[i] = *image
+	}
+	return result, nil
+}
+
+// Helper: storeImageFiles menyimpan file asli dan semua varian lalu mengisi key-nya di image.
+// Mengembalikan key yang sudah tersimpan (juga saat gagal) agar pemanggil bisa membersihkannya.
+func (s *roomServiceImpl) storeImageFiles(ctx context.Context, roomID uint, filename string, processed *imaging.Result, image *models.RoomImage) ([]string, error) {
+	// Semua file satu gambar berbagi prefix: rooms/<roomID>_<timestamp>_<nama>[_<varian>].<ext>
+	name := strings.TrimSuffix(filepath.Base(filename), filepath.Ext(filename))
+	prefix := fmt.Sprintf("rooms/%d_%d_%s", roomID, time.Now().UnixNano(), unsafeFilenameChars.ReplaceAllString(name, "_"))
+
+	var stored []string
+	store := func(key string, encoded imaging.Encoded) error {
+		if err := s.fileStorage.Put(ctx, key, bytes.NewReader(encoded.Data), int64(len(encoded.Data)), encoded.ContentType); err != nil {
+			return err
+		}
+		stored = append(stored, key)
+		return nil
+	}
+
+	image.StorageKey = prefix + "." + processed.Original.Ext
+	if err := store(image.StorageKey, processed.Original); err != nil {
+		return stored, err
+	}
+	for _, variant := range processed.Variants {
+		key := prefix + "_" + variant.Name + "." + variant.Ext
+		if err := store(key, variant); err != nil {
+			return stored, err
+		}
+		switch variant.Name {
+		case "thumb":
+			image.ThumbKey = key
+		case "card":
+			image.CardKey = key
+		case "full":
+			image.FullKey = key
+		case "webp":
+			image.WebpKey = key
+		}
+	}
+
+	// ImageURL wajib diisi (NOT NULL); URL final di-resolve ulang setiap kali dibaca
+	image.ImageURL = s.storageURL(0, image.StorageKey, "")
+	return stored, nil
+}
+
+// Helper: imageProcessingError memetakan error pkg/imaging ke error domain
+func imageProcessingError(err error) error {
+	switch {
+	case errors.Is(err, imaging.ErrTooLarge), errors.Is(err, imaging.ErrTooManyPixels):
+		return models.ErrImageTooLarge
+	case errors.Is(err, imaging.ErrUnsupportedType), errors.Is(err, imaging.ErrCorrupt):
+		return models.ErrInvalidImage
+	}
+	return err
+}
+
+// UpdateRoomImage: Mengubah alt text, caption, atau menjadikan gambar primary (Admin Only)
+func (s *roomServiceImpl) UpdateRoomImage(roomID, imageID uint, update RoomImageUpdate) (*models.RoomImage, error) {
+	image, err := s.roomImageRepo.FindByID(imageID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomImageNotFound
+		}
+		return nil, err
+	}
+	// Gambar harus milik kamar pada URL
+	if image.RoomID != roomID {
+		return nil, models.ErrRoomImageNotFound
+	}
+
+	if update.AltText != nil {
+		image.AltText = *update.AltText
+	}
+	if update.Caption != nil {
+		image.Caption = *update.Caption
+	}
+	if update.IsPrimary {
+		image.IsPrimary = true
+	}
+
+	// Repository melepas primary lama dalam transaksi yang sama
+	if err := s.roomImageRepo.Update(image); err != nil {
+		return nil, err
+	}
+	s.resolveImageURL(image)
+	return image, nil
+}
+
+// ReorderRoomImages: Menyimpan urutan galeri dan (opsional) mengganti gambar primary (Admin Only).
+// imageIDs harus berisi semua gambar kamar tepat satu kali.
+func (s *roomServiceImpl) ReorderRoomImages(roomID uint, imageIDs []uint, primaryImageID uint) ([]models.RoomImage, error) {
+	if _, err := s.roomRepo.FindByID(roomID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomNotFound
+		}
+		return nil, err
+	}
+
+	existing, err := s.roomImageRepo.FindByRoomID(roomID)
+	if err != nil {
+		return nil, err
+	}
+	if len(imageIDs) != len(existing) {
+		return nil, models.ErrInvalidImageOrder
+	}
+
+	remaining := make(map[uint]bool, len(existing))
+	for _, image := range existing {
+		remaining[image.ID] = true
+	}
+	if primaryImageID != 0 && !remaining[primaryImageID] {
+		return nil, models.ErrRoomImageNotFound
+	}
+	for _, id := range imageIDs {
+		// ID asing atau duplikat
+		if !remaining[id] {
+			return nil, models.ErrInvalidImageOrder
+		}
+		delete(remaining, id)
+	}
+
+	if err := s.roomImageRepo.Reorder(roomID, imageIDs, primaryImageID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrRoomImageNotFound
+		}
+		return nil, err
+	}
+
+	images, err := s.roomImageRepo.FindByRoomID(roomID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range images {
+		s.resolveImageURL(&images[i])
+	}
+	return images, nil
+}
+
+// DeleteRoomImage: Menghapus satu gambar kamar beserta file-nya (Admin Only)
+func (s *roomServiceImpl) DeleteRoomImage(roomID, imageID uint) error {
+	image, err := s.roomImageRepo.FindByID(imageID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrRoomImageNotFound
+		}
+		return err
+	}
+	if image.RoomID != roomID {
+		return models.ErrRoomImageNotFound
+	}
+
+	if err := s.roomImageRepo.Delete(imageID); err != nil {
 		return err
 	}
-	return s.roomImageRepo.Delete(imageID)
+	s.deleteStoredFile(image)
+
+	// Gambar primary dihapus: gambar pertama yang tersisa menjadi primary
+	if image.IsPrimary {
+		remaining, err := s.roomImageRepo.FindByRoomID(image.RoomID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 {
+			remaining[0].IsPrimary = true
+			return s.roomImageRepo.Update(&remaining[0])
+		}
+	}
+	return nil
 }
 
-// DeleteRoomImages: Menghapus semua gambar kamar tertentu (Admin Only)
+// DeleteRoomImages: Menghapus semua gambar kamar tertentu beserta file-nya (Admin Only)
 func (s *roomServiceImpl) DeleteRoomImages(roomID uint) error {
-	return s.roomImageRepo.DeleteByRoomID(roomID)
+	images, err := s.roomImageRepo.FindByRoomID(roomID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.roomImageRepo.DeleteByRoomID(roomID); err != nil {
+		return err
+	}
+	for i := range images {
+		s.deleteStoredFile(&images[i])
+	}
+	return nil
+}
+
+// Helper: deleteStoredFile menghapus file asli dan semua varian di storage;
+// kegagalan hanya di-log karena record sudah terhapus
+func (s *roomServiceImpl) deleteStoredFile(image *models.RoomImage) {
+	for _, key := range []string{image.StorageKey, image.ThumbKey, image.CardKey, image.FullKey, image.WebpKey} {
+		if key == "" {
+			continue
+		}
+		if err := s.fileStorage.Delete(context.Background(), key); err != nil {
+			log.Printf("gagal menghapus file %s: %v", key, err)
+		}
+	}
 }
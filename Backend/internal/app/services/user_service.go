@@ -4,12 +4,34 @@ import "backend/internal/domain/models"
 
 type UserService interface {
 	GetUserProfile(userID uint) (*models.User, error)
-	UpdateUserProfile(userID uint, fullName, email, username string) (*models.User, error)
+	// UpdateUserProfile hanya mengubah field yang diisi; username & email dicek keunikannya
+	UpdateUserProfile(userID uint, fullName, email, username, language string) (*models.User, error)
+
+	// Self-service member
+	// Aksi sensitif butuh bukti identitas (lihat models.Reauthentication); akun tanpa password (login OIDC)
+	// memakai kode 2FA atau login ulang lewat provider
+	// ChangePassword memverifikasi password lama; akun tanpa password bisa mengatur password pertamanya
+	ChangePassword(userID uint, proof models.Reauthentication, newPassword string) error
+	// RequestEmailChange mengirim link verifikasi ke email baru; email akun berubah setelah link dibuka
+	RequestEmailChange(userID uint, newEmail string, proof models.Reauthentication) (*models.EmailChangeRequest, error)
+	ConfirmEmailChange(token string) (*models.User, error)
+	// DeleteOwnAccount menghapus & menganonimkan akun sendiri setelah identitas diverifikasi
+	DeleteOwnAccount(userID uint, proof models.Reauthentication) error
+
+	// Data tamu tersimpan untuk mengisi form booking
+	GetSavedGuests(userID uint) ([]models.SavedGuest, error)
+	GetSavedGuest(userID, guestID uint) (*models.SavedGuest, error)
+	CreateSavedGuest(userID uint, guest *models.SavedGuest) (*models.SavedGuest, error)
+	// UpdateSavedGuest: telepon & nomor identitas yang dikosongkan tidak diubah (client hanya melihat versi tersamar)
+	UpdateSavedGuest(userID, guestID uint, input *models.SavedGuest) (*models.SavedGuest, error)
+	DeleteSavedGuest(userID, guestID uint) error
 
 	// Admin-only methods
-	GetAllUsers(pagination *models.Pagination) ([]models.User, int64, error)
+	GetAllUsers(filter *models.UserFilter, pagination *models.Pagination) ([]models.User, int64, error)
 	GetUserByID(userID uint) (*models.User, error)
 	CreateUserByAdmin(user *models.User) (*models.User, error)
 	UpdateUserByAdmin(userID uint, fullName, email, username, role string) (*models.User, error)
-	DeleteUserByAdmin(userID uint) error
+	// DeactivateUserByAdmin menggantikan hapus permanen; akun sendiri dan admin aktif terakhir dilindungi
+	DeactivateUserByAdmin(actorID, userID uint) (*models.User, error)
+	ReactivateUserByAdmin(userID uint) (*models.User, error)
 }
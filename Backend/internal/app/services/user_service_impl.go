@@ -1,70 +1,383 @@
 package services
 
 import (
+	"backend/internal/config"
+	"backend/internal/domain/mailer"
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
 type userServiceImpl struct {
-	userRepo repositories.UserRepository
+	userRepo        repositories.UserRepository
+	savedGuestRepo  repositories.SavedGuestRepository
+	emailChangeRepo repositories.EmailChangeRepository
+	privacyService  PrivacyService
+	authService     AuthService
+	mailer          mailer.Mailer
+	cfg             *config.Config
 }
 
-func NewUserService(userRepo repositories.UserRepository) UserService {
-	return &userServiceImpl{userRepo: userRepo}
+func NewUserService(
+	userRepo repositories.UserRepository,
+	savedGuestRepo repositories.SavedGuestRepository,
+	emailChangeRepo repositories.EmailChangeRepository,
+	privacyService PrivacyService,
+	authService AuthService,
+	mailer mailer.Mailer,
+	cfg *config.Config,
+) UserService {
+	return &userServiceImpl{
+		userRepo:        userRepo,
+		savedGuestRepo:  savedGuestRepo,
+		emailChangeRepo: emailChangeRepo,
+		privacyService:  privacyService,
+		authService:     authService,
+		mailer:          mailer,
+		cfg:             cfg,
+	}
 }
 
-func (s *userServiceImpl) GetUserProfile(userID uint) (*models.User, error) {
+// findUser memuat user dan memetakan record kosong ke ErrUserNotFound
+func (s *userServiceImpl) findUser(userID uint) (*models.User, error) {
 	user, err := s.userRepo.FindByID(userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("pengguna tidak ditemukan")
+			return nil, models.ErrUserNotFound
 		}
 		return nil, err
 	}
+	return user, nil
+}
+
+// ensureUnique memastikan username & email belum dipakai user lain (excludeID = user yang sedang diubah).
+// Index unik di database tetap menjadi pengaman terakhir jika terjadi balapan request.
+func (s *userServiceImpl) ensureUnique(username, email string, excludeID uint) error {
+	if username != "" {
+		existing, err := s.userRepo.FindByUsername(username)
+		if err == nil && existing.ID != excludeID {
+			return models.ErrUsernameTaken
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+	if email != "" {
+		existing, err := s.userRepo.FindByEmail(email)
+		if err == nil && existing.ID != excludeID {
+			return models.ErrEmailTaken
+		}
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureNotLastAdmin menolak perubahan yang membuat sistem tidak punya admin grup aktif
+func (s *userServiceImpl) ensureNotLastAdmin(user *models.User) error {
+	if user.Role != models.RoleAdmin || !user.IsGroupAdmin || user.DeactivatedAt != nil {
+		return nil
+	}
+	count, err := s.userRepo.CountActiveAdmins()
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return models.ErrLastAdmin
+	}
+	return nil
+}
+
+func (s *userServiceImpl) save(user *models.User) (*models.User, error) {
+	if err := s.userRepo.Update(user); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrUserAlreadyExists
+		}
+		return nil, err
+	}
+	// Sembunyikan password sebelum dikembalikan
+	user.Password = ""
+	return user, nil
+}
+
+func (s *userServiceImpl) GetUserProfile(userID uint) (*models.User, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
 	// Sembunyikan password
 	user.Password = ""
 	return user, nil
 }
 
-func (s *userServiceImpl) UpdateUserProfile(userID uint, fullName, email, username string) (*models.User, error) {
-	user, err := s.userRepo.FindByID(userID)
+func (s *userServiceImpl) UpdateUserProfile(userID uint, fullName, email, username, language string) (*models.User, error) {
+	user, err := s.findUser(userID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("pengguna tidak ditemukan")
-		}
 		return nil, err
 	}
 
-	// Update field jika ada isinya
-	if fullName != "" {
+	email = strings.TrimSpace(email)
+	username = strings.TrimSpace(username)
+	if err := s.ensureUnique(username, email, user.ID); err != nil {
+		return nil, err
+	}
+
+	// Update field jika ada isinya (field kosong tidak menimpa data lama)
+	if fullName = strings.TrimSpace(fullName); fullName != "" {
 		user.FullName = fullName
 	}
 	if email != "" {
-		// Disini bisa ditambahkan validasi apakah email sudah dipakai user lain
 		user.Email = email
 	}
 	if username != "" {
-		// Disini bisa ditambahkan validasi apakah username sudah dipakai user lain
 		user.Username = username
 	}
+	if language != "" {
+		user.Language = language
+	}
 
-	if err := s.userRepo.Update(user); err != nil {
+	return s.save(user)
+}
+
+// --- Self-service member ---
+
+// reauthenticate memastikan pemilik akun sendiri yang melakukan aksi sensitif (lihat verifyReauthentication)
+func (s *userServiceImpl) reauthenticate(user *models.User, proof models.Reauthentication) error {
+	return verifyReauthentication(user, proof, func(code string) error {
+		return s.authService.VerifySecondFactor(user.ID, code)
+	})
+}
+
+// verifyReauthentication dipakai bersama UserService dan AuthService.
+// Akun tanpa password (dibuat lewat login OIDC) memakai kode 2FA atau token akses yang baru diterbitkan
+// (login ulang lewat provider), sehingga token lama yang dicuri saja tidak cukup.
+func verifyReauthentication(user *models.User, proof models.Reauthentication, verifyCode func(code string) error) error {
+	if user.Password != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(proof.Password)); err != nil {
+			return models.ErrInvalidCurrentPassword
+		}
+		return nil
+	}
+	if user.TwoFactorEnabled && proof.Code != "" {
+		return verifyCode(proof.Code)
+	}
+	if !proof.IssuedAt.IsZero() && time.Since(proof.IssuedAt) <= models.ReauthWindow {
+		return nil
+	}
+	return models.ErrReauthRequired
+}
+
+// notify mengirim email pemberitahuan dalam bahasa user; kegagalan hanya dicatat di log
+func (s *userServiceImpl) notify(to, language, subjectID, bodyID, subjectEN, bodyEN string) {
+	msg := mailer.Message{To: to, Subject: subjectID, Body: bodyID}
+	if language == "en" {
+		msg.Subject, msg.Body = subjectEN, bodyEN
+	}
+	if err := s.mailer.Send(context.Background(), msg); err != nil {
+		log.Printf("⚠️ Gagal mengirim email ke %s: %v", to, err)
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *userServiceImpl) ChangePassword(userID uint, proof models.Reauthentication, newPassword string) error {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return err
+	}
+	if err := s.reauthenticate(user, proof); err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	user.Password = string(hashedPassword)
+	if _, err := s.save(user); err != nil {
+		return err
+	}
+
+	s.notify(user.Email, user.Language,
+		"Password akun Anda telah diubah",
+		"Password akun "+user.Username+" baru saja diubah. Jika bukan Anda yang mengubahnya, segera hubungi hotel.",
+		"Your account password was changed",
+		"The password for "+user.Username+" was just changed. If this wasn't you, please contact the hotel immediately.")
+	return nil
+}
+
+func (s *userServiceImpl) RequestEmailChange(userID uint, newEmail string, proof models.Reauthentication) (*models.EmailChangeRequest, error) {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reauthenticate(user, proof); err != nil {
 		return nil, err
 	}
 
-	// Sembunyikan password sebelum dikembalikan
-	user.Password = ""
-	return user, nil
+	newEmail = strings.TrimSpace(newEmail)
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, models.ErrEmailUnchanged
+	}
+	if err := s.ensureUnique("", newEmail, user.ID); err != nil {
+		return nil, err
+	}
+
+	token := randomURLToken()
+	request := &models.EmailChangeRequest{
+		UserID:    user.ID,
+		NewEmail:  newEmail,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(models.EmailChangeTTL),
+	}
+	if err := s.emailChangeRepo.Replace(request); err != nil {
+		return nil, err
+	}
+
+	// Link verifikasi dikirim ke email baru (membuktikan kepemilikan); email lama hanya diberi tahu
+	link := fmt.Sprintf("%s/account/verify-email?token=%s", s.cfg.FrontendURL, url.QueryEscape(token))
+	msg := mailer.Message{
+		To:      newEmail,
+		Subject: "Verifikasi email baru Anda",
+		Body:    "Buka link berikut dalam 24 jam untuk memakai email ini di akun " + user.Username + ":\n" + link,
+	}
+	if user.Language == "en" {
+		msg.Subject = "Verify your new email address"
+		msg.Body = "Open this link within 24 hours to use this email for " + user.Username + ":\n" + link
+	}
+	if err := s.mailer.Send(context.Background(), msg); err != nil {
+		return nil, err
+	}
+
+	s.notify(user.Email, user.Language,
+		"Permintaan perubahan email",
+		"Ada permintaan mengganti email akun "+user.Username+" ke "+newEmail+". Email tidak berubah sampai alamat baru diverifikasi.",
+		"Email change requested",
+		"A request was made to change the email of "+user.Username+" to "+newEmail+". Nothing changes until the new address is verified.")
+	return request, nil
+}
+
+func (s *userServiceImpl) ConfirmEmailChange(token string) (*models.User, error) {
+	request, err := s.emailChangeRepo.FindByTokenHash(hashToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrInvalidEmailToken
+		}
+		return nil, err
+	}
+	// Email bisa saja sudah dipakai akun lain sejak link dikirim
+	if err := s.ensureUnique("", request.NewEmail, request.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.emailChangeRepo.Complete(request); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrEmailTaken
+		}
+		return nil, err
+	}
+	return s.GetUserProfile(request.UserID)
+}
+
+func (s *userServiceImpl) DeleteOwnAccount(userID uint, proof models.Reauthentication) error {
+	user, err := s.findUser(userID)
+	if err != nil {
+		return err
+	}
+	if err := s.reauthenticate(user, proof); err != nil {
+		return err
+	}
+	_, err = s.privacyService.DeleteAccount(userID)
+	return err
+}
+
+func (s *userServiceImpl) GetSavedGuests(userID uint) ([]models.SavedGuest, error) {
+	return s.savedGuestRepo.FindByUserID(userID)
+}
+
+func (s *userServiceImpl) GetSavedGuest(userID, guestID uint) (*models.SavedGuest, error) {
+	guest, err := s.savedGuestRepo.FindByID(guestID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrSavedGuestNotFound
+		}
+		return nil, err
+	}
+	// Data tamu milik member lain diperlakukan seperti tidak ada
+	if guest.UserID != userID {
+		return nil, models.ErrSavedGuestNotFound
+	}
+	return guest, nil
+}
+
+func (s *userServiceImpl) CreateSavedGuest(userID uint, guest *models.SavedGuest) (*models.SavedGuest, error) {
+	count, err := s.savedGuestRepo.CountByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= models.MaxSavedGuests {
+		return nil, models.ErrSavedGuestLimit
+	}
+
+	guest.ID = 0
+	guest.UserID = userID
+	// Data tamu pertama otomatis menjadi default
+	if count == 0 {
+		guest.IsDefault = true
+	}
+	if err := s.savedGuestRepo.Save(guest); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+func (s *userServiceImpl) UpdateSavedGuest(userID, guestID uint, input *models.SavedGuest) (*models.SavedGuest, error) {
+	guest, err := s.GetSavedGuest(userID, guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	guest.Label = input.Label
+	guest.FullName = input.FullName
+	guest.Email = input.Email
+	guest.IsDefault = input.IsDefault
+	if input.Phone != "" {
+		guest.Phone = input.Phone
+	}
+	if input.IDNumber != "" {
+		guest.IDNumber = input.IDNumber
+	}
+	if err := s.savedGuestRepo.Save(guest); err != nil {
+		return nil, err
+	}
+	return guest, nil
+}
+
+func (s *userServiceImpl) DeleteSavedGuest(userID, guestID uint) error {
+	if _, err := s.GetSavedGuest(userID, guestID); err != nil {
+		return err
+	}
+	return s.savedGuestRepo.Delete(guestID)
 }
 
 // --- Admin-only methods implementation ---
 
-func (s *userServiceImpl) GetAllUsers(pagination *models.Pagination) ([]models.User, int64, error) {
-	users, total, err := s.userRepo.FindAll(pagination)
+func (s *userServiceImpl) GetAllUsers(filter *models.UserFilter, pagination *models.Pagination) ([]models.User, int64, error) {
+	users, total, err := s.userRepo.FindAll(filter, pagination)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -76,18 +389,21 @@ func (s *userServiceImpl) GetAllUsers(pagination *models.Pagination) ([]models.U
 }
 
 func (s *userServiceImpl) GetUserByID(userID uint) (*models.User, error) {
-	user, err := s.userRepo.FindByID(userID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("pengguna tidak ditemukan")
-		}
-		return nil, err
-	}
-	user.Password = ""
-	return user, nil
+	return s.GetUserProfile(userID)
 }
 
 func (s *userServiceImpl) CreateUserByAdmin(user *models.User) (*models.User, error) {
+	user.Username = strings.TrimSpace(user.Username)
+	user.Email = strings.TrimSpace(user.Email)
+	if err := s.ensureUnique(user.Username, user.Email, 0); err != nil {
+		return nil, err
+	}
+	if user.Role == "" {
+		user.Role = models.RoleMember
+	}
+	// Akses properti (atau status admin grup) diberikan terpisah lewat PUT /admin/users/:id/properties
+	user.IsGroupAdmin = false
+
 	// Hash Password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -97,6 +413,9 @@ func (s *userServiceImpl) CreateUserByAdmin(user *models.User) (*models.User, er
 
 	// Simpan ke Database
 	if err := s.userRepo.Create(user); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, models.ErrUserAlreadyExists
+		}
 		return nil, err
 	}
 	user.Password = ""
@@ -104,16 +423,30 @@ func (s *userServiceImpl) CreateUserByAdmin(user *models.User) (*models.User, er
 }
 
 func (s *userServiceImpl) UpdateUserByAdmin(userID uint, fullName, email, username, role string) (*models.User, error) {
-	user, err := s.userRepo.FindByID(userID)
+	user, err := s.findUser(userID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("pengguna tidak ditemukan")
-		}
 		return nil, err
 	}
 
+	email = strings.TrimSpace(email)
+	username = strings.TrimSpace(username)
+	if err := s.ensureUnique(username, email, user.ID); err != nil {
+		return nil, err
+	}
+	// Menurunkan role admin aktif terakhir akan mengunci semua orang dari menu admin
+	if role != "" && role != user.Role {
+		if err := s.ensureNotLastAdmin(user); err != nil {
+			return nil, err
+		}
+		user.Role = role
+		// Status admin grup tidak ikut terbawa jika role diturunkan lalu dinaikkan lagi
+		if role != models.RoleAdmin {
+			user.IsGroupAdmin = false
+		}
+	}
+
 	// Update field jika ada isinya
-	if fullName != "" {
+	if fullName = strings.TrimSpace(fullName); fullName != "" {
 		user.FullName = fullName
 	}
 	if email != "" {
@@ -122,28 +455,40 @@ func (s *userServiceImpl) UpdateUserByAdmin(userID uint, fullName, email, userna
 	if username != "" {
 		user.Username = username
 	}
-	if role != "" && (role == models.RoleAdmin || role == models.RoleMember) {
-		user.Role = role
+
+	return s.save(user)
+}
+
+// DeactivateUserByAdmin: user tidak bisa login lagi, booking & riwayatnya tetap tersimpan.
+// Token yang sudah terbit langsung ditolak: status akun dicek setiap request di route /member dan /account
+// (ActiveUserMiddleware) serta /admin (PropertyScopeMiddleware lewat GetAdminScope).
+func (s *userServiceImpl) DeactivateUserByAdmin(actorID, userID uint) (*models.User, error) {
+	if actorID == userID {
+		return nil, models.ErrCannotDeactivateSelf
 	}
 
-	if err := s.userRepo.Update(user); err != nil {
+	user, err := s.findUser(userID)
+	if err != nil {
 		return nil, err
 	}
-	user.Password = ""
-	return user, nil
+	if user.DeactivatedAt != nil {
+		user.Password = ""
+		return user, nil
+	}
+	if err := s.ensureNotLastAdmin(user); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user.DeactivatedAt = &now
+	return s.save(user)
 }
 
-func (s *userServiceImpl) DeleteUserByAdmin(userID uint) error {
-	// Tambahkan pengecekan, misal tidak bisa hapus diri sendiri
-	// if userID == currentUser.ID { ... }
-	
-	_, err := s.userRepo.FindByID(userID)
+func (s *userServiceImpl) ReactivateUserByAdmin(userID uint) (*models.User, error) {
+	user, err := s.findUser(userID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errors.New("pengguna tidak ditemukan")
-		}
-		return err
+		return nil, err
 	}
-
-	return s.userRepo.Delete(userID)
+	user.DeactivatedAt = nil
+	return s.save(user)
 }
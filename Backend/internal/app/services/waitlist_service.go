@@ -0,0 +1,27 @@
+package services
+
+import (
+	"backend/internal/domain/models"
+	"time"
+)
+
+// WaitlistService mengelola daftar tunggu untuk tanggal yang penuh. Kamar yang kosong karena pembatalan
+// ditawarkan ke antrean terdepan lewat link klaim berbatas waktu; selama itu kamar ditahan untuk member tersebut.
+type WaitlistService interface {
+	// Untuk Member
+	JoinWaitlist(entry *models.WaitlistEntry) (*models.WaitlistEntry, error) // Ditolak jika kamar masih tersedia
+	GetUserEntries(userID uint) ([]models.WaitlistEntry, error)
+	CancelEntry(entryID uint, userID uint) error // Penawaran yang dibatalkan langsung diteruskan ke antrean berikutnya
+	// FindOffer mengambil penawaran aktif milik member dari token link klaim
+	FindOffer(token string, userID uint) (*models.WaitlistEntry, error)
+	CompleteClaim(entry *models.WaitlistEntry, bookingID uint) error
+
+	// Dipakai BookingService & scheduler
+	IsHeld(roomID uint, checkInDate, checkOutDate string, userID uint) (bool, error)
+	// ProcessWaitlist mengakhiri penawaran yang kedaluwarsa lalu menawarkan kamar kosong ke antrean terdepan;
+	// mengembalikan jumlah penawaran baru
+	ProcessWaitlist(now time.Time) (int, error)
+
+	// Untuk Admin
+	GetAllEntries(filter *models.WaitlistFilter, pagination *models.Pagination) ([]models.WaitlistEntry, error)
+}
@@ -0,0 +1,286 @@
+package services
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain/mailer"
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type waitlistServiceImpl struct {
+	waitlistRepo repositories.WaitlistRepository
+	roomRepo     repositories.RoomRepository
+	userRepo     repositories.UserRepository
+	mailer       mailer.Mailer
+	cfg          *config.Config
+}
+
+func NewWaitlistService(
+	waitlistRepo repositories.WaitlistRepository,
+	roomRepo repositories.RoomRepository,
+	userRepo repositories.UserRepository,
+	mailer mailer.Mailer,
+	cfg *config.Config,
+) WaitlistService {
+	return &waitlistServiceImpl{
+		waitlistRepo: waitlistRepo,
+		roomRepo:     roomRepo,
+		userRepo:     userRepo,
+		mailer:       mailer,
+		cfg:          cfg,
+	}
+}
+
+func (s *waitlistServiceImpl) JoinWaitlist(entry *models.WaitlistEntry) (*models.WaitlistEntry, error) {
+	checkIn, checkOut := entry.CheckInDate.Format("2006-01-02"), entry.CheckOutDate.Format("2006-01-02")
+	if checkIn < today() {
+		return nil, models.ErrWaitlistCheckInPast
+	}
+	if checkOut <= checkIn {
+		return nil, models.ErrMinimumStay
+	}
+
+	// Kamar tertentu: properti & kapasitas diambil dari kamar; tipe kamar: harus ada di properti tersebut
+	if entry.RoomID != nil {
+		room, err := s.roomRepo.FindByID(*entry.RoomID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, models.ErrRoomNotFound
+			}
+			return nil, err
+		}
+		if err := room.CheckOccupancy(entry.Party()); err != nil {
+			return nil, err
+		}
+		entry.PropertyID = room.PropertyID
+		entry.RoomType = ""
+	} else {
+		rooms, err := s.roomRepo.FindAll(&models.RoomFilter{PropertyID: entry.PropertyID, Type: entry.RoomType}, &models.Pagination{Limit: 1, Sort: "id"})
+		if err != nil {
+			return nil, err
+		}
+		if len(rooms) == 0 {
+			return nil, models.ErrRoomNotFound
+		}
+	}
+
+	existing, err := s.waitlistRepo.FindByUserID(entry.UserID)
+	if err != nil {
+		return nil, err
+	}
+	active := 0
+	for _, other := range existing {
+		if !other.IsActive() {
+			continue
+		}
+		active++
+		if sameRoomRequest(&other, entry) &&
+			other.CheckInDate.Format("2006-01-02") == checkIn && other.CheckOutDate.Format("2006-01-02") == checkOut {
+			return nil, models.ErrWaitlistDuplicate
+		}
+	}
+	if active >= models.MaxWaitlistEntries {
+		return nil, models.ErrWaitlistLimit
+	}
+
+	// Daftar tunggu hanya untuk tanggal yang penuh
+	room, err := s.findAvailableRoom(entry)
+	if err != nil {
+		return nil, err
+	}
+	if room != nil {
+		return nil, models.ErrWaitlistRoomAvailable
+	}
+
+	entry.Status = models.WaitlistWaiting
+	if err := s.waitlistRepo.Create(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// sameRoomRequest mengecek apakah dua antrean meminta kamar / tipe kamar yang sama
+func sameRoomRequest(a, b *models.WaitlistEntry) bool {
+	if a.RoomID != nil || b.RoomID != nil {
+		return a.RoomID != nil && b.RoomID != nil && *a.RoomID == *b.RoomID
+	}
+	return a.PropertyID == b.PropertyID && a.RoomType == b.RoomType
+}
+
+// findAvailableRoom mencari kamar yang kosong (tidak dibooking & tidak ditahan) untuk antrean; nil = penuh
+func (s *waitlistServiceImpl) findAvailableRoom(entry *models.WaitlistEntry) (*models.Room, error) {
+	filter := &models.RoomFilter{
+		PropertyID: entry.PropertyID,
+		Type:       entry.RoomType,
+		Adults:     entry.Adults,
+		Children:   entry.Children,
+	}
+	if entry.RoomID != nil {
+		filter.RoomID = *entry.RoomID
+	}
+	rooms, err := s.roomRepo.FindAvailable(entry.CheckInDate.Format("2006-01-02"), entry.CheckOutDate.Format("2006-01-02"),
+		filter, &models.Pagination{Limit: 1, Sort: "price asc"})
+	if err != nil {
+		return nil, err
+	}
+	if len(rooms) == 0 {
+		return nil, nil
+	}
+	return &rooms[0], nil
+}
+
+func (s *waitlistServiceImpl) GetUserEntries(userID uint) ([]models.WaitlistEntry, error) {
+	return s.waitlistRepo.FindByUserID(userID)
+}
+
+func (s *waitlistServiceImpl) CancelEntry(entryID uint, userID uint) error {
+	entry, err := s.waitlistRepo.FindByID(entryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.ErrWaitlistNotFound
+		}
+		return err
+	}
+	if entry.UserID != userID {
+		return models.ErrWaitlistNotFound
+	}
+	if !entry.IsActive() {
+		return models.ErrWaitlistNotActive
+	}
+
+	wasOffered := entry.Status == models.WaitlistOffered
+	entry.Status = models.WaitlistCancelled
+	if err := s.waitlistRepo.Update(entry); err != nil {
+		return err
+	}
+
+	// Kamar yang ditahan langsung ditawarkan ke antrean berikutnya
+	if wasOffered {
+		if _, err := s.ProcessWaitlist(time.Now()); err != nil {
+			log.Printf("⚠️ Gagal memproses daftar tunggu: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *waitlistServiceImpl) FindOffer(token string, userID uint) (*models.WaitlistEntry, error) {
+	entry, err := s.waitlistRepo.FindByOfferTokenHash(hashToken(token))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, models.ErrInvalidWaitlistOffer
+		}
+		return nil, err
+	}
+	// Link hanya berlaku untuk member yang ditawari
+	if entry.UserID != userID {
+		return nil, models.ErrInvalidWaitlistOffer
+	}
+	if entry.Status != models.WaitlistOffered || entry.OfferExpiresAt == nil || !entry.OfferExpiresAt.After(time.Now()) {
+		return nil, models.ErrWaitlistOfferExpired
+	}
+	return entry, nil
+}
+
+func (s *waitlistServiceImpl) CompleteClaim(entry *models.WaitlistEntry, bookingID uint) error {
+	entry.Status = models.WaitlistClaimed
+	entry.BookingID = &bookingID
+	return s.waitlistRepo.Update(entry)
+}
+
+func (s *waitlistServiceImpl) IsHeld(roomID uint, checkInDate, checkOutDate string, userID uint) (bool, error) {
+	return s.waitlistRepo.IsHeld(roomID, checkInDate, checkOutDate, userID)
+}
+
+func (s *waitlistServiceImpl) ProcessWaitlist(now time.Time) (int, error) {
+	if _, err := s.waitlistRepo.Expire(now); err != nil {
+		return 0, err
+	}
+
+	// Antrean diproses berurutan; setiap penawaran langsung tersimpan sehingga kamarnya tertahan dan tidak ikut
+	// ditawarkan ke antrean berikutnya. Instance lain yang memproses bersamaan diserialkan oleh Offer
+	// (kunci baris kamar di database), bukan oleh mutex per proses.
+	entries, err := s.waitlistRepo.FindWaiting()
+	if err != nil {
+		return 0, err
+	}
+	offered := 0
+	for i := range entries {
+		room, err := s.findAvailableRoom(&entries[i])
+		if err != nil {
+			return offered, err
+		}
+		if room == nil {
+			continue
+		}
+		ok, err := s.offer(&entries[i], room, now)
+		if err != nil {
+			return offered, err
+		}
+		if ok {
+			offered++
+		}
+	}
+	return offered, nil
+}
+
+// offer menahan kamar untuk antrean lalu mengirim link klaim ke email member.
+// false jika kamar keburu ditahan untuk antrean lain atau antrean sudah diproses instance lain
+// (antrean dicoba lagi pada proses berikutnya).
+func (s *waitlistServiceImpl) offer(entry *models.WaitlistEntry, room *models.Room, now time.Time) (bool, error) {
+	token := randomURLToken()
+	expiresAt := now.Add(models.WaitlistOfferTTL)
+	entry.Status = models.WaitlistOffered
+	entry.OfferedRoomID = &room.ID
+	entry.OfferTokenHash = hashToken(token)
+	entry.OfferedAt = &now
+	entry.OfferExpiresAt = &expiresAt
+	ok, err := s.waitlistRepo.Offer(entry)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	// Email dikirim di luar request (misalnya pembatalan booking yang melepas kamar); kegagalan hanya dicatat di log
+	go s.sendOffer(*entry, *room, token)
+	return true, nil
+}
+
+// sendOffer mengirim link klaim penawaran ke email member dalam bahasa member
+func (s *waitlistServiceImpl) sendOffer(entry models.WaitlistEntry, room models.Room, token string) {
+	user, err := s.userRepo.FindByID(entry.UserID)
+	if err != nil {
+		log.Printf("⚠️ Penawaran daftar tunggu #%d: member tidak ditemukan: %v", entry.ID, err)
+		return
+	}
+
+	link := fmt.Sprintf("%s/waitlist/claim?token=%s", s.cfg.FrontendURL, url.QueryEscape(token))
+	checkIn, checkOut := entry.CheckInDate.Format("2006-01-02"), entry.CheckOutDate.Format("2006-01-02")
+	expiresAt := entry.OfferExpiresAt.Format("2006-01-02 15:04")
+	msg := mailer.Message{
+		To:      user.Email,
+		Subject: "Kamar tersedia dari daftar tunggu Anda",
+		Body: fmt.Sprintf("Kamar %s (%s) kini tersedia untuk %s s/d %s dan kami tahan untuk Anda sampai %s.\n"+
+			"Klaim lewat link berikut sebelum kamar ditawarkan ke tamu berikutnya:\n%s",
+			room.RoomNumber, room.Type, checkIn, checkOut, expiresAt, link),
+	}
+	if user.Language == "en" {
+		msg.Subject = "A room from your waitlist is available"
+		msg.Body = fmt.Sprintf("Room %s (%s) is now available for %s to %s and is held for you until %s.\n"+
+			"Claim it with the link below before it is offered to the next guest:\n%s",
+			room.RoomNumber, room.Type, checkIn, checkOut, expiresAt, link)
+	}
+	if err := s.mailer.Send(context.Background(), msg); err != nil {
+		log.Printf("⚠️ Gagal mengirim email ke %s: %v", user.Email, err)
+	}
+}
+
+func (s *waitlistServiceImpl) GetAllEntries(filter *models.WaitlistFilter, pagination *models.Pagination) ([]models.WaitlistEntry, error) {
+	return s.waitlistRepo.FindAll(filter, pagination)
+}
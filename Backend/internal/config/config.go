@@ -4,22 +4,86 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	ServerPort string
-	DBHost     string
-	DBPort     string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	JWTSecret  string
+	ServerPort  string
+	DBHost      string
+	DBPort      string
+	DBUser      string
+	DBPassword  string
+	DBName      string
+	JWTSecret   string
 	JWTExpHours int
+
+	// File Storage (gambar kamar)
+	StorageDriver        string // "local" atau "s3"
+	StorageLocalDir      string
+	StoragePublicBaseURL string
+	StorageSigningKey    string // Jika diisi, URL file akan ditandatangani dan kadaluarsa
+	StorageURLTTLMinutes int
+	S3Endpoint           string
+	S3Region             string
+	S3Bucket             string
+	S3AccessKey          string
+	S3SecretKey          string
+	S3UseSSL             bool
+
+	// Upload gambar
+	UploadMaxImageMB int // Ukuran maksimal satu file gambar
+	UploadMaxFiles   int // Jumlah file maksimal dalam satu request upload galeri
+
+	// Enkripsi data pribadi tamu (nomor identitas & telepon)
+	FieldEncryptionKeys string // "id:base64key,..."; kunci pertama aktif, sisanya untuk data lama (rotasi)
+	FieldIndexKey       string // Kunci HMAC blind index untuk pencarian; jangan dirotasi
+
+	// Rate limiting & proteksi brute-force login (limit 0 = nonaktif)
+	RateLimitStore         string // "memory" (satu instance) atau "redis" (dibagi antar instance)
+	RedisAddr              string
+	RedisPassword          string
+	RedisDB                int
+	ProxyHeader            string // Header IP asli client jika di belakang reverse proxy, contoh "X-Forwarded-For"
+	RateLimitAPI           int    // Request per menit per IP untuk seluruh /api
+	RateLimitSearch        int    // Pencarian kamar tersedia per menit per IP
+	RateLimitAuth          int    // Login/register per menit per IP
+	RateLimitLoginAccount  int    // Percobaan login per 15 menit per username
+	LoginLockoutThreshold  int    // Jumlah password salah berturut-turut sebelum akun dikunci
+	LoginLockoutMinutes    int    // Durasi kunci pertama; berlipat dua setiap kelipatan threshold berikutnya
+	LoginLockoutMaxMinutes int    // Batas atas durasi kunci
+
+	// Two-factor authentication (TOTP)
+	TwoFactorIssuer       string // Nama yang tampil di aplikasi authenticator
+	RequireAdminTwoFactor bool   // Wajibkan 2FA untuk semua user role admin (diaktifkan saat login berikutnya)
+
+	// Login OpenID Connect (Google, dll); kosong = fitur nonaktif
+	OIDCProviders []OIDCProviderConfig
+
+	// Email (verifikasi perubahan email, pemberitahuan akun)
+	MailDriver   string // "log" (hanya ditulis ke log) atau "smtp"
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string // Contoh "Luxury Hotel <no-reply@hotel.com>"
+	FrontendURL  string // Basis URL link di email, contoh "https://hotel.com"
+}
+
+// OIDCProviderConfig adalah konfigurasi satu provider OpenID Connect.
+// Dibaca dari OIDC_PROVIDERS=google,... lalu OIDC_<NAMA>_* untuk tiap provider.
+type OIDCProviderConfig struct {
+	Name         string // Dipakai di URL, contoh "google"
+	DisplayName  string // Label tombol login, contoh "Google"
+	IssuerURL    string // Contoh "https://accounts.google.com" (endpoint dibaca dari discovery)
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string   // Halaman frontend yang menerima ?code=&state= dari provider
+	Scopes       []string // Default: openid email profile
 }
 
-func LoadConfig() *Config{
+func LoadConfig() *Config {
 	err := godotenv.Load()
 	if err != nil {
 		log.Println("Perhatian: file .env tidak ditemukan, menggunakan environment variables sistem.")
@@ -30,14 +94,118 @@ func LoadConfig() *Config{
 		expHours = 24
 	}
 
+	urlTTL, err := strconv.Atoi(os.Getenv("STORAGE_URL_TTL_MINUTES"))
+	if err != nil {
+		urlTTL = 60
+	}
+
+	maxImageMB, err := strconv.Atoi(os.Getenv("UPLOAD_MAX_IMAGE_MB"))
+	if err != nil || maxImageMB <= 0 {
+		maxImageMB = 10
+	}
+
+	maxFiles, err := strconv.Atoi(os.Getenv("UPLOAD_MAX_FILES"))
+	if err != nil || maxFiles <= 0 {
+		maxFiles = 10
+	}
+
 	return &Config{
-		ServerPort: os.Getenv("SERVER_PORT"),
-		DBHost:     os.Getenv("DB_HOST"),
-		DBPort:     os.Getenv("DB_PORT"),
-		DBUser:     os.Getenv("DB_USER"),
-		DBPassword: os.Getenv("DB_PASSWORD"),
-		DBName:     os.Getenv("DB_NAME"),
-		JWTSecret:  os.Getenv("JWT_SECRET_KEY"),
+		ServerPort:  os.Getenv("SERVER_PORT"),
+		DBHost:      os.Getenv("DB_HOST"),
+		DBPort:      os.Getenv("DB_PORT"),
+		DBUser:      os.Getenv("DB_USER"),
+		DBPassword:  os.Getenv("DB_PASSWORD"),
+		DBName:      os.Getenv("DB_NAME"),
+		JWTSecret:   os.Getenv("JWT_SECRET_KEY"),
 		JWTExpHours: expHours,
+
+		StorageDriver:        getEnv("STORAGE_DRIVER", "local"),
+		StorageLocalDir:      getEnv("STORAGE_LOCAL_DIR", "./uploads"),
+		StoragePublicBaseURL: getEnv("STORAGE_PUBLIC_BASE_URL", "/uploads"),
+		StorageSigningKey:    os.Getenv("STORAGE_SIGNING_KEY"),
+		StorageURLTTLMinutes: urlTTL,
+		S3Endpoint:           os.Getenv("S3_ENDPOINT"),
+		S3Region:             getEnv("S3_REGION", "us-east-1"),
+		S3Bucket:             os.Getenv("S3_BUCKET"),
+		S3AccessKey:          os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:          os.Getenv("S3_SECRET_KEY"),
+		S3UseSSL:             os.Getenv("S3_USE_SSL") == "true",
+
+		UploadMaxImageMB: maxImageMB,
+		UploadMaxFiles:   maxFiles,
+
+		FieldEncryptionKeys: os.Getenv("FIELD_ENCRYPTION_KEYS"),
+		FieldIndexKey:       os.Getenv("FIELD_INDEX_KEY"),
+
+		RateLimitStore:         getEnv("RATE_LIMIT_STORE", "memory"),
+		RedisAddr:              getEnv("REDIS_ADDR", "localhost:6379"),
+		RedisPassword:          os.Getenv("REDIS_PASSWORD"),
+		RedisDB:                getEnvInt("REDIS_DB", 0),
+		ProxyHeader:            os.Getenv("PROXY_HEADER"),
+		RateLimitAPI:           getEnvInt("RATE_LIMIT_API_PER_MINUTE", 300),
+		RateLimitSearch:        getEnvInt("RATE_LIMIT_SEARCH_PER_MINUTE", 30),
+		RateLimitAuth:          getEnvInt("RATE_LIMIT_AUTH_PER_MINUTE", 10),
+		RateLimitLoginAccount:  getEnvInt("RATE_LIMIT_LOGIN_ACCOUNT_PER_15_MINUTES", 20),
+		LoginLockoutThreshold:  getEnvInt("LOGIN_LOCKOUT_THRESHOLD", 5),
+		LoginLockoutMinutes:    getEnvInt("LOGIN_LOCKOUT_MINUTES", 1),
+		LoginLockoutMaxMinutes: getEnvInt("LOGIN_LOCKOUT_MAX_MINUTES", 60),
+
+		TwoFactorIssuer:       getEnv("TWO_FACTOR_ISSUER", "Luxury Hotel"),
+		RequireAdminTwoFactor: os.Getenv("REQUIRE_ADMIN_2FA") == "true",
+
+		OIDCProviders: loadOIDCProviders(),
+
+		MailDriver:   getEnv("MAIL_DRIVER", "log"),
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     getEnvInt("SMTP_PORT", 587),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+		SMTPPassword: os.Getenv("SMTP_PASSWORD"),
+		MailFrom:     os.Getenv("MAIL_FROM"),
+		FrontendURL:  strings.TrimRight(getEnv("FRONTEND_URL", "http://localhost:5173"), "/"),
+	}
+}
+
+// getEnv mengambil environment variable dengan nilai default
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
 	}
-}
\ No newline at end of file
+	return fallback
+}
+
+// getEnvInt mengambil environment variable angka (>= 0) dengan nilai default
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value < 0 {
+		return fallback
+	}
+	return value
+}
+
+// loadOIDCProviders membaca provider dari OIDC_PROVIDERS; provider tanpa issuer/client ID dilewati
+func loadOIDCProviders() []OIDCProviderConfig {
+	var providers []OIDCProviderConfig
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		provider := OIDCProviderConfig{
+			Name:         name,
+			DisplayName:  getEnv(prefix+"DISPLAY_NAME", strings.ToUpper(name[:1])+name[1:]),
+			IssuerURL:    os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(getEnv(prefix+"SCOPES", "openid email profile")),
+		}
+		if provider.IssuerURL == "" || provider.ClientID == "" {
+			log.Printf("Perhatian: provider OIDC %q dilewati karena %sISSUER / %sCLIENT_ID kosong", name, prefix, prefix)
+			continue
+		}
+		providers = append(providers, provider)
+	}
+	return providers
+}
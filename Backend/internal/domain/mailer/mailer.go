@@ -0,0 +1,16 @@
+package mailer
+
+import "context"
+
+// Message adalah email teks sederhana untuk satu penerima
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer adalah kontrak pengiriman email (verifikasi email, pemberitahuan akun).
+// Implementasi "log" hanya menulis ke log server untuk pengembangan.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
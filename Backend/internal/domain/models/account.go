@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// MaxSavedGuests adalah batas data tamu tersimpan per member
+const MaxSavedGuests = 10
+
+// SavedGuest adalah data tamu tersimpan milik member untuk mengisi form booking lebih cepat.
+// Telepon & nomor identitas terenkripsi seperti data tamu di booking; dihapus permanen (tanpa soft delete).
+type SavedGuest struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	UserID    uint            `gorm:"not null;index"`
+	Label     string          `gorm:"type:varchar(50)"` // Contoh "Saya sendiri", "Ibu"
+	FullName  string          `gorm:"type:varchar(255);not null"`
+	Email     string          `gorm:"type:varchar(255)"`
+	Phone     SensitiveString `gorm:"type:varchar(255);serializer:encrypted"`
+	IDNumber  SensitiveString `gorm:"type:varchar(255);serializer:encrypted"`
+	IsDefault bool            // Dipilih otomatis di form booking
+}
+
+// EmailChangeTTL adalah masa berlaku link verifikasi email baru
+const EmailChangeTTL = 24 * time.Hour
+
+// EmailChangeRequest menyimpan email baru yang menunggu verifikasi.
+// Satu member hanya punya satu permintaan aktif; token hanya disimpan hash SHA-256.
+type EmailChangeRequest struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UserID    uint      `gorm:"not null;uniqueIndex"`
+	NewEmail  string    `gorm:"type:varchar(100);not null"`
+	TokenHash string    `gorm:"type:char(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time `gorm:"not null"`
+}
+
+// ReauthWindow adalah batas umur token akses yang masih dianggap login ulang baru-baru ini
+const ReauthWindow = 5 * time.Minute
+
+// Reauthentication adalah bukti identitas untuk aksi sensitif akun sendiri (ganti password/email, hapus akun).
+// Akun ber-password memakai Password. Akun tanpa password (dibuat lewat login OIDC) memakai Code jika 2FA
+// aktif, atau token akses yang diterbitkan kurang dari ReauthWindow lalu (login ulang lewat provider).
+type Reauthentication struct {
+	Password string
+	Code     string    // Kode TOTP atau recovery code
+	IssuedAt time.Time // Claim iat token akses
+}
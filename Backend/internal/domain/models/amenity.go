@@ -0,0 +1,11 @@
+package models
+
+import "gorm.io/gorm"
+
+// Amenity adalah fasilitas kamar yang bisa difilter tamu (wifi, bathtub, sea view, dll)
+type Amenity struct {
+	gorm.Model
+	Name     string `gorm:"type:varchar(100);unique;not null"`
+	Icon     string `gorm:"type:varchar(50)"`          // Nama ikon di frontend, contoh "wifi"
+	Category string `gorm:"type:varchar(50);not null"` // Contoh: "view", "bathroom", "technology"
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MaxGroupRooms adalah jumlah kamar maksimal dalam satu reservasi grup
+const MaxGroupRooms = 20
+
+// BookingGroup adalah reservasi grup (keluarga/korporat): beberapa kamar dengan satu tamu utama
+// dan satu pembayaran. Setiap kamar tetap berupa Booking biasa dengan GroupID terisi,
+// sehingga check-in, housekeeping, dan laporan tetap bekerja per kamar.
+type BookingGroup struct {
+	gorm.Model
+	Code       string `gorm:"type:varchar(20);uniqueIndex;not null"` // Kode reservasi untuk tamu & front desk
+	UserID     uint   `gorm:"not null;index"`                        // Member pemesan
+	PropertyID uint   `gorm:"not null;index"`                        // Semua kamar di satu properti (satu mata uang)
+	Name       string `gorm:"type:varchar(100)"`                     // Contoh: "Keluarga Santoso", "PT Maju Jaya"
+
+	// Tamu utama (penanggung jawab grup); data tamu per kamar ada di rooming list (Booking)
+	LeadGuestID    *uint           `gorm:"index"`
+	LeadGuestName  string          `gorm:"type:varchar(255);not null"`
+	LeadGuestEmail string          `gorm:"type:varchar(255);not null"`
+	LeadGuestPhone SensitiveString `gorm:"type:varchar(255);not null;serializer:encrypted"`
+
+	SpecialRequests string `gorm:"type:text"`
+	PaymentMethod   string `gorm:"type:varchar(50)"`
+	PaymentStatus   string `gorm:"type:enum('pending', 'paid', 'failed', 'refunded');default:'pending'"`
+	Status          string `gorm:"type:enum('confirmed', 'cancelled');default:'confirmed'"`
+
+	Bookings []Booking `gorm:"foreignKey:GroupID"`
+
+	// Total harga kamar yang tidak dibatalkan, diisi service
+	TotalPrice float64 `gorm:"-"`
+}
+
+// GroupRoom adalah satu kamar yang diminta saat membuat reservasi grup
+type GroupRoom struct {
+	RoomID       uint
+	CheckInDate  time.Time
+	CheckOutDate time.Time
+	Party        Party
+	GuestName    string // Penghuni kamar (kosong = tamu utama), bisa diubah lewat rooming list
+}
+
+// RoomingListEntry adalah data penghuni satu kamar dalam grup
+type RoomingListEntry struct {
+	BookingID     uint
+	GuestName     string
+	GuestEmail    string
+	GuestPhone    string
+	GuestIDNumber string
+}
+
+// ActiveBookings mengembalikan kamar grup yang tidak dibatalkan
+func (g *BookingGroup) ActiveBookings() []*Booking {
+	active := make([]*Booking, 0, len(g.Bookings))
+	for i := range g.Bookings {
+		if g.Bookings[i].BookingStatus != StatusCancelled {
+			active = append(active, &g.Bookings[i])
+		}
+	}
+	return active
+}
@@ -0,0 +1,47 @@
+package models
+
+import "time"
+
+// BookingChange berisi perubahan booking yang diminta; field kosong/nol = tidak diubah
+type BookingChange struct {
+	RoomID       uint
+	CheckInDate  time.Time
+	CheckOutDate time.Time
+	Adults       int
+	Children     *int // nil = tidak diubah (0 berarti tanpa anak)
+	ExtraBeds    *int
+	Reason       string
+}
+
+// BookingModification adalah riwayat satu kali perubahan booking (tanggal, kamar, jumlah tamu).
+// Nilai lama & baru disimpan lengkap sehingga riwayat tetap terbaca walau booking diubah lagi.
+type BookingModification struct {
+	ID         uint `gorm:"primarykey"`
+	CreatedAt  time.Time
+	BookingID  uint   `gorm:"not null;index"`
+	ModifiedBy uint   `gorm:"not null"` // Member pemilik booking atau staf
+	Reason     string `gorm:"type:varchar(255)"`
+
+	OldRoomID         uint
+	NewRoomID         uint
+	OldCheckInDate    time.Time `gorm:"type:date"`
+	NewCheckInDate    time.Time `gorm:"type:date"`
+	OldCheckOutDate   time.Time `gorm:"type:date"`
+	NewCheckOutDate   time.Time `gorm:"type:date"`
+	OldNumberOfGuests int
+	NewNumberOfGuests int
+	OldAdults         int
+	NewAdults         int
+	OldChildren       int
+	NewChildren       int
+	OldExtraBeds      int
+	NewExtraBeds      int
+	OldTotalPrice     float64 `gorm:"type:decimal(10,2)"`
+	NewTotalPrice     float64 `gorm:"type:decimal(10,2)"`
+
+	// Selisih harga: positif = tagihan tambahan, negatif = refund ke tamu
+	PriceDifference float64 `gorm:"type:decimal(10,2)"`
+	// Transaksi selisih (adjustment/refund); nil jika belum ada pembayaran yang perlu disesuaikan
+	PaymentID *uint
+	Payment   *Payment `gorm:"foreignKey:PaymentID"`
+}
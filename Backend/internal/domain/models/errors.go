@@ -0,0 +1,239 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// --- Kategori Error ---
+// Setiap DomainError membungkus salah satu kategori ini sehingga handler
+// cukup memakai errors.Is(err, models.ErrNotFound) untuk menentukan status HTTP.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrForbidden       = errors.New("forbidden")
+	ErrConflict        = errors.New("conflict")
+	ErrValidation      = errors.New("validation")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// DomainError adalah error bisnis dengan kode stabil yang bisa dibaca mesin
+type DomainError struct {
+	Code    string // Contoh: "ROOM_NOT_FOUND"
+	Message string // Pesan default untuk ditampilkan ke user
+	Kind    error  // Salah satu kategori di atas
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func (e *DomainError) Unwrap() error {
+	return e.Kind
+}
+
+func newDomainError(kind error, code, message string) *DomainError {
+	return &DomainError{Code: code, Message: message, Kind: kind}
+}
+
+// NewNotFoundError membuat error kategori NotFound
+func NewNotFoundError(code, message string) *DomainError {
+	return newDomainError(ErrNotFound, code, message)
+}
+
+// NewForbiddenError membuat error kategori Forbidden
+func NewForbiddenError(code, message string) *DomainError {
+	return newDomainError(ErrForbidden, code, message)
+}
+
+// NewConflictError membuat error kategori Conflict
+func NewConflictError(code, message string) *DomainError {
+	return newDomainError(ErrConflict, code, message)
+}
+
+// NewValidationError membuat error kategori Validation
+func NewValidationError(code, message string) *DomainError {
+	return newDomainError(ErrValidation, code, message)
+}
+
+// NewUnauthorizedError membuat error kategori Unauthorized
+func NewUnauthorizedError(code, message string) *DomainError {
+	return newDomainError(ErrUnauthorized, code, message)
+}
+
+// NewTooManyRequestsError membuat error kategori TooManyRequests
+func NewTooManyRequestsError(code, message string) *DomainError {
+	return newDomainError(ErrTooManyRequests, code, message)
+}
+
+// RetryAfterError menambahkan waktu tunggu pada DomainError (dikirim sebagai header Retry-After)
+type RetryAfterError struct {
+	*DomainError
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.DomainError
+}
+
+// WithRetryAfter membungkus err dengan waktu tunggu sebelum request boleh diulang
+func WithRetryAfter(err *DomainError, retryAfter time.Duration) *RetryAfterError {
+	return &RetryAfterError{DomainError: err, RetryAfter: retryAfter}
+}
+
+// --- Custom Errors ---
+var (
+	ErrRecordNotFound = gorm.ErrRecordNotFound
+
+	// Auth & User
+	ErrInvalidCredentials   = NewUnauthorizedError("INVALID_CREDENTIALS", "username atau password salah")
+	ErrUserNotFound         = NewNotFoundError("USER_NOT_FOUND", "pengguna tidak ditemukan")
+	ErrAccountLocked        = NewTooManyRequestsError("ACCOUNT_LOCKED", "akun dikunci sementara karena terlalu banyak percobaan login gagal")
+	ErrRateLimited          = NewTooManyRequestsError("RATE_LIMITED", "terlalu banyak request, coba lagi nanti")
+	ErrUserAlreadyExists    = NewConflictError("USER_ALREADY_EXISTS", "username atau email sudah digunakan")
+	ErrUsernameTaken        = NewConflictError("USERNAME_TAKEN", "username sudah digunakan")
+	ErrEmailTaken           = NewConflictError("EMAIL_TAKEN", "email sudah digunakan")
+	ErrAccountDeactivated   = NewForbiddenError("ACCOUNT_DEACTIVATED", "akun sudah dinonaktifkan")
+	ErrStaffRoleRequired    = NewForbiddenError("FORBIDDEN_ROLE", "anda tidak memiliki akses ke resource ini")
+	ErrLastAdmin            = NewConflictError("LAST_ADMIN", "admin grup aktif terakhir tidak bisa dinonaktifkan atau diubah role maupun aksesnya")
+	ErrCannotDeactivateSelf = NewConflictError("CANNOT_DEACTIVATE_SELF", "tidak bisa menonaktifkan akun sendiri")
+
+	// Akun Member (self-service)
+	ErrInvalidCurrentPassword = NewValidationError("INVALID_CURRENT_PASSWORD", "password saat ini salah")
+	ErrReauthRequired         = NewForbiddenError("REAUTH_REQUIRED", "masukkan kode 2FA atau login ulang lewat provider untuk melanjutkan")
+	ErrEmailUnchanged         = NewValidationError("EMAIL_UNCHANGED", "email baru sama dengan email saat ini")
+	ErrInvalidEmailToken      = NewValidationError("INVALID_EMAIL_TOKEN", "link verifikasi email tidak valid atau sudah kedaluwarsa")
+	ErrSavedGuestNotFound     = NewNotFoundError("SAVED_GUEST_NOT_FOUND", "data tamu tersimpan tidak ditemukan")
+	ErrSavedGuestLimit        = NewConflictError("SAVED_GUEST_LIMIT", "jumlah data tamu tersimpan sudah mencapai batas")
+
+	// Two-Factor Authentication
+	ErrInvalidChallenge         = NewUnauthorizedError("INVALID_TWO_FACTOR_CHALLENGE", "sesi verifikasi 2FA tidak valid atau sudah kedaluwarsa")
+	ErrInvalidTwoFactorCode     = NewUnauthorizedError("INVALID_TWO_FACTOR_CODE", "kode 2FA atau recovery code salah")
+	ErrTwoFactorAlreadyEnabled  = NewConflictError("TWO_FACTOR_ALREADY_ENABLED", "2FA sudah aktif")
+	ErrTwoFactorNotEnabled      = NewConflictError("TWO_FACTOR_NOT_ENABLED", "2FA belum aktif")
+	ErrTwoFactorSetupNotStarted = NewConflictError("TWO_FACTOR_SETUP_NOT_STARTED", "mulai setup 2FA terlebih dahulu")
+	ErrTwoFactorRequired        = NewForbiddenError("TWO_FACTOR_MANDATORY", "2FA wajib untuk akun ini dan tidak bisa dinonaktifkan")
+
+	// Login OpenID Connect
+	ErrOIDCProviderNotFound  = NewNotFoundError("OIDC_PROVIDER_NOT_FOUND", "provider login tidak tersedia")
+	ErrInvalidOIDCState      = NewUnauthorizedError("INVALID_OIDC_STATE", "sesi login provider tidak valid atau sudah kedaluwarsa")
+	ErrOIDCLoginFailed       = NewUnauthorizedError("OIDC_LOGIN_FAILED", "login melalui provider gagal")
+	ErrOIDCEmailNotVerified  = NewValidationError("OIDC_EMAIL_NOT_VERIFIED", "provider tidak memberikan email yang terverifikasi")
+	ErrOIDCAccountExists     = NewConflictError("OIDC_ACCOUNT_EXISTS", "email sudah terdaftar; login dengan password lalu hubungkan akun dari pengaturan akun")
+	ErrIdentityAlreadyLinked = NewConflictError("IDENTITY_ALREADY_LINKED", "akun provider ini sudah terhubung ke akun lain")
+	ErrIdentityNotFound      = NewNotFoundError("IDENTITY_NOT_FOUND", "identitas login tidak ditemukan")
+	ErrLastLoginMethod       = NewConflictError("LAST_LOGIN_METHOD", "tidak bisa melepas satu-satunya cara login; atur password terlebih dahulu")
+
+	// Room & Gambar
+	ErrRoomNotFound      = NewNotFoundError("ROOM_NOT_FOUND", "kamar tidak ditemukan")
+	ErrRoomImageNotFound = NewNotFoundError("ROOM_IMAGE_NOT_FOUND", "gambar tidak ditemukan")
+	ErrInvalidRoomData   = NewValidationError("INVALID_ROOM_DATA", "data kamar tidak lengkap atau tidak valid")
+	ErrRoomNumberTaken   = NewConflictError("ROOM_NUMBER_TAKEN", "nomor kamar sudah digunakan di properti ini")
+	ErrInvalidImage      = NewValidationError("INVALID_IMAGE", "file harus berupa gambar jpeg, png, atau webp yang valid")
+	ErrImageTooLarge     = NewValidationError("IMAGE_TOO_LARGE", "ukuran atau dimensi gambar melebihi batas")
+	ErrTooManyImages     = NewValidationError("TOO_MANY_IMAGES", "jumlah gambar dalam satu upload melebihi batas")
+	ErrInvalidImageOrder = NewValidationError("INVALID_IMAGE_ORDER", "urutan gambar harus berisi semua gambar kamar tepat satu kali")
+
+	// Property
+	ErrPropertyNotFound       = NewNotFoundError("PROPERTY_NOT_FOUND", "properti tidak ditemukan")
+	ErrPropertyAlreadyExists  = NewConflictError("PROPERTY_ALREADY_EXISTS", "kode properti sudah digunakan")
+	ErrPropertyForbidden      = NewForbiddenError("PROPERTY_FORBIDDEN", "anda tidak memiliki akses ke properti ini")
+	ErrPropertyRequired       = NewValidationError("PROPERTY_REQUIRED", "properti wajib dipilih")
+	ErrPropertyHasRooms       = NewConflictError("PROPERTY_HAS_ROOMS", "properti yang masih memiliki kamar tidak dapat dihapus")
+	ErrInvalidTimezone        = NewValidationError("INVALID_TIMEZONE", "zona waktu tidak dikenal")
+	ErrPropertyAssignNonStaff = NewValidationError("PROPERTY_ASSIGN_NON_STAFF", "akses properti hanya bisa diberikan ke staf")
+	ErrNoPropertyAssigned     = NewForbiddenError("NO_PROPERTY_ASSIGNED", "akun belum ditugaskan ke properti mana pun")
+	ErrGroupAdminRoleRequired = NewValidationError("GROUP_ADMIN_ROLE_REQUIRED", "hanya role admin yang bisa menjadi admin grup")
+
+	// Housekeeping
+	ErrRoomNotReady            = NewConflictError("ROOM_NOT_READY", "kamar belum selesai dibersihkan")
+	ErrTaskNotFound            = NewNotFoundError("HOUSEKEEPING_TASK_NOT_FOUND", "tugas housekeeping tidak ditemukan")
+	ErrInvalidTaskTransition   = NewConflictError("INVALID_TASK_TRANSITION", "status tugas tidak bisa diubah dari status saat ini")
+	ErrTaskAssignedToOther     = NewForbiddenError("TASK_ASSIGNED_TO_OTHER", "tugas ini ditugaskan ke staf lain")
+	ErrInvalidTaskAssignee     = NewValidationError("INVALID_TASK_ASSIGNEE", "tugas hanya bisa diberikan ke staf housekeeping")
+	ErrCheckInNotAllowed       = NewConflictError("CHECK_IN_NOT_ALLOWED", "booking hanya bisa check-in saat confirmed dan dalam periode menginap")
+	ErrBookingAlreadyCheckedIn = NewConflictError("BOOKING_ALREADY_CHECKED_IN", "tamu sudah check-in")
+
+	// Report
+	ErrInvalidReportPeriod = NewValidationError("INVALID_REPORT_PERIOD", "tanggal akhir laporan harus setelah tanggal awal")
+
+	// Amenity
+	ErrAmenityNotFound      = NewNotFoundError("AMENITY_NOT_FOUND", "fasilitas tidak ditemukan")
+	ErrAmenityAlreadyExists = NewConflictError("AMENITY_ALREADY_EXISTS", "nama fasilitas sudah digunakan")
+
+	// Booking
+	ErrBookingNotFound         = NewNotFoundError("BOOKING_NOT_FOUND", "booking tidak ditemukan")
+	ErrBookingForbidden        = NewForbiddenError("BOOKING_FORBIDDEN", "anda tidak memiliki izin mengakses pemesanan ini")
+	ErrRoomAlreadyBooked       = NewConflictError("ROOM_ALREADY_BOOKED", "kamar sudah dibooking pada periode tersebut")
+	ErrInvalidDateFormat       = NewValidationError("INVALID_DATE_FORMAT", "format tanggal check-in/out tidak valid")
+	ErrMinimumStay             = NewValidationError("MINIMUM_STAY", "durasi pemesanan minimal 1 malam")
+	ErrBookingAlreadyCompleted = NewConflictError("BOOKING_ALREADY_COMPLETED", "pemesanan yang sudah selesai tidak dapat dibatalkan")
+	ErrBookingAlreadyCancelled = NewConflictError("BOOKING_ALREADY_CANCELLED", "pemesanan sudah dibatalkan sebelumnya")
+	ErrBookingNotCancelled     = NewConflictError("BOOKING_NOT_CANCELLED", "hanya booking yang cancelled yang bisa dihapus")
+	ErrBookingAlreadyPaid      = NewConflictError("BOOKING_ALREADY_PAID", "booking yang sudah dibayar tidak bisa dihapus")
+	ErrBookingNotModifiable    = NewConflictError("BOOKING_NOT_MODIFIABLE", "booking ini tidak bisa diubah")
+	ErrBookingInHouseChange    = NewConflictError("BOOKING_IN_HOUSE_CHANGE", "tamu sudah check-in, hanya tanggal check-out dan jumlah tamu yang bisa diubah")
+	ErrBookingUnchanged        = NewValidationError("BOOKING_UNCHANGED", "tidak ada perubahan pada booking")
+	ErrModificationDatePast    = NewValidationError("MODIFICATION_DATE_PAST", "tanggal baru tidak boleh sebelum hari ini")
+	ErrRoomPropertyMismatch    = NewValidationError("ROOM_PROPERTY_MISMATCH", "kamar pengganti harus berada di properti yang sama")
+	ErrOccupancyExceeded       = NewValidationError("OCCUPANCY_EXCEEDED", "jumlah tamu melebihi kapasitas kamar")
+	ErrExtraBedsExceeded       = NewValidationError("EXTRA_BEDS_EXCEEDED", "jumlah extra bed melebihi batas kamar")
+	ErrAdultRequired           = NewValidationError("ADULT_REQUIRED", "minimal satu tamu dewasa per kamar")
+
+	// Reservasi Grup
+	ErrGroupNotFound         = NewNotFoundError("GROUP_NOT_FOUND", "reservasi grup tidak ditemukan")
+	ErrGroupRoomCount        = NewValidationError("GROUP_ROOM_COUNT", "reservasi grup berisi 2 sampai 20 kamar")
+	ErrGroupPropertyMismatch = NewValidationError("GROUP_PROPERTY_MISMATCH", "semua kamar dalam reservasi grup harus berada di properti yang sama")
+	ErrGroupRoomConflict     = NewValidationError("GROUP_ROOM_CONFLICT", "kamar yang sama diminta lebih dari sekali pada tanggal yang bertumpuk")
+	ErrGroupAlreadyCancelled = NewConflictError("GROUP_ALREADY_CANCELLED", "reservasi grup sudah dibatalkan")
+	ErrGroupNotCancellable   = NewConflictError("GROUP_NOT_CANCELLABLE", "reservasi grup tidak bisa dibatalkan karena ada kamar yang sudah check-in atau selesai")
+	ErrGroupAlreadyPaid      = NewConflictError("GROUP_ALREADY_PAID", "reservasi grup sudah dibayar")
+	ErrGroupBookingPayment   = NewConflictError("GROUP_BOOKING_PAYMENT", "kamar dalam reservasi grup dibayar lewat pembayaran grup")
+	ErrRoomingListBooking    = NewValidationError("ROOMING_LIST_INVALID_BOOKING", "booking tidak termasuk kamar aktif dalam reservasi grup ini")
+
+	// Daftar Tunggu
+	ErrWaitlistNotFound      = NewNotFoundError("WAITLIST_NOT_FOUND", "antrean daftar tunggu tidak ditemukan")
+	ErrWaitlistRoomAvailable = NewConflictError("WAITLIST_ROOM_AVAILABLE", "kamar masih tersedia pada tanggal tersebut, silakan langsung booking")
+	ErrWaitlistDuplicate     = NewConflictError("WAITLIST_DUPLICATE", "anda sudah masuk daftar tunggu untuk kamar dan tanggal yang sama")
+	ErrWaitlistLimit         = NewConflictError("WAITLIST_LIMIT", "maksimal 5 antrean daftar tunggu aktif per member")
+	ErrWaitlistNotActive     = NewConflictError("WAITLIST_NOT_ACTIVE", "antrean sudah tidak aktif")
+	ErrWaitlistCheckInPast   = NewValidationError("WAITLIST_CHECK_IN_PAST", "tanggal check-in tidak boleh sebelum hari ini")
+	ErrInvalidWaitlistOffer  = NewValidationError("INVALID_WAITLIST_OFFER", "link penawaran tidak valid")
+	ErrWaitlistOfferExpired  = NewConflictError("WAITLIST_OFFER_EXPIRED", "penawaran sudah kedaluwarsa atau sudah diklaim")
+
+	// Layanan Tambahan
+	ErrExtraNotFound         = NewNotFoundError("EXTRA_NOT_FOUND", "layanan tambahan tidak ditemukan")
+	ErrInvalidExtraPricing   = NewValidationError("INVALID_EXTRA_PRICING", "cara hitung harus per_night, per_person, atau per_stay dan harga tidak boleh negatif")
+	ErrExtraInactive         = NewConflictError("EXTRA_INACTIVE", "layanan tambahan sedang tidak dijual")
+	ErrExtraPropertyMismatch = NewValidationError("EXTRA_PROPERTY_MISMATCH", "layanan tambahan tidak tersedia di properti booking ini")
+	ErrInvalidExtraQuantity  = NewValidationError("INVALID_EXTRA_QUANTITY", "jumlah layanan tambahan harus 1 sampai 99")
+	ErrBookingExtraNotFound  = NewNotFoundError("BOOKING_EXTRA_NOT_FOUND", "layanan tambahan tidak ada di booking ini")
+	ErrBookingExtraForbidden = NewForbiddenError("BOOKING_EXTRA_FORBIDDEN", "layanan tambahan yang ditambahkan staf atau setelah check-in hanya bisa dihapus staf")
+
+	// Guest
+	ErrGuestNotFound      = NewNotFoundError("GUEST_NOT_FOUND", "profil tamu tidak ditemukan")
+	ErrGuestAlreadyExists = NewConflictError("GUEST_ALREADY_EXISTS", "email atau nomor identitas sudah dipakai profil tamu lain, gunakan merge")
+	ErrInvalidGuestMerge  = NewValidationError("INVALID_GUEST_MERGE", "profil tamu tidak bisa digabung ke dirinya sendiri")
+
+	// Data Pribadi (UU PDP)
+	ErrPrivacyRequestNotFound  = NewNotFoundError("PRIVACY_REQUEST_NOT_FOUND", "permintaan data pribadi tidak ditemukan")
+	ErrPrivacyRequestProcessed = NewConflictError("PRIVACY_REQUEST_PROCESSED", "permintaan sudah diproses")
+	ErrErasureAlreadyRequested = NewConflictError("ERASURE_ALREADY_REQUESTED", "permintaan penghapusan data masih menunggu persetujuan")
+	ErrErasureActiveBookings   = NewConflictError("ERASURE_ACTIVE_BOOKINGS", "data tidak bisa dihapus selama masih ada booking aktif")
+	ErrErasureNotAllowed       = NewForbiddenError("ERASURE_NOT_ALLOWED", "akun staf tidak bisa meminta penghapusan data")
+
+	// Review
+	ErrReviewNotFound            = NewNotFoundError("REVIEW_NOT_FOUND", "ulasan tidak ditemukan")
+	ErrReviewAlreadyExists       = NewConflictError("REVIEW_ALREADY_EXISTS", "anda sudah memberikan ulasan untuk pemesanan ini")
+	ErrReviewBookingNotCompleted = NewValidationError("REVIEW_BOOKING_NOT_COMPLETED", "ulasan hanya dapat dibuat untuk pemesanan yang sudah selesai")
+	ErrInvalidRating             = NewValidationError("INVALID_RATING", "rating harus antara 1 sampai 5")
+
+	// Payment
+	ErrPaymentNotFound       = NewNotFoundError("PAYMENT_NOT_FOUND", "pembayaran tidak ditemukan")
+	ErrPaymentNotRefundable  = NewConflictError("PAYMENT_NOT_REFUNDABLE", "hanya pembayaran yang sukses yang dapat di-refund")
+	ErrPaymentNotPending     = NewConflictError("PAYMENT_NOT_PENDING", "hanya pembayaran berstatus pending yang dapat diproses")
+	ErrPaymentAlreadyPending = NewConflictError("PAYMENT_ALREADY_PENDING", "booking masih memiliki tagihan pending; proses tagihan tersebut")
+	ErrBookingFullyPaid      = NewConflictError("BOOKING_FULLY_PAID", "booking sudah lunas")
+)
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// --- Cara Hitung Harga Layanan Tambahan ---
+const (
+	ExtraPerNight  = "per_night"  // Harga × jumlah malam (contoh: sarapan, parkir)
+	ExtraPerPerson = "per_person" // Harga × jumlah tamu, sekali per menginap (contoh: spa, antar-jemput bandara)
+	ExtraPerStay   = "per_stay"   // Harga sekali per menginap (contoh: late check-out)
+)
+
+// MaxExtraQuantity adalah batas jumlah satu layanan tambahan per penambahan
+const MaxExtraQuantity = 99
+
+// Extra adalah layanan tambahan yang dijual properti (sarapan, antar-jemput bandara, spa, late check-out)
+type Extra struct {
+	gorm.Model
+	PropertyID  uint    `gorm:"not null;index"`
+	Name        string  `gorm:"type:varchar(100);not null"`
+	Description string  `gorm:"type:text"`
+	PricingType string  `gorm:"type:enum('per_night', 'per_person', 'per_stay');not null"`
+	Price       float64 `gorm:"type:decimal(10,2);not null"`
+	IsActive    bool    `gorm:"default:true"` // Nonaktif = tidak bisa ditambahkan ke booking; booking lama tidak berubah
+}
+
+// IsValidPricingType mengecek cara hitung harga yang dikenal
+func IsValidPricingType(pricingType string) bool {
+	return pricingType == ExtraPerNight || pricingType == ExtraPerPerson || pricingType == ExtraPerStay
+}
+
+// BookingExtra adalah layanan tambahan yang dibeli untuk satu booking (sudah termasuk di TotalPrice).
+// Nama, cara hitung, dan harga disalin dari katalog sehingga perubahan katalog tidak mengubah tagihan lama.
+type BookingExtra struct {
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	BookingID   uint    `gorm:"not null;index"`
+	ExtraID     uint    `gorm:"not null;index"`
+	Name        string  `gorm:"type:varchar(100);not null"`
+	PricingType string  `gorm:"type:enum('per_night', 'per_person', 'per_stay');not null"`
+	UnitPrice   float64 `gorm:"type:decimal(10,2);not null"`
+	Quantity    int     `gorm:"default:1"`
+	Total       float64 `gorm:"type:decimal(10,2);not null"` // Dihitung ulang jika malam / jumlah tamu booking berubah
+	AddedBy     uint    `gorm:"not null"`                    // Member pemilik booking atau staf (penjualan di front desk)
+}
+
+// Units adalah pengali harga satuan sesuai cara hitung
+func (e *BookingExtra) Units(nights, guests int) int {
+	switch e.PricingType {
+	case ExtraPerNight:
+		return nights
+	case ExtraPerPerson:
+		return guests
+	default:
+		return 1
+	}
+}
+
+// Nights adalah jumlah malam menginap booking
+func (b *Booking) Nights() int {
+	return int(math.Ceil(b.CheckOutDate.Sub(b.CheckInDate).Hours() / 24))
+}
@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// RoomFilter berisi filter pencarian kamar (nilai kosong = tidak difilter)
+type RoomFilter struct {
+	AmenityIDs  []uint // Kamar harus punya SEMUA amenity ini
+	PropertyID  uint   // Filter satu properti dari query
+	PropertyIDs []uint // Batasan dari PropertyScope admin
+	ReadyOnly   bool   // Hanya kamar yang sudah bersih (untuk tamu yang datang hari ini)
+	Adults      int    // Kamar harus muat sejumlah dewasa ini (MaxAdults)
+	Children    int    // Bersama Adults: total tamu harus muat MaxOccupancy + MaxExtraBeds
+	RoomID      uint   // Satu kamar tertentu (daftar tunggu)
+	Type        string // Tipe kamar (daftar tunggu)
+}
+
+// BookingFilter berisi filter daftar booking untuk admin
+type BookingFilter struct {
+	PropertyID  uint
+	PropertyIDs []uint
+	GuestID     uint // Riwayat menginap satu profil tamu
+}
+
+// BookingGroupFilter berisi filter daftar reservasi grup
+type BookingGroupFilter struct {
+	UserID      uint // Reservasi milik member
+	PropertyID  uint
+	PropertyIDs []uint
+	Status      string
+}
+
+// ReportFilter berisi filter laporan (From inklusif, To eksklusif, berdasarkan tanggal check-in)
+type ReportFilter struct {
+	PropertyID  uint
+	PropertyIDs []uint
+	From        time.Time
+	To          time.Time
+}
+
+// Status akun untuk filter daftar user
+const (
+	UserStatusActive   = "active"
+	UserStatusInactive = "inactive"
+)
+
+// UserFilter berisi filter daftar user untuk admin
+type UserFilter struct {
+	Search string // Username, email, atau nama lengkap
+	Role   string
+	Status string // active / inactive
+}
+
+// WaitlistFilter berisi filter daftar tunggu untuk admin
+type WaitlistFilter struct {
+	PropertyID  uint
+	PropertyIDs []uint
+	Status      string
+}
+
+// ExtraFilter berisi filter katalog layanan tambahan
+type ExtraFilter struct {
+	PropertyID  uint
+	PropertyIDs []uint
+	ActiveOnly  bool // Hanya layanan yang sedang dijual (katalog untuk member)
+}
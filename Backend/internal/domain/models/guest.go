@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Guest adalah profil tamu yang menginap, terpisah dari akun User yang memesan.
+// Setiap booking ditautkan ke satu profil; profil dicari ulang berdasarkan email
+// atau nomor identitas sehingga tamu yang sama tidak tercatat berkali-kali.
+type Guest struct {
+	gorm.Model
+	FullName     string          `gorm:"type:varchar(255);not null"`
+	Email        string          `gorm:"type:varchar(255);index"`                // Disimpan lowercase, kunci deduplikasi utama
+	Phone        SensitiveString `gorm:"type:varchar(255);serializer:encrypted"` // Terenkripsi, tersamar di response
+	PhoneHash    string          `gorm:"type:char(64);index" json:"-"`           // Blind index untuk pencarian
+	IDNumber     SensitiveString `gorm:"type:varchar(255);serializer:encrypted"` // KTP/Passport, kunci deduplikasi kedua
+	IDNumberHash string          `gorm:"type:char(64);index" json:"-"`
+	Preferences  string          `gorm:"type:text"` // Contoh: "lantai atas, bantal hypoallergenic"
+	IsVIP        bool            `gorm:"default:false"`
+	Notes        string          `gorm:"type:text"` // Catatan internal staf
+	MergedIntoID *uint           `gorm:"index"`     // Diisi saat profil digabung ke profil lain (profil ini lalu dihapus)
+
+	// Statistik menginap seumur hidup, diisi service pada detail tamu
+	Stats *GuestStats `gorm:"-"`
+}
+
+// GuestStats adalah ringkasan riwayat menginap seorang tamu
+type GuestStats struct {
+	TotalBookings     int64
+	CancelledBookings int64
+	Stays             int64 // Booking yang sudah selesai (check-out)
+	Nights            int64 // Total malam dari booking yang sudah selesai
+	FirstStay         *time.Time
+	LastStay          *time.Time
+	Spend             []GuestSpend // Per mata uang karena setiap properti bisa memakai mata uang berbeda
+}
+
+// GuestSpend adalah total pembayaran tamu dalam satu mata uang
+type GuestSpend struct {
+	Currency string
+	Amount   float64 // Total harga booking yang sudah dibayar
+}
+
+// GuestFilter berisi filter daftar tamu (nilai kosong = tidak difilter)
+type GuestFilter struct {
+	Search      string // Nama, email, telepon, atau nomor identitas
+	VIPOnly     bool
+	PropertyIDs []uint // Batasan dari PropertyScope: hanya tamu yang pernah booking di properti ini
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// --- Status Housekeeping Kamar ---
+const (
+	HousekeepingDirty     = "dirty"     // Perlu dibersihkan (setelah check-out / stay-over)
+	HousekeepingCleaning  = "cleaning"  // Sedang dibersihkan
+	HousekeepingClean     = "clean"     // Selesai dibersihkan, belum diinspeksi
+	HousekeepingInspected = "inspected" // Sudah diperiksa supervisor, siap dijual
+)
+
+// IsRoomReady mengecek apakah kamar boleh diberikan ke tamu yang datang
+func IsRoomReady(housekeepingStatus string) bool {
+	return housekeepingStatus == HousekeepingClean || housekeepingStatus == HousekeepingInspected
+}
+
+// --- Jenis & Status Tugas Housekeeping ---
+const (
+	TaskTypeCheckout = "checkout" // Dibuat saat booking selesai (tamu check-out)
+	TaskTypeStayover = "stayover" // Dibuat harian untuk kamar yang masih ditempati
+
+	TaskPending    = "pending"
+	TaskInProgress = "in_progress"
+	TaskCompleted  = "completed"
+	TaskInspected  = "inspected"
+)
+
+// HousekeepingTask adalah satu tugas pembersihan kamar pada tanggal tertentu.
+// Unik per (kamar, tanggal, jenis) sehingga generator harian aman dijalankan berulang.
+type HousekeepingTask struct {
+	gorm.Model
+	RoomID        uint      `gorm:"not null;uniqueIndex:idx_housekeeping_tasks_room_date_type,priority:1"`
+	PropertyID    uint      `gorm:"not null;index"`
+	BookingID     *uint     `gorm:"index"`
+	TaskDate      time.Time `gorm:"type:date;not null;uniqueIndex:idx_housekeeping_tasks_room_date_type,priority:2"`
+	Type          string    `gorm:"type:enum('checkout', 'stayover');not null;uniqueIndex:idx_housekeeping_tasks_room_date_type,priority:3"`
+	Status        string    `gorm:"type:enum('pending', 'in_progress', 'completed', 'inspected');default:'pending'"`
+	AssignedToID  *uint     `gorm:"index"` // User staf housekeeping
+	InspectedByID *uint
+	Notes         string `gorm:"type:text"`
+	StartedAt     *time.Time
+	CompletedAt   *time.Time
+	InspectedAt   *time.Time
+
+	Room       *Room `gorm:"foreignKey:RoomID"`
+	AssignedTo *User `gorm:"foreignKey:AssignedToID"`
+}
+
+// HousekeepingTaskFilter berisi filter daftar tugas (nilai kosong = tidak difilter)
+type HousekeepingTaskFilter struct {
+	PropertyID   uint
+	PropertyIDs  []uint
+	TaskDate     string // YYYY-MM-DD
+	Status       string
+	AssignedToID uint
+}
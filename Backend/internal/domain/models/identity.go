@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// UserIdentity menghubungkan akun dengan identitas dari provider OpenID Connect (Google, dll).
+// Satu user bisa punya beberapa identitas; satu identitas (provider + subject) hanya milik satu user.
+type UserIdentity struct {
+	ID          uint `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	UserID      uint   `gorm:"not null;index"`
+	Provider    string `gorm:"type:varchar(50);not null;uniqueIndex:idx_user_identities_provider_subject,priority:1"`
+	Subject     string `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identities_provider_subject,priority:2"` // Claim "sub", stabil per provider
+	Email       string `gorm:"type:varchar(100)"`                                                                      // Email dari provider saat terakhir login
+	LastLoginAt *time.Time
+}
+
+// OIDCLoginState menyimpan state, nonce, dan PKCE code verifier antara redirect ke provider
+// dan callback. Sekali pakai dan kedaluwarsa (lihat OIDCStateTTL).
+type OIDCLoginState struct {
+	State        string `gorm:"type:varchar(64);primaryKey"`
+	CreatedAt    time.Time
+	Provider     string    `gorm:"type:varchar(50);not null"`
+	CodeVerifier string    `gorm:"type:varchar(128);not null"`
+	Nonce        string    `gorm:"type:varchar(64);not null"`
+	UserID       *uint     // Terisi pada alur menghubungkan identitas ke akun yang sedang login
+	ExpiresAt    time.Time `gorm:"not null;index"`
+}
+
+// OIDCStateTTL adalah batas waktu user menyelesaikan login di halaman provider
+const OIDCStateTTL = 10 * time.Minute
+
+// OIDCProviderInfo adalah provider yang tersedia untuk tombol login
+type OIDCProviderInfo struct {
+	Name        string
+	DisplayName string
+}
+
+// OIDCAuthorization berisi URL halaman login provider untuk diarahkan oleh frontend
+type OIDCAuthorization struct {
+	AuthorizationURL string
+	State            string
+	ExpiresIn        int // Detik
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// --- Jenis Baris Tagihan ---
+const (
+	InvoiceLineRoom        = "room"         // Tarif kamar per malam
+	InvoiceLineExtraPerson = "extra_person" // Tamu di atas BaseOccupancy
+	InvoiceLineExtraBed    = "extra_bed"
+	InvoiceLineExtra       = "extra" // Layanan tambahan (lihat extra.go)
+)
+
+// InvoiceLine adalah satu baris rincian tagihan booking
+type InvoiceLine struct {
+	Type        string
+	Description string // Nomor & tipe kamar atau nama layanan tambahan
+	Quantity    int    // Malam untuk baris kamar/tamu tambahan/extra bed; jumlah × pengali untuk layanan tambahan
+	UnitPrice   float64
+	Amount      float64
+}
+
+// Invoice adalah rincian tagihan satu booking. Jumlah seluruh baris = Total = Booking.TotalPrice.
+type Invoice struct {
+	BookingID    uint
+	PropertyID   uint
+	PropertyName string
+	Currency     string
+	GuestName    string
+	CheckInDate  time.Time
+	CheckOutDate time.Time
+	Nights       int
+	Lines        []InvoiceLine
+	Total        float64
+	Paid         float64 // Pembayaran & tagihan selisih yang sukses dikurangi refund
+	Balance      float64 // Sisa tagihan; negatif = kelebihan bayar
+	Payments     []Payment
+}
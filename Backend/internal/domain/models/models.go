@@ -1,7 +1,6 @@
 package models
 
 import (
-	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -16,51 +15,118 @@ type User struct {
 	Password string `gorm:"type:varchar(255);not null"`
 	Email    string `gorm:"type:varchar(100);unique;not null"`
 	FullName string `gorm:"type:varchar(100);not null"`
-	Role     string `gorm:"type:enum('admin', 'member');default:'member'"`
+	Role     string `gorm:"type:enum('admin', 'member', 'front_desk', 'housekeeping', 'revenue_manager', 'accountant');default:'member'"`
+	// Admin grup mengelola semua properti; hanya berlaku untuk role admin (lihat PropertyScope)
+	IsGroupAdmin bool   `gorm:"not null;default:false"`
+	Language     string `gorm:"type:varchar(5);default:'id'"` // Preferensi bahasa pesan API (id/en)
+
+	// Akun dinonaktifkan admin (pengganti hapus permanen): tidak bisa login, data & riwayat tetap utuh
+	DeactivatedAt *time.Time `gorm:"index"`
+
+	// Two-factor authentication (TOTP), lihat two_factor.go
+	TwoFactorEnabled   bool
+	TwoFactorEnabledAt *time.Time
+	TwoFactorSecret    string `gorm:"type:varchar(255);serializer:encrypted" json:"-"` // Terenkripsi; terisi sejak setup dimulai
+	TwoFactorLastStep  int64  `json:"-"`                                               // Periode TOTP terakhir yang dipakai (cegah replay)
 
 	// Relasi: User punya banyak Booking
 	Bookings []Booking `gorm:"foreignKey:UserID"`
+
+	// Properti yang boleh dikelola staf (kosong = tidak ada akses, kecuali admin grup)
+	Properties []Property `gorm:"many2many:user_properties"`
 }
 
 type Room struct {
 	gorm.Model
-	RoomNumber   string  `gorm:"type:varchar(10);unique;not null"`
+	PropertyID   uint    `gorm:"not null;uniqueIndex:idx_rooms_property_room_number,priority:1"`                  // Foreign Key ke Property
+	RoomNumber   string  `gorm:"type:varchar(10);not null;uniqueIndex:idx_rooms_property_room_number,priority:2"` // Unik per properti
 	Type         string  `gorm:"type:varchar(50);not null"`
 	Price        float64 `gorm:"type:decimal(10,2);not null"`
 	Description  string  `gorm:"type:text"`
 	Status       string  `gorm:"type:enum('available', 'booked', 'maintenance');default:'available'"`
 	MaxOccupancy int     `gorm:"not null"`
 
+	// Aturan okupansi & biaya tamu tambahan (lihat occupancy.go)
+	MaxAdults       int     `gorm:"default:0"`                    // 0 = sama dengan MaxOccupancy
+	BaseOccupancy   int     `gorm:"default:0"`                    // Tamu yang sudah termasuk harga kamar (0 = MaxOccupancy)
+	ExtraAdultPrice float64 `gorm:"type:decimal(10,2);default:0"` // Per malam per dewasa di atas BaseOccupancy
+	ExtraChildPrice float64 `gorm:"type:decimal(10,2);default:0"` // Per malam per anak di atas BaseOccupancy
+	MaxExtraBeds    int     `gorm:"default:0"`                    // Setiap extra bed menambah kapasitas 1 tamu
+	ExtraBedPrice   float64 `gorm:"type:decimal(10,2);default:0"` // Per malam per extra bed
+
+	// Status kebersihan (lihat housekeeping.go); kamar dirty/cleaning tidak diberikan ke tamu yang datang hari ini
+	HousekeepingStatus string `gorm:"type:enum('dirty', 'cleaning', 'clean', 'inspected');default:'clean'"`
+
 	// Relasi: Room punya banyak Image dan Booking
 	Images   []RoomImage `gorm:"foreignKey:RoomID"`
 	Bookings []Booking   `gorm:"foreignKey:RoomID"`
+
+	// Relasi many-to-many: fasilitas kamar
+	Amenities []Amenity `gorm:"many2many:room_amenities"`
+
+	// Gambar utama kamar (IsPrimary, atau gambar pertama jika belum ada), diisi service
+	PrimaryImage *RoomImage `gorm:"-"`
 }
 
 type RoomImage struct {
 	gorm.Model
-	RoomID    uint   `gorm:"not null"` // Foreign Key
-	ImageURL  string `gorm:"type:varchar(255);not null"`
-	IsPrimary bool   `gorm:"default:false"`
+	RoomID     uint   `gorm:"not null"` // Foreign Key
+	ImageURL   string `gorm:"type:varchar(255);not null"`
+	StorageKey string `gorm:"type:varchar(255)" json:"-"` // Key di FileStorage (kosong = URL eksternal)
+	IsPrimary  bool   `gorm:"default:false"`              // Tepat satu per kamar (unique index di database)
+	SortOrder  int    `gorm:"default:0"`                  // Urutan tampil di galeri (kecil = duluan)
+	AltText    string `gorm:"type:varchar(255)"`
+	Caption    string `gorm:"type:varchar(255)"`
+	Width      int    `gorm:"default:0"` // Dimensi gambar asli (0 = URL eksternal)
+	Height     int    `gorm:"default:0"`
+
+	// Key varian hasil resize di FileStorage (kosong = tidak ada varian)
+	ThumbKey string `gorm:"type:varchar(255)" json:"-"`
+	CardKey  string `gorm:"type:varchar(255)" json:"-"`
+	FullKey  string `gorm:"type:varchar(255)" json:"-"`
+	WebpKey  string `gorm:"type:varchar(255)" json:"-"`
+
+	// URL varian, diisi service saat response (fallback ke ImageURL jika tidak ada varian)
+	ThumbURL string `gorm:"-"`
+	CardURL  string `gorm:"-"`
+	FullURL  string `gorm:"-"`
+	WebpURL  string `gorm:"-"`
 }
 
 type Booking struct {
 	gorm.Model
-	UserID        uint      `gorm:"not null"` // Foreign Key ke User
-	RoomID        uint      `gorm:"not null"` // Foreign Key ke Room
-	CheckInDate   time.Time `gorm:"type:date;not null"`
-	CheckOutDate  time.Time `gorm:"type:date;not null"`
-	TotalPrice    float64   `gorm:"type:decimal(10,2);not null"`
-	PaymentMethod string    `gorm:"type:varchar(50)"`
-	PaymentStatus string    `gorm:"type:enum('pending', 'paid', 'failed');default:'pending'"`
-	BookingStatus string    `gorm:"type:enum('confirmed', 'cancelled', 'completed');default:'confirmed'"`
-	
+	UserID        uint       `gorm:"not null"`       // Foreign Key ke User
+	RoomID        uint       `gorm:"not null"`       // Foreign Key ke Room
+	PropertyID    uint       `gorm:"not null;index"` // Disalin dari Room saat booking dibuat (untuk filter & laporan)
+	GroupID       *uint      `gorm:"index"`          // Reservasi grup (lihat booking_group.go), nil = booking satuan
+	CheckInDate   time.Time  `gorm:"type:date;not null"`
+	CheckOutDate  time.Time  `gorm:"type:date;not null"`
+	TotalPrice    float64    `gorm:"type:decimal(10,2);not null"`
+	PaymentMethod string     `gorm:"type:varchar(50)"`
+	PaymentStatus string     `gorm:"type:enum('pending', 'paid', 'failed', 'refunded');default:'pending'"`
+	BookingStatus string     `gorm:"type:enum('confirmed', 'cancelled', 'completed');default:'confirmed'"`
+	CheckedInAt   *time.Time // Diisi front desk saat tamu check-in (nil = belum datang)
+
 	// Guest Information (PENTING untuk keamanan & regulasi hotel)
-	GuestName        string `gorm:"type:varchar(255);not null"`
-	GuestEmail       string `gorm:"type:varchar(255);not null"`
-	GuestPhone       string `gorm:"type:varchar(20);not null"`
-	GuestIDNumber    string `gorm:"type:varchar(50)"` // KTP/Passport
-	SpecialRequests  string `gorm:"type:text"`
-	NumberOfGuests   int    `gorm:"default:1"`
+	// Data disalin per booking apa adanya; GuestID menautkan ke profil tamu (lihat guest.go)
+	GuestID         *uint           `gorm:"index"`
+	GuestName       string          `gorm:"type:varchar(255);not null"`
+	GuestEmail      string          `gorm:"type:varchar(255);not null"`
+	GuestPhone      SensitiveString `gorm:"type:varchar(255);not null;serializer:encrypted"` // Terenkripsi, tersamar di response
+	GuestIDNumber   SensitiveString `gorm:"type:varchar(255);serializer:encrypted"`          // KTP/Passport, terenkripsi
+	SpecialRequests string          `gorm:"type:text"`
+	NumberOfGuests  int             `gorm:"default:1"` // Adults + Children
+
+	// Susunan tamu & rincian biaya tambahan (sudah termasuk di TotalPrice), lihat occupancy.go
+	Adults            int     `gorm:"default:1"`
+	Children          int     `gorm:"default:0"`
+	ExtraBeds         int     `gorm:"default:0"`
+	ExtraPersonCharge float64 `gorm:"type:decimal(10,2);default:0"`
+	ExtraBedCharge    float64 `gorm:"type:decimal(10,2);default:0"`
+
+	// Layanan tambahan (sarapan, spa, dll), totalnya sudah termasuk di TotalPrice, lihat extra.go
+	ExtrasCharge float64        `gorm:"type:decimal(10,2);default:0"`
+	Extras       []BookingExtra `gorm:"foreignKey:BookingID"`
 
 	// Relasi: Booking punya 1 Review
 	Review Review `gorm:"foreignKey:BookingID"`
@@ -86,6 +152,9 @@ type Pagination struct {
 type Claims struct {
 	UserID uint   `json:"user_id"`
 	Role   string `json:"role"`
+	Lang   string `json:"lang,omitempty"`
+	// Purpose kosong untuk token akses; terisi untuk challenge token 2FA (tidak berlaku sebagai token akses)
+	Purpose string `json:"purpose,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -93,13 +162,20 @@ type Claims struct {
 const (
 	RoleAdmin  = "admin"
 	RoleMember = "member"
+
+	// Role staf (lihat RolePermissions di permission.go)
+	RoleFrontDesk      = "front_desk"
+	RoleHousekeeping   = "housekeeping"
+	RoleRevenueManager = "revenue_manager"
+	RoleAccountant     = "accountant"
 )
 
 // --- Status Pembayaran ---
 const (
-	StatusPending = "pending"
-	StatusPaid    = "paid"
-	StatusFailed  = "failed"
+	StatusPending  = "pending"
+	StatusPaid     = "paid"
+	StatusFailed   = "failed"
+	StatusRefunded = "refunded"
 )
 
 // --- Status Pemesanan ---
@@ -108,10 +184,3 @@ const (
 	StatusCancelled = "cancelled"
 	StatusCompleted = "completed"
 )
-
-// --- Custom Errors ---
-var (
-	ErrRecordNotFound     = gorm.ErrRecordNotFound
-	ErrInvalidCredentials = errors.New("username atau password salah")
-	// Tambahkan error lain sesuai kebutuhan (misalnya: errors.New("kamar sudah dibooking"))
-)
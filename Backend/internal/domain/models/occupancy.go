@@ -0,0 +1,72 @@
+package models
+
+// Party adalah susunan tamu yang menginap di satu kamar
+type Party struct {
+	Adults    int
+	Children  int
+	ExtraBeds int
+}
+
+// Guests adalah jumlah seluruh tamu (dewasa + anak)
+func (p Party) Guests() int {
+	return p.Adults + p.Children
+}
+
+// Party mengambil susunan tamu booking
+func (b *Booking) Party() Party {
+	return Party{Adults: b.Adults, Children: b.Children, ExtraBeds: b.ExtraBeds}
+}
+
+// SetParty menyimpan susunan tamu ke booking (NumberOfGuests ikut diperbarui)
+func (b *Booking) SetParty(p Party) {
+	b.Adults, b.Children, b.ExtraBeds = p.Adults, p.Children, p.ExtraBeds
+	b.NumberOfGuests = p.Guests()
+}
+
+// includedGuests adalah jumlah tamu yang sudah termasuk harga kamar
+func (r *Room) includedGuests() int {
+	if r.BaseOccupancy > 0 {
+		return r.BaseOccupancy
+	}
+	return r.MaxOccupancy
+}
+
+// ValidOccupancyRules mengecek konfigurasi okupansi kamar masuk akal
+func (r *Room) ValidOccupancyRules() bool {
+	return r.MaxAdults >= 0 && r.MaxAdults <= r.MaxOccupancy &&
+		r.BaseOccupancy >= 0 && r.BaseOccupancy <= r.MaxOccupancy &&
+		r.MaxExtraBeds >= 0 &&
+		r.ExtraAdultPrice >= 0 && r.ExtraChildPrice >= 0 && r.ExtraBedPrice >= 0
+}
+
+// CheckOccupancy memastikan susunan tamu muat di kamar.
+// Kapasitas = MaxOccupancy + extra bed yang diminta; jumlah dewasa dibatasi MaxAdults (jika diisi).
+func (r *Room) CheckOccupancy(p Party) error {
+	if p.Adults < 1 || p.Children < 0 || p.ExtraBeds < 0 {
+		return ErrAdultRequired
+	}
+	if p.ExtraBeds > r.MaxExtraBeds {
+		return ErrExtraBedsExceeded
+	}
+	if r.MaxAdults > 0 && p.Adults > r.MaxAdults {
+		return ErrOccupancyExceeded
+	}
+	if p.Guests() > r.MaxOccupancy+p.ExtraBeds {
+		return ErrOccupancyExceeded
+	}
+	return nil
+}
+
+// ExtraPersonRate adalah biaya tamu tambahan per malam.
+// Dewasa mengisi kuota BaseOccupancy lebih dulu, sisa kuota dipakai anak.
+func (r *Room) ExtraPersonRate(p Party) float64 {
+	included := r.includedGuests()
+	extraAdults := max(0, p.Adults-included)
+	extraChildren := max(0, p.Children-max(0, included-p.Adults))
+	return float64(extraAdults)*r.ExtraAdultPrice + float64(extraChildren)*r.ExtraChildPrice
+}
+
+// ExtraBedRate adalah biaya extra bed per malam
+func (r *Room) ExtraBedRate(p Party) float64 {
+	return float64(p.ExtraBeds) * r.ExtraBedPrice
+}
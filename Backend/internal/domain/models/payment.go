@@ -4,9 +4,26 @@ import "gorm.io/gorm"
 
 type Payment struct {
 	gorm.Model
-	BookingID     uint    `gorm:"not null"`
-	Amount        float64 `gorm:"type:decimal(10,2);not null"`
+	BookingID     *uint   `gorm:"index"` // Tepat satu dari BookingID / GroupID terisi
+	GroupID       *uint   `gorm:"index"` // Satu pembayaran untuk seluruh kamar reservasi grup
+	Type          string  `gorm:"type:enum('payment', 'adjustment', 'refund');default:'payment'"`
+	Amount        float64 `gorm:"type:decimal(10,2);not null"` // Selalu positif; arah uang ditentukan Type
 	PaymentMethod string  `gorm:"type:varchar(50);not null"`
-	Status        string  `gorm:"type:enum('pending', 'success', 'failed');default:'pending'"`
+	Status        string  `gorm:"type:enum('pending', 'success', 'failed', 'refunded', 'cancelled');default:'pending'"`
 	TransactionID string  `gorm:"type:varchar(100);unique"`
 }
+
+// --- Jenis Transaksi Pembayaran ---
+const (
+	PaymentTypePayment    = "payment"    // Pembayaran booking
+	PaymentTypeAdjustment = "adjustment" // Tagihan selisih harga setelah booking diubah
+	PaymentTypeRefund     = "refund"     // Pengembalian selisih harga setelah booking diubah
+)
+
+// PaymentCancelled adalah status tagihan pending yang digantikan tagihan baru (booking diubah)
+const PaymentCancelled = "cancelled"
+
+// IsCharge mengecek apakah transaksi adalah uang masuk dari tamu (pembayaran atau tagihan selisih)
+func (p *Payment) IsCharge() bool {
+	return p.Type != PaymentTypeRefund
+}
@@ -0,0 +1,93 @@
+package models
+
+import "slices"
+
+// Permission adalah hak akses granular dengan format "resource:aksi".
+// Endpoint staf memeriksa permission, bukan nama role, sehingga role baru
+// cukup didaftarkan di RolePermissions tanpa mengubah route.
+type Permission string
+
+const (
+	PermRoomWrite        Permission = "room:write"         // Tambah/ubah/hapus kamar, galeri, fasilitas kamar
+	PermRoomUpdateStatus Permission = "room:update_status" // Ubah status kamar (available/maintenance)
+	PermAmenityWrite     Permission = "amenity:write"
+	PermPropertyWrite    Permission = "property:write"
+	PermExtraWrite       Permission = "extra:write" // Katalog layanan tambahan & harganya
+
+	PermBookingRead         Permission = "booking:read"
+	PermBookingUpdateStatus Permission = "booking:update_status"
+	PermBookingModify       Permission = "booking:modify" // Ubah tanggal, kamar, & jumlah tamu (selisih harga ditagih/di-refund)
+
+	PermPaymentUpdateStatus Permission = "payment:update_status"
+	PermPaymentRefund       Permission = "payment:refund"
+
+	PermGuestRead   Permission = "guest:read"   // Profil tamu, riwayat menginap & total belanja
+	PermGuestManage Permission = "guest:manage" // Ubah profil tamu, gabung profil duplikat
+	PermGuestPII    Permission = "guest:pii"    // Buka nomor identitas & telepon lengkap (dicatat di log audit)
+
+	PermHousekeepingRead    Permission = "housekeeping:read"
+	PermHousekeepingUpdate  Permission = "housekeeping:update"  // Mulai & selesaikan tugas pembersihan
+	PermHousekeepingManage  Permission = "housekeeping:manage"  // Tugaskan staf, buat tugas, ubah status kebersihan kamar
+	PermHousekeepingInspect Permission = "housekeeping:inspect" // Inspeksi kamar yang sudah dibersihkan
+
+	PermReviewModerate Permission = "review:moderate"
+	PermReportRead     Permission = "report:read"
+
+	PermUserRead   Permission = "user:read"
+	PermUserManage Permission = "user:manage" // Buat, ubah, & nonaktifkan user, ubah role, atur properti staf
+
+	PermAuditRead     Permission = "audit:read"     // Log akses data pribadi tamu
+	PermPrivacyManage Permission = "privacy:manage" // Setujui/tolak permintaan penghapusan data member
+)
+
+// AllPermissions berisi semua permission (urutan untuk tampilan admin)
+var AllPermissions = []Permission{
+	PermRoomWrite, PermRoomUpdateStatus, PermAmenityWrite, PermPropertyWrite, PermExtraWrite,
+	PermBookingRead, PermBookingUpdateStatus, PermBookingModify,
+	PermPaymentUpdateStatus, PermPaymentRefund,
+	PermGuestRead, PermGuestManage, PermGuestPII,
+	PermHousekeepingRead, PermHousekeepingUpdate, PermHousekeepingManage, PermHousekeepingInspect,
+	PermReviewModerate, PermReportRead,
+	PermUserRead, PermUserManage,
+	PermAuditRead, PermPrivacyManage,
+}
+
+// RolePermissions memetakan role staf ke permission-nya. Member tidak punya permission staf.
+var RolePermissions = map[string][]Permission{
+	RoleAdmin: AllPermissions,
+	RoleFrontDesk: {
+		PermBookingRead, PermBookingUpdateStatus, PermBookingModify, PermPaymentUpdateStatus,
+		PermRoomUpdateStatus, PermUserRead, PermGuestRead, PermGuestManage, PermGuestPII,
+		PermHousekeepingRead, PermHousekeepingManage, PermHousekeepingInspect,
+	},
+	RoleHousekeeping: {
+		PermRoomUpdateStatus, PermHousekeepingRead, PermHousekeepingUpdate,
+	},
+	RoleRevenueManager: {
+		PermRoomWrite, PermExtraWrite, PermBookingRead, PermReportRead, PermGuestRead,
+	},
+	RoleAccountant: {
+		PermBookingRead, PermPaymentUpdateStatus, PermPaymentRefund, PermReportRead,
+	},
+}
+
+// StaffRoles adalah role yang boleh mengakses endpoint /api/admin
+var StaffRoles = []string{RoleAdmin, RoleFrontDesk, RoleHousekeeping, RoleRevenueManager, RoleAccountant}
+
+// AllRoles adalah semua role yang bisa ditetapkan ke user
+var AllRoles = append([]string{RoleMember}, StaffRoles...)
+
+// IsStaffRole mengecek apakah role termasuk staf hotel
+func IsStaffRole(role string) bool {
+	return slices.Contains(StaffRoles, role)
+}
+
+// IsValidRole mengecek apakah role dikenal
+func IsValidRole(role string) bool {
+	return slices.Contains(AllRoles, role)
+}
+
+// HasPermission mengecek apakah role memiliki permission tertentu
+func HasPermission(role string, permission Permission) bool {
+	return slices.Contains(RolePermissions[role], permission)
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SensitiveString adalah data pribadi tamu (nomor identitas, telepon).
+// Di database disimpan terenkripsi (tag `serializer:encrypted`), di response API
+// hanya ditampilkan tersamar. Nilai lengkap hanya dikirim lewat endpoint staf
+// yang aksesnya dicatat di PIIAccessLog.
+type SensitiveString string
+
+// Masked menyamarkan semua karakter kecuali 4 terakhir, contoh "************3456"
+func (s SensitiveString) Masked() string {
+	runes := []rune(string(s))
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+}
+
+// MarshalJSON selalu mengirim nilai tersamar
+func (s SensitiveString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Masked())
+}
+
+// GuestIdentity adalah data identitas lengkap (tidak tersamar) untuk staf berwenang
+type GuestIdentity struct {
+	FullName string
+	Phone    string
+	IDNumber string
+}
+
+// --- Jenis Data yang Dibuka ---
+const (
+	PIIResourceGuest   = "guest"
+	PIIResourceBooking = "booking"
+)
+
+// PIIAccessLog mencatat setiap kali staf membuka data pribadi lengkap (append-only)
+type PIIAccessLog struct {
+	ID           uint      `gorm:"primaryKey"`
+	CreatedAt    time.Time `gorm:"index"`
+	UserID       uint      `gorm:"not null;index"` // Staf yang membuka data
+	ResourceType string    `gorm:"type:enum('guest', 'booking');not null"`
+	ResourceID   uint      `gorm:"not null"`
+	GuestID      *uint     `gorm:"index"`             // Profil tamu pemilik data (jika ada)
+	Fields       string    `gorm:"type:varchar(100)"` // Contoh: "phone,id_number"
+	Reason       string    `gorm:"type:varchar(255);not null"`
+	IPAddress    string    `gorm:"type:varchar(45)"`
+
+	User *User `gorm:"foreignKey:UserID"`
+}
+
+// PIIAccessFilter berisi filter log akses data pribadi (nilai kosong = tidak difilter)
+type PIIAccessFilter struct {
+	UserID  uint
+	GuestID uint
+	From    time.Time // Inklusif
+	To      time.Time // Eksklusif
+}
+
+// --- Permintaan Data Pribadi (UU PDP) ---
+const (
+	PrivacyRequestExport  = "export"  // Unduh arsip data pribadi (langsung selesai)
+	PrivacyRequestErasure = "erasure" // Hapus/anonimkan data pribadi (perlu persetujuan admin)
+
+	PrivacyRequestPending   = "pending"
+	PrivacyRequestCompleted = "completed"
+	PrivacyRequestRejected  = "rejected"
+)
+
+// AnonymizedName menggantikan nama pada data yang sudah dianonimkan
+const AnonymizedName = "Anonim"
+
+// PrivacyRequest mencatat permintaan ekspor dan penghapusan data dari member
+type PrivacyRequest struct {
+	gorm.Model
+	UserID       uint   `gorm:"not null;index"`
+	Type         string `gorm:"type:enum('export', 'erasure');not null"`
+	Status       string `gorm:"type:enum('pending', 'completed', 'rejected');default:'pending'"`
+	Reason       string `gorm:"type:text"`         // Alasan dari member (opsional)
+	ReviewedByID *uint  `gorm:"index"`             // Admin yang menyetujui/menolak
+	ReviewNote   string `gorm:"type:varchar(255)"` // Catatan admin, wajib saat menolak
+	ReviewedAt   *time.Time
+	CompletedAt  *time.Time
+
+	User *User `gorm:"foreignKey:UserID"`
+}
+
+// PrivacyRequestFilter berisi filter daftar permintaan untuk admin
+type PrivacyRequestFilter struct {
+	Type   string
+	Status string
+	UserID uint
+}
+
+// PersonalDataExport adalah seluruh data pribadi seorang member untuk diunduh
+type PersonalDataExport struct {
+	Profile       ExportedProfile
+	Bookings      []ExportedBooking
+	BookingGroups []ExportedBookingGroup
+	Payments      []Payment
+	Reviews       []Review
+	SavedGuests   []ExportedSavedGuest
+}
+
+// ExportedProfile adalah data akun tanpa hash password
+type ExportedProfile struct {
+	ID        uint
+	Username  string
+	Email     string
+	FullName  string
+	Role      string
+	Language  string
+	CreatedAt time.Time
+}
+
+// ExportedBooking menampilkan telepon & nomor identitas lengkap milik pemohon sendiri
+// (field luar menimpa SensitiveString tersamar milik Booking saat di-encode JSON)
+type ExportedBooking struct {
+	Booking
+	GuestPhone    string
+	GuestIDNumber string
+}
+
+// ExportedBookingGroup menampilkan telepon tamu utama reservasi grup tanpa disamarkan
+type ExportedBookingGroup struct {
+	BookingGroup
+	LeadGuestPhone string
+}
+
+// ExportedSavedGuest menampilkan data tamu tersimpan tanpa disamarkan
+type ExportedSavedGuest struct {
+	SavedGuest
+	Phone    string
+	IDNumber string
+}
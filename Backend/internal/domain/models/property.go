@@ -0,0 +1,52 @@
+package models
+
+import (
+	"slices"
+
+	"gorm.io/gorm"
+)
+
+// Property adalah satu hotel dalam grup; setiap kamar dimiliki satu properti
+type Property struct {
+	gorm.Model
+	Code         string `gorm:"type:varchar(20);unique;not null"` // Kode singkat, contoh "JKT01"
+	Name         string `gorm:"type:varchar(100);not null"`
+	Address      string `gorm:"type:text"`
+	City         string `gorm:"type:varchar(100)"`
+	Country      string `gorm:"type:varchar(100)"`
+	Phone        string `gorm:"type:varchar(20)"`
+	Timezone     string `gorm:"type:varchar(64);not null;default:'Asia/Jakarta'"` // Nama zona waktu IANA
+	Currency     string `gorm:"type:char(3);not null;default:'IDR'"`              // Kode ISO 4217
+	CheckInTime  string `gorm:"type:varchar(5);not null;default:'14:00'"`         // Format HH:MM waktu lokal
+	CheckOutTime string `gorm:"type:varchar(5);not null;default:'12:00'"`
+}
+
+// PropertyScope membatasi properti yang boleh dikelola seorang staf.
+// Hanya admin grup (Global) yang boleh mengakses semua properti; scope nil dipakai route publik.
+type PropertyScope struct {
+	Global      bool
+	PropertyIDs []uint
+	Role        string // Role staf terbaru dari database (role di JWT bisa sudah diubah admin)
+}
+
+// IsGlobal mengecek apakah scope tidak dibatasi properti tertentu
+func (s *PropertyScope) IsGlobal() bool {
+	return s == nil || s.Global
+}
+
+// Allows mengecek apakah properti boleh diakses dengan scope ini
+func (s *PropertyScope) Allows(propertyID uint) bool {
+	return s.IsGlobal() || slices.Contains(s.PropertyIDs, propertyID)
+}
+
+// PropertyReport adalah ringkasan performa satu properti pada periode tertentu
+type PropertyReport struct {
+	PropertyID        uint
+	PropertyName      string
+	Currency          string
+	Bookings          int64 // Semua booking (termasuk cancelled) yang check-in pada periode
+	CancelledBookings int64
+	RoomNights        int64   // Total malam dari booking yang tidak dibatalkan
+	Revenue           float64 // Total harga booking yang sudah dibayar (termasuk layanan tambahan)
+	ExtrasRevenue     float64 // Bagian Revenue dari layanan tambahan
+}
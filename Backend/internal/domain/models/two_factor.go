@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// --- Challenge Login 2FA ---
+const (
+	ChallengeTwoFactorVerify = "2fa_verify" // User sudah mengaktifkan 2FA: kirim kode TOTP / recovery code
+	ChallengeTwoFactorEnroll = "2fa_enroll" // Kebijakan mewajibkan 2FA tetapi user belum mengaktifkannya
+)
+
+// RecoveryCodeCount adalah jumlah recovery code yang dibuat setiap kali di-generate
+const RecoveryCodeCount = 10
+
+// RecoveryCode adalah kode cadangan sekali pakai jika perangkat authenticator hilang.
+// Hanya hash yang disimpan; kode asli ditampilkan sekali saat dibuat.
+type RecoveryCode struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UserID    uint   `gorm:"not null;index"`
+	CodeHash  string `gorm:"type:char(64);not null;uniqueIndex"`
+	UsedAt    *time.Time
+}
+
+// LoginResult adalah hasil login tahap pertama. Jika ChallengeToken terisi, Token kosong
+// dan client harus menyelesaikan challenge (verifikasi atau aktivasi 2FA) terlebih dahulu.
+type LoginResult struct {
+	Token          string
+	User           *User
+	ChallengeToken string
+	ChallengeType  string // ChallengeTwoFactorVerify / ChallengeTwoFactorEnroll
+	ExpiresIn      int    // Masa berlaku challenge token (detik)
+}
+
+// TwoFactorSetup berisi secret untuk didaftarkan ke aplikasi authenticator
+type TwoFactorSetup struct {
+	Secret          string
+	ProvisioningURI string // otpauth://totp/..., untuk QR code
+}
+
+// TwoFactorStatus adalah status 2FA akun yang sedang login
+type TwoFactorStatus struct {
+	Enabled                bool
+	EnabledAt              *time.Time
+	Required               bool // Diwajibkan kebijakan (REQUIRE_ADMIN_2FA)
+	RecoveryCodesRemaining int64
+}
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// --- Status Daftar Tunggu ---
+const (
+	WaitlistWaiting   = "waiting"   // Menunggu kamar kosong
+	WaitlistOffered   = "offered"   // Kamar ditahan untuk member ini sampai OfferExpiresAt
+	WaitlistClaimed   = "claimed"   // Penawaran diklaim menjadi booking
+	WaitlistExpired   = "expired"   // Penawaran tidak diklaim tepat waktu / tanggal check-in sudah lewat
+	WaitlistCancelled = "cancelled" // Dibatalkan member
+)
+
+// WaitlistOfferTTL adalah batas waktu klaim kamar yang ditawarkan; setelah itu kamar
+// ditawarkan ke antrean berikutnya
+const WaitlistOfferTTL = 2 * time.Hour
+
+// MaxWaitlistEntries adalah batas antrean aktif (waiting/offered) per member
+const MaxWaitlistEntries = 5
+
+// WaitlistEntry adalah antrean member untuk kamar tertentu atau tipe kamar di satu properti
+// pada rentang tanggal yang sedang penuh. Urutan antrean = urutan ID (siapa cepat dia dapat).
+type WaitlistEntry struct {
+	ID           uint `gorm:"primaryKey"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	UserID       uint      `gorm:"not null;index"`
+	PropertyID   uint      `gorm:"not null;index"`
+	RoomID       *uint     `gorm:"index"`            // Kamar tertentu; nil = kamar apa pun dengan RoomType
+	RoomType     string    `gorm:"type:varchar(50)"` // Diisi jika RoomID nil
+	CheckInDate  time.Time `gorm:"type:date;not null"`
+	CheckOutDate time.Time `gorm:"type:date;not null"`
+	Adults       int       `gorm:"default:1"`
+	Children     int       `gorm:"default:0"`
+	Status       string    `gorm:"type:enum('waiting', 'offered', 'claimed', 'expired', 'cancelled');default:'waiting';index"`
+
+	// Penawaran: kamar ditahan (tidak muncul di pencarian & tidak bisa dibooking member lain) sampai kedaluwarsa.
+	// Token link klaim hanya disimpan hash SHA-256.
+	OfferedRoomID  *uint
+	OfferTokenHash string `gorm:"type:char(64);index" json:"-"`
+	OfferedAt      *time.Time
+	OfferExpiresAt *time.Time
+	BookingID      *uint // Booking hasil klaim
+}
+
+// IsActive mengecek apakah antrean masih menunggu atau sedang ditawari kamar
+func (w *WaitlistEntry) IsActive() bool {
+	return w.Status == WaitlistWaiting || w.Status == WaitlistOffered
+}
+
+// Party mengembalikan susunan tamu antrean
+func (w *WaitlistEntry) Party() Party {
+	return Party{Adults: w.Adults, Children: w.Children}
+}
@@ -0,0 +1,23 @@
+package oidc
+
+import "context"
+
+// Provider adalah kontrak satu provider OpenID Connect (alur authorization code + PKCE)
+type Provider interface {
+	// Name adalah nama provider di URL, contoh "google"
+	Name() string
+	DisplayName() string
+	// AuthCodeURL membuat URL halaman login provider dengan state, nonce, dan PKCE challenge (S256)
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange menukar authorization code, memverifikasi ID token (signature, issuer, audience,
+	// masa berlaku, nonce), dan mengembalikan identitas user
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Identity adalah claim ID token yang dipakai aplikasi
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
@@ -0,0 +1,20 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Store adalah kontrak penyimpanan counter rate limit & lockout login.
+// Implementasi memory cukup untuk satu instance; Redis dipakai jika server berjalan di beberapa instance.
+type Store interface {
+	// Increment menambah counter key dan mengembalikan nilai barunya beserta sisa waktu window.
+	// Window dimulai saat hit pertama (fixed window) dan tidak diperpanjang oleh hit berikutnya.
+	Increment(ctx context.Context, key string, window time.Duration) (count int64, ttl time.Duration, err error)
+	// Get mengembalikan counter dan sisa waktunya; count 0 jika key tidak ada atau sudah kedaluwarsa
+	Get(ctx context.Context, key string) (count int64, ttl time.Duration, err error)
+	// Set menimpa counter dengan nilai dan masa berlaku baru
+	Set(ctx context.Context, key string, value int64, ttl time.Duration) error
+	// Delete menghapus counter; tidak error jika key sudah tidak ada
+	Delete(ctx context.Context, key string) error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"backend/internal/domain/models"
+	"time"
 )
 
 type RoomRepository interface {
@@ -11,9 +12,50 @@ type RoomRepository interface {
 	FindByID(id uint) (*models.Room, error)
 
 	// Show & Search
-	FindAll(pagination *models.Pagination) ([]models.Room, error)
+	FindAll(filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error)
 	// Fungsi untuk Filter Ketersediaan Real-time
-	FindAvailable(checkInDate, checkOutDate string, pagination *models.Pagination) ([]models.Room, error)
+	FindAvailable(checkInDate, checkOutDate string, filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error)
+	// Fasilitas kamar (mengganti seluruh daftar amenity kamar)
+	ReplaceAmenities(roomID uint, amenities []models.Amenity) error
+	// Status kebersihan kamar (hanya kolom housekeeping_status yang diubah)
+	UpdateHousekeepingStatus(roomID uint, status string) error
+}
+
+type PropertyRepository interface {
+	Create(property *models.Property) error
+	Update(property *models.Property) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.Property, error)
+	FindByIDs(ids []uint) ([]models.Property, error)
+	FindAll(ids []uint) ([]models.Property, error) // ids kosong = semua properti
+	FindDefault() (*models.Property, error)        // Properti tertua, dipakai jika property_id tidak diisi
+	CountRooms(propertyID uint) (int64, error)
+
+	// Scope admin per properti
+	FindIDsByUserID(userID uint) ([]uint, error)
+	ReplaceUserProperties(userID uint, properties []models.Property) error
+}
+
+type ReportRepository interface {
+	SummaryByProperty(filter *models.ReportFilter) ([]models.PropertyReport, error)
+}
+
+type AmenityRepository interface {
+	Create(amenity *models.Amenity) error
+	Update(amenity *models.Amenity) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.Amenity, error)
+	FindByIDs(ids []uint) ([]models.Amenity, error)
+	FindAll(category string) ([]models.Amenity, error)
+}
+
+// ExtraRepository mengelola katalog layanan tambahan per properti
+type ExtraRepository interface {
+	Create(extra *models.Extra) error
+	Update(extra *models.Extra) error
+	Delete(id uint) error
+	FindByID(id uint) (*models.Extra, error)
+	FindAll(filter *models.ExtraFilter) ([]models.Extra, error)
 }
 
 type UserRepository interface {
@@ -22,9 +64,58 @@ type UserRepository interface {
 	Delete(id uint) error
 	FindByID(id uint) (*models.User, error)
 	FindByUsername(username string) (*models.User, error)
+	FindByEmail(email string) (*models.User, error)
 	// Tambahan untuk Admin
-	FindAll(pagination *models.Pagination) ([]models.User, int64, error) // Get all users (for admin)
+	FindAll(filter *models.UserFilter, pagination *models.Pagination) ([]models.User, int64, error) // Get all users (for admin)
 	FindAllMembers(pagination *models.Pagination) ([]models.User, error)
+	// CountActiveAdmins menghitung admin grup yang belum dinonaktifkan (cegah sistem tanpa admin grup)
+	CountActiveAdmins() (int64, error)
+}
+
+type SavedGuestRepository interface {
+	FindByUserID(userID uint) ([]models.SavedGuest, error)
+	FindByID(id uint) (*models.SavedGuest, error)
+	CountByUserID(userID uint) (int64, error)
+	// Save membuat/mengubah data tamu; jika IsDefault, data tamu lain milik user tidak lagi default
+	Save(guest *models.SavedGuest) error
+	Delete(id uint) error
+}
+
+type EmailChangeRepository interface {
+	// Replace menyimpan permintaan baru dan membatalkan permintaan lama milik user yang sama
+	Replace(request *models.EmailChangeRequest) error
+	FindByTokenHash(tokenHash string) (*models.EmailChangeRequest, error)
+	// Complete mengganti email user dan menghapus permintaan dalam satu transaksi
+	Complete(request *models.EmailChangeRequest) error
+}
+
+type IdentityRepository interface {
+	FindByProviderSubject(provider, subject string) (*models.UserIdentity, error)
+	FindByID(id uint) (*models.UserIdentity, error)
+	FindByUserID(userID uint) ([]models.UserIdentity, error)
+	Create(identity *models.UserIdentity) error
+	Update(identity *models.UserIdentity) error
+	Delete(id uint) error
+	// CreateUserWithIdentity membuat member baru beserta identitasnya dalam satu transaksi
+	CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error
+
+	CreateState(state *models.OIDCLoginState) error
+	// ConsumeState mengambil lalu menghapus state (sekali pakai); ErrRecordNotFound jika tidak ada/kedaluwarsa
+	ConsumeState(state string) (*models.OIDCLoginState, error)
+}
+
+type TwoFactorRepository interface {
+	// SaveSettings menyimpan kolom 2FA user saja (enabled, secret, last step)
+	SaveSettings(user *models.User) error
+	// ClaimStep mencatat periode TOTP yang dipakai; false jika periode tersebut (atau yang lebih baru) sudah dipakai
+	ClaimStep(userID uint, step int64) (bool, error)
+
+	// ReplaceRecoveryCodes menghapus semua recovery code lama lalu menyimpan hash yang baru
+	ReplaceRecoveryCodes(userID uint, codeHashes []string) error
+	// UseRecoveryCode menandai kode terpakai; false jika kode tidak ada atau sudah dipakai
+	UseRecoveryCode(userID uint, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(userID uint) (int64, error)
+	DeleteRecoveryCodes(userID uint) error
 }
 
 type BookingRepository interface {
@@ -35,11 +126,99 @@ type BookingRepository interface {
 
 	// Fungsi Member dan Admin
 	FindByUserID(userID uint, pagination *models.Pagination) ([]models.Booking, error)
-	FindAll(pagination *models.Pagination) ([]models.Booking, error) // Untuk Admin melihat semua
+	FindAll(filter *models.BookingFilter, pagination *models.Pagination) ([]models.Booking, error) // Untuk Admin melihat semua
 
 	// Fungsi Logika Bisnis
-	UpdateStatus(id uint, newStatus string) error                             // Mengubah booking/payment status oleh Admin
-	CheckOverlap(roomID uint, checkInDate, checkOutDate string) (bool, error) // Pencegahan Double Booking
+	UpdateStatus(id uint, newStatus string) error // Mengubah booking/payment status oleh Admin
+	// CheckOverlap mencegah double booking; excludeBookingID (0 = tidak ada) dilewati saat booking itu sendiri diubah
+	CheckOverlap(roomID uint, checkInDate, checkOutDate string, excludeBookingID uint) (bool, error)
+	FindInHouse(date string) ([]models.Booking, error) // Tamu yang sudah check-in dan masih menginap pada tanggal tersebut
+
+	// Riwayat perubahan booking
+	// SaveModification menyimpan booking, transaksi selisih harga (baru/diubah), dan riwayat dalam satu transaksi;
+	// riwayat ditautkan ke transaksi baru pertama (adjustment/refund) jika ada
+	SaveModification(booking *models.Booking, modification *models.BookingModification, payments []*models.Payment) error
+	FindModifications(bookingID uint) ([]models.BookingModification, error)
+
+	// SaveExtras menyimpan booking beserta layanan tambahannya (baru/dihitung ulang), menghapus layanan
+	// tambahan removedIDs, dan menyimpan transaksi selisih harga dalam satu transaksi
+	SaveExtras(booking *models.Booking, removedIDs []uint, payments []*models.Payment) error
+}
+
+// BookingGroupRepository mengelola reservasi grup; kamar grup disimpan sebagai Booking biasa
+type BookingGroupRepository interface {
+	// Create menyimpan profil tamu utama (baru atau dilengkapi; nil = tidak berubah), grup, dan seluruh booking
+	// kamarnya dalam satu transaksi (semua atau tidak sama sekali)
+	Create(group *models.BookingGroup, leadGuest *models.Guest) error
+	FindByID(id uint) (*models.BookingGroup, error) // Termasuk booking kamar, urut berdasarkan ID
+	FindAll(filter *models.BookingGroupFilter, pagination *models.Pagination) ([]models.BookingGroup, error)
+	// Save menyimpan grup dan booking kamar yang berubah (pembatalan, rooming list, status bayar) dalam satu transaksi
+	Save(group *models.BookingGroup, bookings []*models.Booking) error
+}
+
+// WaitlistRepository mengelola antrean daftar tunggu kamar penuh
+type WaitlistRepository interface {
+	Create(entry *models.WaitlistEntry) error
+	Update(entry *models.WaitlistEntry) error
+	FindByID(id uint) (*models.WaitlistEntry, error)
+	FindByOfferTokenHash(tokenHash string) (*models.WaitlistEntry, error)
+	FindByUserID(userID uint) ([]models.WaitlistEntry, error)
+	FindAll(filter *models.WaitlistFilter, pagination *models.Pagination) ([]models.WaitlistEntry, error)
+	FindWaiting() ([]models.WaitlistEntry, error) // Antrean berstatus waiting, urut dari yang pertama
+	// Offer menyimpan penawaran (status, kamar, token, batas klaim) hanya jika antrean masih waiting dan kamar belum
+	// ditahan penawaran lain pada tanggal yang bertumpuk; aman dipanggil beberapa instance sekaligus.
+	// false = penawaran tidak disimpan.
+	Offer(entry *models.WaitlistEntry) (bool, error)
+	// Expire menandai penawaran yang lewat batas klaim dan antrean yang tanggal check-in-nya sudah lewat
+	Expire(now time.Time) (int64, error)
+	// IsHeld mengecek apakah kamar sedang ditahan untuk penawaran waitlist member lain pada rentang tanggal tersebut
+	IsHeld(roomID uint, checkInDate, checkOutDate string, exceptUserID uint) (bool, error)
+}
+
+type GuestRepository interface {
+	Create(guest *models.Guest) error
+	Update(guest *models.Guest) error
+	FindByID(id uint) (*models.Guest, error)
+	FindByIDs(ids []uint) ([]models.Guest, error)
+	FindAll(filter *models.GuestFilter, pagination *models.Pagination) ([]models.Guest, error)
+	// FindMatch mencari profil dengan email (lowercase) atau nomor identitas yang sama; email diutamakan
+	FindMatch(email, idNumber string) (*models.Guest, error)
+	// Stats menghitung riwayat menginap tamu (propertyIDs kosong = semua properti)
+	Stats(guestID uint, propertyIDs []uint) (*models.GuestStats, error)
+	HasBookingIn(guestID uint, propertyIDs []uint) (bool, error)
+	// Merge menyimpan profil target, memindahkan booking profil sumber ke target,
+	// lalu menghapus profil sumber dalam satu transaksi
+	Merge(target *models.Guest, sourceIDs []uint) error
+}
+
+type AuditRepository interface {
+	CreatePIIAccess(log *models.PIIAccessLog) error
+	FindPIIAccess(filter *models.PIIAccessFilter, pagination *models.Pagination) ([]models.PIIAccessLog, error)
+}
+
+type PrivacyRepository interface {
+	CreateRequest(request *models.PrivacyRequest) error
+	UpdateRequest(request *models.PrivacyRequest) error
+	FindRequestByID(id uint) (*models.PrivacyRequest, error)
+	FindRequests(filter *models.PrivacyRequestFilter, pagination *models.Pagination) ([]models.PrivacyRequest, error)
+
+	// CollectUserData mengumpulkan profil, booking, pembayaran, dan ulasan milik user
+	CollectUserData(userID uint) (*models.PersonalDataExport, error)
+	// CountActiveBookings menghitung booking confirmed yang belum selesai menginap per tanggal tersebut
+	CountActiveBookings(userID uint, date string) (int64, error)
+	// CompleteErasure menganonimkan user, booking, dan profil tamu yang hanya dipakai user tersebut,
+	// lalu menyimpan status permintaan dalam satu transaksi. Pembayaran & nominal booking tidak diubah.
+	CompleteErasure(request *models.PrivacyRequest) error
+}
+
+type HousekeepingRepository interface {
+	// CreateTasks membuat tugas yang belum ada (duplikat kamar+tanggal+jenis dilewati)
+	// dan menandai kamarnya dirty. Mengembalikan jumlah tugas baru.
+	CreateTasks(tasks []models.HousekeepingTask) (int, error)
+	// Update menyimpan tugas sekaligus status kebersihan kamarnya dalam satu transaksi (roomStatus "" = tidak diubah)
+	Update(task *models.HousekeepingTask, roomStatus string) error
+	FindByID(id uint) (*models.HousekeepingTask, error)
+	FindAll(filter *models.HousekeepingTaskFilter, pagination *models.Pagination) ([]models.HousekeepingTask, error)
 }
 
 type RoomImageRepository interface {
@@ -50,6 +229,9 @@ type RoomImageRepository interface {
 	FindByRoomID(roomID uint) ([]models.RoomImage, error)
 	// Tambahan
 	DeleteByRoomID(roomID uint) error
+	// Galeri: Create/Update dengan IsPrimary otomatis melepas primary lama dalam satu transaksi
+	CreateMany(images []*models.RoomImage) error
+	Reorder(roomID uint, imageIDs []uint, primaryImageID uint) error
 }
 
 type ReviewRepository interface {
@@ -5,6 +5,7 @@ import "backend/internal/domain/models"
 type PaymentRepository interface {
 	Create(payment *models.Payment) error
 	GetByID(id uint) (*models.Payment, error)
-	GetByBookingID(bookingID uint) (*models.Payment, error)
+	GetByBookingID(bookingID uint) (*models.Payment, error)   // Pembayaran utama booking (bukan adjustment/refund)
+	FindByBookingID(bookingID uint) ([]models.Payment, error) // Semua transaksi booking, urut dari yang pertama
 	Update(payment *models.Payment) error
 }
@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+// FileStorage adalah kontrak penyimpanan file (gambar kamar, dll).
+// Key adalah path relatif, contoh: "rooms/12_1764551417_kamar.jpg".
+type FileStorage interface {
+	// Put menyimpan isi reader ke key
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Delete menghapus object; tidak error jika object sudah tidak ada
+	Delete(ctx context.Context, key string) error
+	// URL mengembalikan URL yang bisa diakses client (bisa berupa signed URL yang kadaluarsa)
+	URL(ctx context.Context, key string) (string, error)
+}
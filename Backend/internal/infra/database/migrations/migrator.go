@@ -0,0 +1,300 @@
+package migrations
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Semua file migrasi SQL di-embed ke binary agar server tidak butuh file eksternal
+//
+//go:embed sql/*.sql
+var sqlFiles embed.FS
+
+// SourceDir adalah lokasi file migrasi di repo (dipakai oleh perintah `migrate create`)
+const SourceDir = "internal/infra/database/migrations/sql"
+
+// Format nama file: 000001_nama_migrasi.up.sql / 000001_nama_migrasi.down.sql
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrPendingMigrations dikembalikan jika masih ada migrasi yang belum dijalankan
+var ErrPendingMigrations = errors.New("skema database belum dimigrasi, jalankan `migrate up` terlebih dahulu")
+
+// Migration adalah satu versi skema dengan script up dan down
+type Migration struct {
+	Version   uint64
+	Name      string
+	UpSQL     string
+	DownSQL   string
+	AppliedAt *time.Time
+}
+
+// schemaMigration adalah catatan migrasi yang sudah dijalankan
+type schemaMigration struct {
+	Version   uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(255);not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator menjalankan migrasi berversi terhadap database
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator membaca semua migrasi yang di-embed
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(sqlFiles, "sql")
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+func loadMigrations(fsys fs.FS, root string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("nama file migrasi tidak valid: %s", entry.Name())
+		}
+
+		version, _ := strconv.ParseUint(matches[1], 10, 64)
+		content, err := fs.ReadFile(fsys, path.Join(root, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		} else if m.Name != matches[2] {
+			return nil, fmt.Errorf("versi migrasi %d dipakai dua nama: %s dan %s", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.UpSQL = string(content)
+		} else {
+			m.DownSQL = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.UpSQL == "" || m.DownSQL == "" {
+			return nil, fmt.Errorf("migrasi %06d_%s harus punya file up dan down", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// ensureTable membuat tabel schema_migrations jika belum ada
+func (m *Migrator) ensureTable() error {
+	return m.db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT UNSIGNED NOT NULL,
+		name       VARCHAR(255) NOT NULL,
+		applied_at DATETIME(3) NOT NULL,
+		PRIMARY KEY (version)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4`).Error
+}
+
+func (m *Migrator) applied() (map[uint64]time.Time, error) {
+	if err := m.ensureTable(); err != nil {
+		return nil, err
+	}
+
+	var rows []schemaMigration
+	if err := m.db.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64]time.Time, len(rows))
+	for _, row := range rows {
+		result[row.Version] = row.AppliedAt
+	}
+	return result, nil
+}
+
+// Status mengembalikan semua migrasi beserta waktu dijalankan (nil jika belum)
+func (m *Migrator) Status() ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Migration, len(m.migrations))
+	for i, migration := range m.migrations {
+		if at, ok := applied[migration.Version]; ok {
+			at := at
+			migration.AppliedAt = &at
+		}
+		result[i] = migration
+	}
+	return result, nil
+}
+
+// Pending mengembalikan migrasi yang belum dijalankan
+func (m *Migrator) Pending() ([]Migration, error) {
+	all, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range all {
+		if migration.AppliedAt == nil {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// EnsureUpToDate dipakai saat startup: server menolak jalan jika skema belum dimigrasi
+func (m *Migrator) EnsureUpToDate() error {
+	pending, err := m.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		names := make([]string, len(pending))
+		for i, p := range pending {
+			names[i] = fmt.Sprintf("%06d_%s", p.Version, p.Name)
+		}
+		return fmt.Errorf("%w (pending: %s)", ErrPendingMigrations, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// Up menjalankan semua migrasi yang belum dijalankan, berurutan
+func (m *Migrator) Up() ([]Migration, error) {
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range pending {
+		if err := m.exec(migration.UpSQL); err != nil {
+			return pending[:i], fmt.Errorf("migrasi %06d_%s gagal: %w", migration.Version, migration.Name, err)
+		}
+		record := schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}
+		if err := m.db.Create(&record).Error; err != nil {
+			return pending[:i], err
+		}
+	}
+	return pending, nil
+}
+
+// Down membatalkan sejumlah `steps` migrasi terakhir
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	all, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var rolledBack []Migration
+	for i := len(all) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+		migration := all[i]
+		if migration.AppliedAt == nil {
+			continue
+		}
+		if err := m.exec(migration.DownSQL); err != nil {
+			return rolledBack, fmt.Errorf("rollback %06d_%s gagal: %w", migration.Version, migration.Name, err)
+		}
+		if err := m.db.Delete(&schemaMigration{}, migration.Version).Error; err != nil {
+			return rolledBack, err
+		}
+		rolledBack = append(rolledBack, migration)
+	}
+	return rolledBack, nil
+}
+
+// exec menjalankan script SQL per statement.
+// Catatan: DDL MySQL tidak transaksional, jadi migrasi yang gagal di tengah harus diperbaiki manual.
+func (m *Migrator) exec(script string) error {
+	for _, stmt := range splitStatements(script) {
+		if err := m.db.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements memecah script berdasarkan ';' di akhir baris dan membuang komentar baris
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			stmt := strings.TrimSuffix(strings.TrimSpace(current.String()), ";")
+			statements = append(statements, stmt)
+			current.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// Create membuat pasangan file migrasi kosong dengan versi berikutnya di dir
+func Create(dir, name string) (upPath, downPath string, err error) {
+	name = strings.ToLower(regexp.MustCompile(`\W+`).ReplaceAllString(strings.TrimSpace(name), "_"))
+	if name == "" {
+		return "", "", errors.New("nama migrasi wajib diisi")
+	}
+
+	migrations, err := loadMigrations(os.DirFS(dir), ".")
+	if err != nil {
+		return "", "", err
+	}
+
+	var next uint64 = 1
+	if len(migrations) > 0 {
+		next = migrations[len(migrations)-1].Version + 1
+	}
+
+	base := fmt.Sprintf("%06d_%s", next, name)
+	upPath = filepath.Join(dir, base+".up.sql")
+	downPath = filepath.Join(dir, base+".down.sql")
+
+	if err := os.WriteFile(upPath, []byte("-- "+base+" (up)\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(downPath, []byte("-- "+base+" (down)\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	return upPath, downPath, nil
+}
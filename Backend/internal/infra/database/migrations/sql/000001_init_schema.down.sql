@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS bookings;
+DROP TABLE IF EXISTS room_images;
+DROP TABLE IF EXISTS rooms;
+DROP TABLE IF EXISTS users;
@@ -0,0 +1,105 @@
+-- Skema awal (setara dengan hasil AutoMigrate sebelumnya).
+-- Memakai IF NOT EXISTS agar database lama yang dibuat AutoMigrate bisa langsung diadopsi.
+
+CREATE TABLE IF NOT EXISTS users (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    deleted_at DATETIME(3) NULL,
+    username   VARCHAR(50)  NOT NULL,
+    password   VARCHAR(255) NOT NULL,
+    email      VARCHAR(100) NOT NULL,
+    full_name  VARCHAR(100) NOT NULL,
+    role       ENUM('admin', 'member') DEFAULT 'member',
+    language   VARCHAR(5) DEFAULT 'id',
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_users_username (username),
+    UNIQUE KEY uni_users_email (email),
+    KEY idx_users_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS rooms (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at    DATETIME(3) NULL,
+    updated_at    DATETIME(3) NULL,
+    deleted_at    DATETIME(3) NULL,
+    room_number   VARCHAR(10)   NOT NULL,
+    type          VARCHAR(50)   NOT NULL,
+    price         DECIMAL(10,2) NOT NULL,
+    description   TEXT,
+    status        ENUM('available', 'booked', 'maintenance') DEFAULT 'available',
+    max_occupancy BIGINT NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_rooms_room_number (room_number),
+    KEY idx_rooms_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS room_images (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    deleted_at DATETIME(3) NULL,
+    room_id    BIGINT UNSIGNED NOT NULL,
+    image_url  VARCHAR(255) NOT NULL,
+    is_primary BOOLEAN DEFAULT FALSE,
+    PRIMARY KEY (id),
+    KEY idx_room_images_deleted_at (deleted_at),
+    CONSTRAINT fk_rooms_images FOREIGN KEY (room_id) REFERENCES rooms (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS bookings (
+    id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at       DATETIME(3) NULL,
+    updated_at       DATETIME(3) NULL,
+    deleted_at       DATETIME(3) NULL,
+    user_id          BIGINT UNSIGNED NOT NULL,
+    room_id          BIGINT UNSIGNED NOT NULL,
+    check_in_date    DATE NOT NULL,
+    check_out_date   DATE NOT NULL,
+    total_price      DECIMAL(10,2) NOT NULL,
+    payment_method   VARCHAR(50),
+    payment_status   ENUM('pending', 'paid', 'failed') DEFAULT 'pending',
+    booking_status   ENUM('confirmed', 'cancelled', 'completed') DEFAULT 'confirmed',
+    guest_name       VARCHAR(255) NOT NULL,
+    guest_email      VARCHAR(255) NOT NULL,
+    guest_phone      VARCHAR(20)  NOT NULL,
+    guest_id_number  VARCHAR(50),
+    special_requests TEXT,
+    number_of_guests BIGINT DEFAULT 1,
+    PRIMARY KEY (id),
+    KEY idx_bookings_deleted_at (deleted_at),
+    CONSTRAINT fk_users_bookings FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_rooms_bookings FOREIGN KEY (room_id) REFERENCES rooms (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    deleted_at DATETIME(3) NULL,
+    booking_id BIGINT UNSIGNED NOT NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    rating     INT NOT NULL,
+    comment    TEXT,
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_reviews_booking_id (booking_id),
+    KEY idx_reviews_deleted_at (deleted_at),
+    CONSTRAINT fk_bookings_review FOREIGN KEY (booking_id) REFERENCES bookings (id),
+    CONSTRAINT chk_reviews_rating CHECK (rating >= 1 AND rating <= 5)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS payments (
+    id             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at     DATETIME(3) NULL,
+    updated_at     DATETIME(3) NULL,
+    deleted_at     DATETIME(3) NULL,
+    booking_id     BIGINT UNSIGNED NOT NULL,
+    amount         DECIMAL(10,2) NOT NULL,
+    payment_method VARCHAR(50) NOT NULL,
+    status         ENUM('pending', 'success', 'failed') DEFAULT 'pending',
+    transaction_id VARCHAR(100),
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_payments_transaction_id (transaction_id),
+    KEY idx_payments_deleted_at (deleted_at),
+    CONSTRAINT fk_bookings_payments FOREIGN KEY (booking_id) REFERENCES bookings (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
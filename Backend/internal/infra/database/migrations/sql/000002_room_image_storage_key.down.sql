@@ -0,0 +1 @@
+ALTER TABLE room_images DROP COLUMN storage_key;
@@ -0,0 +1,5 @@
+-- Simpan key object storage agar URL gambar bisa di-resolve (dan ditandatangani) saat dibaca
+ALTER TABLE room_images ADD COLUMN storage_key VARCHAR(255) NULL AFTER image_url;
+
+-- Gambar lama yang di-upload ke disk lokal: "/uploads/rooms/x.jpg" -> key "rooms/x.jpg"
+UPDATE room_images SET storage_key = SUBSTRING(image_url, 10) WHERE image_url LIKE '/uploads/%';
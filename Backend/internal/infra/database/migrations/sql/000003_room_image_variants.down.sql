@@ -0,0 +1,7 @@
+ALTER TABLE room_images
+    DROP COLUMN webp_key,
+    DROP COLUMN full_key,
+    DROP COLUMN card_key,
+    DROP COLUMN thumb_key,
+    DROP COLUMN height,
+    DROP COLUMN width;
@@ -0,0 +1,8 @@
+-- Dimensi gambar asli dan key varian hasil resize (thumb, card, full, webp)
+ALTER TABLE room_images
+    ADD COLUMN width INT NOT NULL DEFAULT 0 AFTER is_primary,
+    ADD COLUMN height INT NOT NULL DEFAULT 0 AFTER width,
+    ADD COLUMN thumb_key VARCHAR(255) NULL AFTER height,
+    ADD COLUMN card_key VARCHAR(255) NULL AFTER thumb_key,
+    ADD COLUMN full_key VARCHAR(255) NULL AFTER card_key,
+    ADD COLUMN webp_key VARCHAR(255) NULL AFTER full_key;
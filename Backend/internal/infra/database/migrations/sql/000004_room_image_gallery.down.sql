@@ -0,0 +1,8 @@
+ALTER TABLE room_images
+    DROP INDEX idx_room_images_single_primary,
+    DROP COLUMN primary_room_id;
+
+ALTER TABLE room_images
+    DROP COLUMN caption,
+    DROP COLUMN alt_text,
+    DROP COLUMN sort_order;
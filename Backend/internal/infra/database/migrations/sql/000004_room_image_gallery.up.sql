@@ -0,0 +1,27 @@
+-- Urutan galeri, teks alternatif, dan caption per gambar
+ALTER TABLE room_images
+    ADD COLUMN sort_order INT NOT NULL DEFAULT 0 AFTER is_primary,
+    ADD COLUMN alt_text VARCHAR(255) NULL AFTER sort_order,
+    ADD COLUMN caption VARCHAR(255) NULL AFTER alt_text;
+
+-- Urutan awal mengikuti urutan upload
+UPDATE room_images SET sort_order = id;
+
+-- Data lama bisa punya banyak gambar primary: pertahankan yang paling awal per kamar
+UPDATE room_images ri
+    JOIN (
+        SELECT room_id, MIN(id) AS keep_id
+        FROM room_images
+        WHERE is_primary = TRUE AND deleted_at IS NULL
+        GROUP BY room_id
+    ) p ON p.room_id = ri.room_id
+    SET ri.is_primary = (ri.id = p.keep_id)
+    WHERE ri.is_primary = TRUE;
+
+-- Gambar yang sudah di-soft-delete tidak boleh tetap primary
+UPDATE room_images SET is_primary = FALSE WHERE deleted_at IS NOT NULL;
+
+-- Tepat satu primary per kamar: kolom bernilai room_id hanya untuk gambar primary yang aktif
+ALTER TABLE room_images
+    ADD COLUMN primary_room_id BIGINT UNSIGNED AS (IF(is_primary AND deleted_at IS NULL, room_id, NULL)) STORED,
+    ADD UNIQUE INDEX idx_room_images_single_primary (primary_room_id);
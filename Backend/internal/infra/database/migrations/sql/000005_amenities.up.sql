@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS amenities (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    deleted_at DATETIME(3) NULL,
+    name       VARCHAR(100) NOT NULL,
+    icon       VARCHAR(50),
+    category   VARCHAR(50) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_amenities_name (name),
+    KEY idx_amenities_category (category),
+    KEY idx_amenities_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Tabel relasi many-to-many rooms <-> amenities
+CREATE TABLE IF NOT EXISTS room_amenities (
+    room_id    BIGINT UNSIGNED NOT NULL,
+    amenity_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (room_id, amenity_id),
+    KEY idx_room_amenities_amenity_id (amenity_id),
+    CONSTRAINT fk_room_amenities_room FOREIGN KEY (room_id) REFERENCES rooms (id) ON DELETE CASCADE,
+    CONSTRAINT fk_room_amenities_amenity FOREIGN KEY (amenity_id) REFERENCES amenities (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Katalog awal, bisa diubah lewat /api/admin/amenities
+INSERT IGNORE INTO amenities (created_at, updated_at, name, icon, category) VALUES
+    (NOW(3), NOW(3), 'Wi-Fi', 'wifi', 'technology'),
+    (NOW(3), NOW(3), 'Smart TV', 'tv', 'technology'),
+    (NOW(3), NOW(3), 'Air Conditioning', 'snowflake', 'comfort'),
+    (NOW(3), NOW(3), 'Minibar', 'wine', 'comfort'),
+    (NOW(3), NOW(3), 'Bathtub', 'bath', 'bathroom'),
+    (NOW(3), NOW(3), 'Rain Shower', 'shower', 'bathroom'),
+    (NOW(3), NOW(3), 'Sea View', 'waves', 'view'),
+    (NOW(3), NOW(3), 'City View', 'building', 'view'),
+    (NOW(3), NOW(3), 'Balcony', 'door-open', 'view');
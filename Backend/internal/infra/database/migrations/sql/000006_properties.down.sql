@@ -0,0 +1,15 @@
+DROP TABLE IF EXISTS user_properties;
+
+ALTER TABLE bookings
+    DROP FOREIGN KEY fk_properties_bookings,
+    DROP INDEX idx_bookings_property_id,
+    DROP COLUMN property_id;
+
+-- Gagal jika nomor kamar yang sama sudah dipakai di lebih dari satu properti
+ALTER TABLE rooms
+    DROP FOREIGN KEY fk_properties_rooms,
+    DROP INDEX idx_rooms_property_room_number,
+    ADD UNIQUE KEY uni_rooms_room_number (room_number),
+    DROP COLUMN property_id;
+
+DROP TABLE IF EXISTS properties;
@@ -0,0 +1,50 @@
+CREATE TABLE IF NOT EXISTS properties (
+    id             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at     DATETIME(3) NULL,
+    updated_at     DATETIME(3) NULL,
+    deleted_at     DATETIME(3) NULL,
+    code           VARCHAR(20)  NOT NULL,
+    name           VARCHAR(100) NOT NULL,
+    address        TEXT,
+    city           VARCHAR(100),
+    country        VARCHAR(100),
+    phone          VARCHAR(20),
+    timezone       VARCHAR(64) NOT NULL DEFAULT 'Asia/Jakarta',
+    currency       CHAR(3)     NOT NULL DEFAULT 'IDR',
+    check_in_time  VARCHAR(5)  NOT NULL DEFAULT '14:00',
+    check_out_time VARCHAR(5)  NOT NULL DEFAULT '12:00',
+    PRIMARY KEY (id),
+    UNIQUE KEY uni_properties_code (code),
+    KEY idx_properties_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Semua data lama menjadi milik satu properti default
+INSERT INTO properties (created_at, updated_at, code, name) VALUES (NOW(3), NOW(3), 'MAIN', 'Luxury Hotel');
+
+-- Kamar dimiliki properti; nomor kamar cukup unik di dalam satu properti
+ALTER TABLE rooms ADD COLUMN property_id BIGINT UNSIGNED NULL AFTER deleted_at;
+UPDATE rooms SET property_id = (SELECT MIN(id) FROM properties);
+ALTER TABLE rooms
+    MODIFY property_id BIGINT UNSIGNED NOT NULL,
+    DROP INDEX uni_rooms_room_number,
+    ADD UNIQUE KEY idx_rooms_property_room_number (property_id, room_number),
+    ADD CONSTRAINT fk_properties_rooms FOREIGN KEY (property_id) REFERENCES properties (id);
+
+-- Booking menyimpan properti kamar agar filter dan laporan tidak perlu join ke rooms
+ALTER TABLE bookings ADD COLUMN property_id BIGINT UNSIGNED NULL AFTER room_id;
+UPDATE bookings b JOIN rooms r ON r.id = b.room_id SET b.property_id = r.property_id;
+ALTER TABLE bookings
+    MODIFY property_id BIGINT UNSIGNED NOT NULL,
+    ADD KEY idx_bookings_property_id (property_id),
+    ADD CONSTRAINT fk_properties_bookings FOREIGN KEY (property_id) REFERENCES properties (id);
+
+-- Properti yang boleh dikelola staf; staf tanpa baris di sini tidak punya akses properti
+-- (kecuali admin grup, lihat users.is_group_admin di 000021)
+CREATE TABLE IF NOT EXISTS user_properties (
+    user_id     BIGINT UNSIGNED NOT NULL,
+    property_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (user_id, property_id),
+    KEY idx_user_properties_property_id (property_id),
+    CONSTRAINT fk_user_properties_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_properties_property FOREIGN KEY (property_id) REFERENCES properties (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
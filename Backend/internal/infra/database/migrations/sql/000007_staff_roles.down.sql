@@ -0,0 +1,15 @@
+-- Nilai yang tidak dikenal enum lama dikembalikan ke nilai terdekat sebelum kolom diubah
+UPDATE bookings SET payment_status = 'failed' WHERE payment_status = 'refunded';
+
+ALTER TABLE bookings
+    MODIFY payment_status ENUM('pending', 'paid', 'failed') DEFAULT 'pending';
+
+UPDATE payments SET status = 'failed' WHERE status = 'refunded';
+
+ALTER TABLE payments
+    MODIFY status ENUM('pending', 'success', 'failed') DEFAULT 'pending';
+
+UPDATE users SET role = 'member' WHERE role NOT IN ('admin', 'member');
+
+ALTER TABLE users
+    MODIFY role ENUM('admin', 'member') DEFAULT 'member';
@@ -0,0 +1,10 @@
+-- Role staf hotel (permission per role didefinisikan di models.RolePermissions)
+ALTER TABLE users
+    MODIFY role ENUM('admin', 'member', 'front_desk', 'housekeeping', 'revenue_manager', 'accountant') DEFAULT 'member';
+
+-- Status refund untuk pembayaran dan booking
+ALTER TABLE payments
+    MODIFY status ENUM('pending', 'success', 'failed', 'refunded') DEFAULT 'pending';
+
+ALTER TABLE bookings
+    MODIFY payment_status ENUM('pending', 'paid', 'failed', 'refunded') DEFAULT 'pending';
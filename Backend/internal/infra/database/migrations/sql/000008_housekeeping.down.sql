@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS housekeeping_tasks;
+
+ALTER TABLE bookings
+    DROP COLUMN checked_in_at;
+
+ALTER TABLE rooms
+    DROP COLUMN housekeeping_status;
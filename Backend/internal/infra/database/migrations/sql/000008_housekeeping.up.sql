@@ -0,0 +1,38 @@
+-- Status kebersihan kamar (terpisah dari status jual available/booked/maintenance)
+ALTER TABLE rooms
+    ADD COLUMN housekeeping_status ENUM('dirty', 'cleaning', 'clean', 'inspected') DEFAULT 'clean' AFTER max_occupancy;
+
+-- Waktu tamu check-in (dipakai untuk membuat tugas stay-over harian)
+ALTER TABLE bookings
+    ADD COLUMN checked_in_at DATETIME(3) NULL AFTER booking_status;
+
+CREATE TABLE IF NOT EXISTS housekeeping_tasks (
+    id              BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at      DATETIME(3) NULL,
+    updated_at      DATETIME(3) NULL,
+    deleted_at      DATETIME(3) NULL,
+    room_id         BIGINT UNSIGNED NOT NULL,
+    property_id     BIGINT UNSIGNED NOT NULL,
+    booking_id      BIGINT UNSIGNED NULL,
+    task_date       DATE NOT NULL,
+    type            ENUM('checkout', 'stayover') NOT NULL,
+    status          ENUM('pending', 'in_progress', 'completed', 'inspected') DEFAULT 'pending',
+    assigned_to_id  BIGINT UNSIGNED NULL,
+    inspected_by_id BIGINT UNSIGNED NULL,
+    notes           TEXT,
+    started_at      DATETIME(3) NULL,
+    completed_at    DATETIME(3) NULL,
+    inspected_at    DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    -- Satu tugas per kamar, tanggal, dan jenis: generator harian aman dijalankan berulang
+    UNIQUE KEY idx_housekeeping_tasks_room_date_type (room_id, task_date, type),
+    KEY idx_housekeeping_tasks_property_id (property_id),
+    KEY idx_housekeeping_tasks_booking_id (booking_id),
+    KEY idx_housekeeping_tasks_assigned_to_id (assigned_to_id),
+    KEY idx_housekeeping_tasks_deleted_at (deleted_at),
+    CONSTRAINT fk_housekeeping_tasks_room FOREIGN KEY (room_id) REFERENCES rooms (id) ON DELETE CASCADE,
+    CONSTRAINT fk_housekeeping_tasks_property FOREIGN KEY (property_id) REFERENCES properties (id),
+    CONSTRAINT fk_housekeeping_tasks_booking FOREIGN KEY (booking_id) REFERENCES bookings (id) ON DELETE SET NULL,
+    CONSTRAINT fk_housekeeping_tasks_assigned_to FOREIGN KEY (assigned_to_id) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT fk_housekeeping_tasks_inspected_by FOREIGN KEY (inspected_by_id) REFERENCES users (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
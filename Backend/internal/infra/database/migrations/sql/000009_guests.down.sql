@@ -0,0 +1,6 @@
+ALTER TABLE bookings
+    DROP FOREIGN KEY fk_bookings_guest,
+    DROP KEY idx_bookings_guest_id,
+    DROP COLUMN guest_id;
+
+DROP TABLE IF EXISTS guests;
@@ -0,0 +1,38 @@
+-- Profil tamu terpisah dari akun user; booking tetap menyimpan salinan data tamu
+CREATE TABLE IF NOT EXISTS guests (
+    id             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at     DATETIME(3) NULL,
+    updated_at     DATETIME(3) NULL,
+    deleted_at     DATETIME(3) NULL,
+    full_name      VARCHAR(255) NOT NULL,
+    email          VARCHAR(255),
+    phone          VARCHAR(20),
+    id_number      VARCHAR(50),
+    preferences    TEXT,
+    is_vip         BOOLEAN DEFAULT FALSE,
+    notes          TEXT,
+    merged_into_id BIGINT UNSIGNED NULL,
+    PRIMARY KEY (id),
+    -- Tidak unique: duplikat lama diselesaikan lewat merge oleh staf
+    KEY idx_guests_email (email),
+    KEY idx_guests_id_number (id_number),
+    KEY idx_guests_merged_into_id (merged_into_id),
+    KEY idx_guests_deleted_at (deleted_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE bookings
+    ADD COLUMN guest_id BIGINT UNSIGNED NULL AFTER checked_in_at,
+    ADD KEY idx_bookings_guest_id (guest_id),
+    ADD CONSTRAINT fk_bookings_guest FOREIGN KEY (guest_id) REFERENCES guests (id);
+
+-- Backfill: satu profil per email dari booking yang sudah ada
+INSERT INTO guests (created_at, updated_at, full_name, email, phone, id_number)
+SELECT MIN(created_at), MAX(updated_at), MAX(guest_name), LOWER(TRIM(guest_email)), MAX(guest_phone), MAX(NULLIF(guest_id_number, ''))
+FROM bookings
+WHERE guest_email <> ''
+GROUP BY LOWER(TRIM(guest_email));
+
+UPDATE bookings
+JOIN guests ON guests.email = LOWER(TRIM(bookings.guest_email))
+SET bookings.guest_id = guests.id
+WHERE bookings.guest_id IS NULL;
@@ -0,0 +1,16 @@
+-- Kolom dikembalikan ke ukuran semula: hanya berhasil jika data belum dienkripsi
+-- (ciphertext lebih panjang dari varchar lama)
+DROP TABLE IF EXISTS pii_access_logs;
+
+ALTER TABLE guests
+    DROP KEY idx_guests_id_number_hash,
+    DROP KEY idx_guests_phone_hash,
+    DROP COLUMN id_number_hash,
+    DROP COLUMN phone_hash,
+    MODIFY phone VARCHAR(20),
+    MODIFY id_number VARCHAR(50),
+    ADD KEY idx_guests_id_number (id_number);
+
+ALTER TABLE bookings
+    MODIFY guest_phone VARCHAR(20) NOT NULL,
+    MODIFY guest_id_number VARCHAR(50);
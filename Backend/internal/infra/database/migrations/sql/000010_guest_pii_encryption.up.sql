@@ -0,0 +1,33 @@
+-- Telepon & nomor identitas tamu disimpan terenkripsi (AES-256-GCM, format "enc:<key>:<base64>").
+-- Data lama tetap terbaca sebagai plaintext sampai `go run ./cmd reencrypt` dijalankan.
+ALTER TABLE bookings
+    MODIFY guest_phone VARCHAR(255) NOT NULL,
+    MODIFY guest_id_number VARCHAR(255);
+
+-- Ciphertext tidak bisa dicari langsung: pencarian & deduplikasi memakai blind index (HMAC-SHA256)
+ALTER TABLE guests
+    MODIFY phone VARCHAR(255),
+    MODIFY id_number VARCHAR(255),
+    DROP KEY idx_guests_id_number,
+    ADD COLUMN phone_hash CHAR(64) NULL AFTER phone,
+    ADD COLUMN id_number_hash CHAR(64) NULL AFTER id_number,
+    ADD KEY idx_guests_phone_hash (phone_hash),
+    ADD KEY idx_guests_id_number_hash (id_number_hash);
+
+-- Log setiap akses data pribadi lengkap oleh staf (append-only, tanpa soft delete)
+CREATE TABLE IF NOT EXISTS pii_access_logs (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at    DATETIME(3) NULL,
+    user_id       BIGINT UNSIGNED NOT NULL,
+    resource_type ENUM('guest', 'booking') NOT NULL,
+    resource_id   BIGINT UNSIGNED NOT NULL,
+    guest_id      BIGINT UNSIGNED NULL,
+    fields        VARCHAR(100),
+    reason        VARCHAR(255) NOT NULL,
+    ip_address    VARCHAR(45),
+    PRIMARY KEY (id),
+    KEY idx_pii_access_logs_created_at (created_at),
+    KEY idx_pii_access_logs_user_id (user_id),
+    KEY idx_pii_access_logs_guest_id (guest_id),
+    CONSTRAINT fk_pii_access_logs_user FOREIGN KEY (user_id) REFERENCES users (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
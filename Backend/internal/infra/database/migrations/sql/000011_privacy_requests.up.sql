@@ -0,0 +1,21 @@
+-- Permintaan ekspor & penghapusan data pribadi member (UU PDP).
+-- Ekspor langsung tercatat completed; penghapusan menunggu persetujuan admin.
+CREATE TABLE IF NOT EXISTS privacy_requests (
+    id             BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at     DATETIME(3) NULL,
+    updated_at     DATETIME(3) NULL,
+    deleted_at     DATETIME(3) NULL,
+    user_id        BIGINT UNSIGNED NOT NULL,
+    type           ENUM('export', 'erasure') NOT NULL,
+    status         ENUM('pending', 'completed', 'rejected') DEFAULT 'pending',
+    reason         TEXT,
+    reviewed_by_id BIGINT UNSIGNED NULL,
+    review_note    VARCHAR(255),
+    reviewed_at    DATETIME(3) NULL,
+    completed_at   DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    KEY idx_privacy_requests_deleted_at (deleted_at),
+    KEY idx_privacy_requests_user_id (user_id),
+    KEY idx_privacy_requests_reviewed_by_id (reviewed_by_id),
+    CONSTRAINT fk_privacy_requests_user FOREIGN KEY (user_id) REFERENCES users (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
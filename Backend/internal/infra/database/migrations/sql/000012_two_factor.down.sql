@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS recovery_codes;
+
+ALTER TABLE users
+    DROP COLUMN two_factor_last_step,
+    DROP COLUMN two_factor_secret,
+    DROP COLUMN two_factor_enabled_at,
+    DROP COLUMN two_factor_enabled;
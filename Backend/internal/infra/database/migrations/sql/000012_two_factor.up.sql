@@ -0,0 +1,19 @@
+-- Two-factor authentication (TOTP). Secret disimpan terenkripsi seperti data pribadi tamu.
+ALTER TABLE users
+    ADD COLUMN two_factor_enabled    BOOLEAN DEFAULT FALSE,
+    ADD COLUMN two_factor_enabled_at DATETIME(3) NULL,
+    ADD COLUMN two_factor_secret     VARCHAR(255),
+    ADD COLUMN two_factor_last_step  BIGINT NOT NULL DEFAULT 0;
+
+-- Recovery code sekali pakai; hanya hash SHA-256 yang disimpan
+CREATE TABLE IF NOT EXISTS recovery_codes (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    code_hash  CHAR(64) NOT NULL,
+    used_at    DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_recovery_codes_code_hash (code_hash),
+    KEY idx_recovery_codes_user_id (user_id),
+    CONSTRAINT fk_recovery_codes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
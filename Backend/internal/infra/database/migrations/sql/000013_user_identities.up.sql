@@ -0,0 +1,28 @@
+-- Identitas login eksternal (OpenID Connect); satu provider + subject hanya milik satu user
+CREATE TABLE IF NOT EXISTS user_identities (
+    id            BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at    DATETIME(3) NULL,
+    updated_at    DATETIME(3) NULL,
+    user_id       BIGINT UNSIGNED NOT NULL,
+    provider      VARCHAR(50) NOT NULL,
+    subject       VARCHAR(255) NOT NULL,
+    email         VARCHAR(100),
+    last_login_at DATETIME(3) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_user_identities_provider_subject (provider, subject),
+    KEY idx_user_identities_user_id (user_id),
+    CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- State login sementara (state, nonce, PKCE code verifier); dihapus saat dipakai atau kedaluwarsa
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    state         VARCHAR(64) NOT NULL,
+    created_at    DATETIME(3) NULL,
+    provider      VARCHAR(50) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce         VARCHAR(64) NOT NULL,
+    user_id       BIGINT UNSIGNED NULL,
+    expires_at    DATETIME(3) NOT NULL,
+    PRIMARY KEY (state),
+    KEY idx_oidc_login_states_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+ALTER TABLE users
+    DROP KEY idx_users_deactivated_at,
+    DROP COLUMN deactivated_at;
@@ -0,0 +1,4 @@
+-- Admin menonaktifkan akun alih-alih menghapusnya; NULL = aktif
+ALTER TABLE users
+    ADD COLUMN deactivated_at DATETIME(3) NULL,
+    ADD KEY idx_users_deactivated_at (deactivated_at);
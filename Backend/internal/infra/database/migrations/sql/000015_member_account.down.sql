@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS email_change_requests;
+DROP TABLE IF EXISTS saved_guests;
@@ -0,0 +1,30 @@
+-- Data tamu tersimpan milik member untuk mengisi form booking lebih cepat (telepon & nomor identitas terenkripsi)
+CREATE TABLE IF NOT EXISTS saved_guests (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    updated_at DATETIME(3) NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    label      VARCHAR(50),
+    full_name  VARCHAR(255) NOT NULL,
+    email      VARCHAR(255),
+    phone      VARCHAR(255),
+    id_number  VARCHAR(255),
+    is_default TINYINT(1) NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    KEY idx_saved_guests_user_id (user_id),
+    CONSTRAINT fk_saved_guests_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Permintaan ganti email yang menunggu verifikasi; satu permintaan aktif per user, token disimpan sebagai hash
+CREATE TABLE IF NOT EXISTS email_change_requests (
+    id         BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(3) NULL,
+    user_id    BIGINT UNSIGNED NOT NULL,
+    new_email  VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_email_change_requests_user_id (user_id),
+    UNIQUE KEY idx_email_change_requests_token_hash (token_hash),
+    CONSTRAINT fk_email_change_requests_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
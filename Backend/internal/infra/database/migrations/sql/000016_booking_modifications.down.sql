@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS booking_modifications;
+
+UPDATE payments SET status = 'failed' WHERE status = 'cancelled';
+DELETE FROM payments WHERE type <> 'payment';
+
+ALTER TABLE payments
+    DROP KEY idx_payments_booking_id_type,
+    DROP COLUMN type,
+    MODIFY status ENUM('pending', 'success', 'failed', 'refunded') DEFAULT 'pending';
@@ -0,0 +1,31 @@
+-- Jenis transaksi: pembayaran booking, tagihan selisih (adjustment), atau refund selisih setelah booking diubah.
+-- Status cancelled untuk tagihan pending yang digantikan tagihan baru.
+ALTER TABLE payments
+    ADD COLUMN type ENUM('payment', 'adjustment', 'refund') NOT NULL DEFAULT 'payment' AFTER booking_id,
+    MODIFY status ENUM('pending', 'success', 'failed', 'refunded', 'cancelled') DEFAULT 'pending',
+    ADD KEY idx_payments_booking_id_type (booking_id, type);
+
+-- Riwayat perubahan booking (nilai lama & baru)
+CREATE TABLE IF NOT EXISTS booking_modifications (
+    id                   BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at           DATETIME(3) NULL,
+    booking_id           BIGINT UNSIGNED NOT NULL,
+    modified_by          BIGINT UNSIGNED NOT NULL,
+    reason               VARCHAR(255),
+    old_room_id          BIGINT UNSIGNED,
+    new_room_id          BIGINT UNSIGNED,
+    old_check_in_date    DATE,
+    new_check_in_date    DATE,
+    old_check_out_date   DATE,
+    new_check_out_date   DATE,
+    old_number_of_guests BIGINT,
+    new_number_of_guests BIGINT,
+    old_total_price      DECIMAL(10,2),
+    new_total_price      DECIMAL(10,2),
+    price_difference     DECIMAL(10,2),
+    payment_id           BIGINT UNSIGNED NULL,
+    PRIMARY KEY (id),
+    KEY idx_booking_modifications_booking_id (booking_id),
+    CONSTRAINT fk_booking_modifications_booking FOREIGN KEY (booking_id) REFERENCES bookings (id),
+    CONSTRAINT fk_booking_modifications_payment FOREIGN KEY (payment_id) REFERENCES payments (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
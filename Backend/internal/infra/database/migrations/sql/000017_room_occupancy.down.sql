@@ -0,0 +1,22 @@
+ALTER TABLE booking_modifications
+    DROP COLUMN old_adults,
+    DROP COLUMN new_adults,
+    DROP COLUMN old_children,
+    DROP COLUMN new_children,
+    DROP COLUMN old_extra_beds,
+    DROP COLUMN new_extra_beds;
+
+ALTER TABLE bookings
+    DROP COLUMN adults,
+    DROP COLUMN children,
+    DROP COLUMN extra_beds,
+    DROP COLUMN extra_person_charge,
+    DROP COLUMN extra_bed_charge;
+
+ALTER TABLE rooms
+    DROP COLUMN max_adults,
+    DROP COLUMN base_occupancy,
+    DROP COLUMN extra_adult_price,
+    DROP COLUMN extra_child_price,
+    DROP COLUMN max_extra_beds,
+    DROP COLUMN extra_bed_price;
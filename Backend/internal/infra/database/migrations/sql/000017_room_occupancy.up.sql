@@ -0,0 +1,29 @@
+-- Aturan okupansi kamar & biaya tamu tambahan / extra bed (per malam)
+ALTER TABLE rooms
+    ADD COLUMN max_adults INT NOT NULL DEFAULT 0 AFTER max_occupancy,
+    ADD COLUMN base_occupancy INT NOT NULL DEFAULT 0 AFTER max_adults,
+    ADD COLUMN extra_adult_price DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER base_occupancy,
+    ADD COLUMN extra_child_price DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER extra_adult_price,
+    ADD COLUMN max_extra_beds INT NOT NULL DEFAULT 0 AFTER extra_child_price,
+    ADD COLUMN extra_bed_price DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER max_extra_beds;
+
+-- Susunan tamu booking & rincian biaya tambahan (sudah termasuk total_price)
+ALTER TABLE bookings
+    ADD COLUMN adults INT NOT NULL DEFAULT 1 AFTER number_of_guests,
+    ADD COLUMN children INT NOT NULL DEFAULT 0 AFTER adults,
+    ADD COLUMN extra_beds INT NOT NULL DEFAULT 0 AFTER children,
+    ADD COLUMN extra_person_charge DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER extra_beds,
+    ADD COLUMN extra_bed_charge DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER extra_person_charge;
+
+-- Booking lama tidak punya rincian anak: semua tamu dianggap dewasa
+UPDATE bookings SET adults = GREATEST(number_of_guests, 1);
+
+ALTER TABLE booking_modifications
+    ADD COLUMN old_adults INT NOT NULL DEFAULT 0 AFTER new_number_of_guests,
+    ADD COLUMN new_adults INT NOT NULL DEFAULT 0 AFTER old_adults,
+    ADD COLUMN old_children INT NOT NULL DEFAULT 0 AFTER new_adults,
+    ADD COLUMN new_children INT NOT NULL DEFAULT 0 AFTER old_children,
+    ADD COLUMN old_extra_beds INT NOT NULL DEFAULT 0 AFTER new_children,
+    ADD COLUMN new_extra_beds INT NOT NULL DEFAULT 0 AFTER old_extra_beds;
+
+UPDATE booking_modifications SET old_adults = old_number_of_guests, new_adults = new_number_of_guests;
@@ -0,0 +1,14 @@
+DELETE FROM payments WHERE group_id IS NOT NULL;
+
+ALTER TABLE payments
+    DROP FOREIGN KEY fk_booking_groups_payments,
+    DROP KEY idx_payments_group_id,
+    DROP COLUMN group_id,
+    MODIFY booking_id BIGINT UNSIGNED NOT NULL;
+
+ALTER TABLE bookings
+    DROP FOREIGN KEY fk_booking_groups_bookings,
+    DROP KEY idx_bookings_group_id,
+    DROP COLUMN group_id;
+
+DROP TABLE IF EXISTS booking_groups;
@@ -0,0 +1,40 @@
+-- Reservasi grup: beberapa kamar (booking) dengan satu tamu utama dan satu pembayaran
+CREATE TABLE IF NOT EXISTS booking_groups (
+    id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at       DATETIME(3) NULL,
+    updated_at       DATETIME(3) NULL,
+    deleted_at       DATETIME(3) NULL,
+    code             VARCHAR(20) NOT NULL,
+    user_id          BIGINT UNSIGNED NOT NULL,
+    property_id      BIGINT UNSIGNED NOT NULL,
+    name             VARCHAR(100),
+    lead_guest_id    BIGINT UNSIGNED NULL,
+    lead_guest_name  VARCHAR(255) NOT NULL,
+    lead_guest_email VARCHAR(255) NOT NULL,
+    lead_guest_phone VARCHAR(255) NOT NULL,
+    special_requests TEXT,
+    payment_method   VARCHAR(50),
+    payment_status   ENUM('pending', 'paid', 'failed', 'refunded') DEFAULT 'pending',
+    status           ENUM('confirmed', 'cancelled') DEFAULT 'confirmed',
+    PRIMARY KEY (id),
+    UNIQUE KEY idx_booking_groups_code (code),
+    KEY idx_booking_groups_deleted_at (deleted_at),
+    KEY idx_booking_groups_user_id (user_id),
+    KEY idx_booking_groups_property_id (property_id),
+    KEY idx_booking_groups_lead_guest_id (lead_guest_id),
+    CONSTRAINT fk_booking_groups_user FOREIGN KEY (user_id) REFERENCES users (id),
+    CONSTRAINT fk_booking_groups_property FOREIGN KEY (property_id) REFERENCES properties (id),
+    CONSTRAINT fk_booking_groups_lead_guest FOREIGN KEY (lead_guest_id) REFERENCES guests (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE bookings
+    ADD COLUMN group_id BIGINT UNSIGNED NULL AFTER property_id,
+    ADD KEY idx_bookings_group_id (group_id),
+    ADD CONSTRAINT fk_booking_groups_bookings FOREIGN KEY (group_id) REFERENCES booking_groups (id);
+
+-- Pembayaran grup tidak terikat ke satu booking: tepat satu dari booking_id / group_id terisi
+ALTER TABLE payments
+    MODIFY booking_id BIGINT UNSIGNED NULL,
+    ADD COLUMN group_id BIGINT UNSIGNED NULL AFTER booking_id,
+    ADD KEY idx_payments_group_id (group_id),
+    ADD CONSTRAINT fk_booking_groups_payments FOREIGN KEY (group_id) REFERENCES booking_groups (id);
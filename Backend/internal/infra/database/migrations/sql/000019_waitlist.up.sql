@@ -0,0 +1,33 @@
+-- Daftar tunggu untuk tanggal penuh; kamar yang kosong ditawarkan ke antrean terdepan
+-- dan ditahan sampai offer_expires_at (token link klaim disimpan sebagai hash)
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id               BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at       DATETIME(3) NULL,
+    updated_at       DATETIME(3) NULL,
+    user_id          BIGINT UNSIGNED NOT NULL,
+    property_id      BIGINT UNSIGNED NOT NULL,
+    room_id          BIGINT UNSIGNED NULL,
+    room_type        VARCHAR(50),
+    check_in_date    DATE NOT NULL,
+    check_out_date   DATE NOT NULL,
+    adults           INT NOT NULL DEFAULT 1,
+    children         INT NOT NULL DEFAULT 0,
+    status           ENUM('waiting', 'offered', 'claimed', 'expired', 'cancelled') DEFAULT 'waiting',
+    offered_room_id  BIGINT UNSIGNED NULL,
+    offer_token_hash CHAR(64),
+    offered_at       DATETIME(3) NULL,
+    offer_expires_at DATETIME(3) NULL,
+    booking_id       BIGINT UNSIGNED NULL,
+    PRIMARY KEY (id),
+    KEY idx_waitlist_entries_user_id (user_id),
+    KEY idx_waitlist_entries_property_id (property_id),
+    KEY idx_waitlist_entries_room_id (room_id),
+    KEY idx_waitlist_entries_status (status),
+    KEY idx_waitlist_entries_offer_token_hash (offer_token_hash),
+    KEY idx_waitlist_entries_offered_room (offered_room_id, status),
+    CONSTRAINT fk_waitlist_entries_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_waitlist_entries_property FOREIGN KEY (property_id) REFERENCES properties (id),
+    CONSTRAINT fk_waitlist_entries_room FOREIGN KEY (room_id) REFERENCES rooms (id) ON DELETE CASCADE,
+    CONSTRAINT fk_waitlist_entries_offered_room FOREIGN KEY (offered_room_id) REFERENCES rooms (id) ON DELETE SET NULL,
+    CONSTRAINT fk_waitlist_entries_booking FOREIGN KEY (booking_id) REFERENCES bookings (id) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
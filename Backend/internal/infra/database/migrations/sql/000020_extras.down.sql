@@ -0,0 +1,5 @@
+ALTER TABLE bookings
+    DROP COLUMN extras_charge;
+
+DROP TABLE IF EXISTS booking_extras;
+DROP TABLE IF EXISTS extras;
@@ -0,0 +1,41 @@
+-- Katalog layanan tambahan per properti (sarapan, antar-jemput bandara, spa, late check-out)
+CREATE TABLE IF NOT EXISTS extras (
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at   DATETIME(3) NULL,
+    updated_at   DATETIME(3) NULL,
+    deleted_at   DATETIME(3) NULL,
+    property_id  BIGINT UNSIGNED NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    description  TEXT,
+    pricing_type ENUM('per_night', 'per_person', 'per_stay') NOT NULL,
+    price        DECIMAL(10,2) NOT NULL,
+    is_active    BOOLEAN DEFAULT TRUE,
+    PRIMARY KEY (id),
+    KEY idx_extras_property_id (property_id),
+    KEY idx_extras_deleted_at (deleted_at),
+    CONSTRAINT fk_extras_property FOREIGN KEY (property_id) REFERENCES properties (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Layanan tambahan per booking; nama, cara hitung & harga disalin dari katalog saat ditambahkan
+CREATE TABLE IF NOT EXISTS booking_extras (
+    id           BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at   DATETIME(3) NULL,
+    updated_at   DATETIME(3) NULL,
+    booking_id   BIGINT UNSIGNED NOT NULL,
+    extra_id     BIGINT UNSIGNED NOT NULL,
+    name         VARCHAR(100) NOT NULL,
+    pricing_type ENUM('per_night', 'per_person', 'per_stay') NOT NULL,
+    unit_price   DECIMAL(10,2) NOT NULL,
+    quantity     INT NOT NULL DEFAULT 1,
+    total        DECIMAL(10,2) NOT NULL,
+    added_by     BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (id),
+    KEY idx_booking_extras_booking_id (booking_id),
+    KEY idx_booking_extras_extra_id (extra_id),
+    CONSTRAINT fk_bookings_extras FOREIGN KEY (booking_id) REFERENCES bookings (id) ON DELETE CASCADE,
+    CONSTRAINT fk_booking_extras_extra FOREIGN KEY (extra_id) REFERENCES extras (id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- Total layanan tambahan booking (sudah termasuk total_price, dipisah untuk laporan pendapatan)
+ALTER TABLE bookings
+    ADD COLUMN extras_charge DECIMAL(10,2) NOT NULL DEFAULT 0 AFTER extra_bed_charge;
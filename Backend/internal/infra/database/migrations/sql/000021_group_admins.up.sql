@@ -0,0 +1,6 @@
+-- Admin grup ditandai eksplisit: staf tanpa penugasan properti tidak lagi dianggap admin grup
+ALTER TABLE users ADD COLUMN is_group_admin BOOLEAN NOT NULL DEFAULT FALSE AFTER role;
+
+-- Admin tanpa penugasan properti (admin grup sebelum migrasi ini) tetap admin grup
+UPDATE users SET is_group_admin = TRUE
+WHERE role = 'admin' AND id NOT IN (SELECT user_id FROM user_properties);
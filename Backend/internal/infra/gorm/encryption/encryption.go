@@ -0,0 +1,179 @@
+// Package encryption menghubungkan fieldcrypt ke GORM: serializer "encrypted" untuk kolom
+// data pribadi tamu & secret 2FA, serta proses enkripsi ulang data lama (rotasi kunci).
+package encryption
+
+import (
+	"backend/pkg/fieldcrypt"
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// serializer mengenkripsi nilai saat disimpan dan mendekripsi saat dibaca
+type serializer struct {
+	keyring *fieldcrypt.Keyring
+}
+
+// Register mendaftarkan serializer "encrypted"; wajib dipanggil sebelum query pertama
+func Register(keyring *fieldcrypt.Keyring) {
+	schema.RegisterSerializer("encrypted", serializer{keyring: keyring})
+}
+
+func (s serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var raw string
+	switch v := dbValue.(type) {
+	case []byte:
+		raw = string(v)
+	case string:
+		raw = v
+	case nil:
+	default:
+		return fmt.Errorf("kolom terenkripsi %s: tipe %T tidak didukung", field.Name, dbValue)
+	}
+
+	plaintext, err := s.keyring.Decrypt(raw)
+	if err != nil {
+		return fmt.Errorf("kolom terenkripsi %s: %w", field.Name, err)
+	}
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+	return nil
+}
+
+func (s serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	return s.keyring.Encrypt(reflect.ValueOf(fieldValue).String())
+}
+
+// column adalah kolom terenkripsi, opsional dengan kolom blind index-nya
+type column struct {
+	name      string
+	indexName string
+}
+
+// table adalah tabel yang memiliki kolom terenkripsi
+type table struct {
+	name    string
+	columns []column
+}
+
+var encryptedTables = []table{
+	{name: "bookings", columns: []column{{name: "guest_phone"}, {name: "guest_id_number"}}},
+	{name: "guests", columns: []column{{name: "phone", indexName: "phone_hash"}, {name: "id_number", indexName: "id_number_hash"}}},
+	{name: "users", columns: []column{{name: "two_factor_secret"}}},
+	{name: "saved_guests", columns: []column{{name: "phone"}, {name: "id_number"}}},
+	{name: "booking_groups", columns: []column{{name: "lead_guest_phone"}}},
+}
+
+const batchSize = 500
+
+// Reencrypt mengenkripsi ulang semua kolom data pribadi dengan kunci aktif:
+// plaintext lama dienkripsi, ciphertext dengan kunci lama diganti, dan blind index dihitung ulang.
+// Aman dijalankan berulang; mengembalikan jumlah baris yang diubah per tabel.
+func Reencrypt(db *gorm.DB, keyring *fieldcrypt.Keyring) (map[string]int, error) {
+	updated := map[string]int{}
+	for _, t := range encryptedTables {
+		count, err := reencryptTable(db, keyring, t)
+		updated[t.name] = count
+		if err != nil {
+			return updated, fmt.Errorf("tabel %s: %w", t.name, err)
+		}
+	}
+	return updated, nil
+}
+
+func reencryptTable(db *gorm.DB, keyring *fieldcrypt.Keyring, t table) (int, error) {
+	selectCols := []string{"id"}
+	for _, col := range t.columns {
+		selectCols = append(selectCols, col.name)
+		if col.indexName != "" {
+			selectCols = append(selectCols, col.indexName)
+		}
+	}
+
+	updated := 0
+	var lastID uint
+	for {
+		// Baca nilai mentah lewat map agar serializer tidak ikut mendekripsi
+		var rows []map[string]interface{}
+		err := db.Table(t.name).Select(selectCols).Where("id > ?", lastID).
+			Order("id").Limit(batchSize).Find(&rows).Error
+		if err != nil {
+			return updated, err
+		}
+		if len(rows) == 0 {
+			return updated, nil
+		}
+
+		for _, row := range rows {
+			id := toUint(row["id"])
+			if id <= lastID {
+				return updated, fmt.Errorf("tipe kolom id tidak dikenali: %T", row["id"])
+			}
+			lastID = id
+			changes := map[string]interface{}{}
+
+			for _, col := range t.columns {
+				raw := toString(row[col.name])
+				plaintext, err := keyring.Decrypt(raw)
+				if err != nil {
+					return updated, fmt.Errorf("id %d kolom %s: %w", lastID, col.name, err)
+				}
+				if keyring.NeedsReencrypt(raw) {
+					if changes[col.name], err = keyring.Encrypt(plaintext); err != nil {
+						return updated, err
+					}
+				}
+				if col.indexName != "" {
+					if hash := keyring.BlindIndex(plaintext); hash != toString(row[col.indexName]) {
+						changes[col.indexName] = hash
+					}
+				}
+			}
+
+			if len(changes) == 0 {
+				continue
+			}
+			// UpdateColumns pada Table: tidak menyentuh updated_at dan tidak melewati serializer
+			if err := db.Table(t.name).Where("id = ?", lastID).UpdateColumns(changes).Error; err != nil {
+				return updated, err
+			}
+			updated++
+		}
+	}
+}
+
+func toString(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+func toUint(value interface{}) uint {
+	switch v := value.(type) {
+	case int64:
+		return uint(v)
+	case uint64:
+		return uint(v)
+	case int32:
+		return uint(v)
+	case uint32:
+		return uint(v)
+	case int:
+		return uint(v)
+	case uint:
+		return v
+	case []byte:
+		id, _ := strconv.ParseUint(string(v), 10, 64)
+		return uint(id)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormAmenityRepository struct {
+	db *gorm.DB
+}
+
+func NewGormAmenityRepository(db *gorm.DB) repositories.AmenityRepository {
+	return &gormAmenityRepository{db: db}
+}
+
+func (r *gormAmenityRepository) Create(amenity *models.Amenity) error {
+	return r.db.Create(amenity).Error
+}
+
+func (r *gormAmenityRepository) Update(amenity *models.Amenity) error {
+	return r.db.Save(amenity).Error
+}
+
+// Delete menghapus permanen agar nama amenity bisa dipakai lagi;
+// relasi di room_amenities ikut terhapus (ON DELETE CASCADE)
+func (r *gormAmenityRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&models.Amenity{}, id).Error
+}
+
+func (r *gormAmenityRepository) FindByID(id uint) (*models.Amenity, error) {
+	var amenity models.Amenity
+	if err := r.db.First(&amenity, id).Error; err != nil {
+		return nil, err
+	}
+	return &amenity, nil
+}
+
+func (r *gormAmenityRepository) FindByIDs(ids []uint) ([]models.Amenity, error) {
+	var amenities []models.Amenity
+	if len(ids) == 0 {
+		return amenities, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&amenities).Error; err != nil {
+		return nil, err
+	}
+	return amenities, nil
+}
+
+// FindAll mengambil semua amenity, dikelompokkan per kategori (category kosong = semua)
+func (r *gormAmenityRepository) FindAll(category string) ([]models.Amenity, error) {
+	var amenities []models.Amenity
+	query := r.db.Order("category, name")
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+	if err := query.Find(&amenities).Error; err != nil {
+		return nil, err
+	}
+	return amenities, nil
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormAuditRepository struct {
+	db *gorm.DB
+}
+
+func NewGormAuditRepository(db *gorm.DB) repositories.AuditRepository {
+	return &gormAuditRepository{db: db}
+}
+
+func (r *gormAuditRepository) CreatePIIAccess(log *models.PIIAccessLog) error {
+	return r.db.Create(log).Error
+}
+
+func (r *gormAuditRepository) FindPIIAccess(filter *models.PIIAccessFilter, pagination *models.Pagination) ([]models.PIIAccessLog, error) {
+	var logs []models.PIIAccessLog
+	query := r.db.Preload("User", selectAssignee).Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.UserID != 0 {
+			query = query.Where("user_id = ?", filter.UserID)
+		}
+		if filter.GuestID != 0 {
+			query = query.Where("guest_id = ?", filter.GuestID)
+		}
+		if !filter.From.IsZero() {
+			query = query.Where("created_at >= ?", filter.From)
+		}
+		if !filter.To.IsZero() {
+			query = query.Where("created_at < ?", filter.To)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&logs).Error; err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"backend/pkg/fieldcrypt"
+
+	"gorm.io/gorm"
+)
+
+type gormBookingGroupRepository struct {
+	db      *gorm.DB
+	keyring *fieldcrypt.Keyring // Blind index profil tamu utama
+}
+
+func NewGormBookingGroupRepository(db *gorm.DB, keyring *fieldcrypt.Keyring) repositories.BookingGroupRepository {
+	return &gormBookingGroupRepository{db: db, keyring: keyring}
+}
+
+func preloadGroupBookings(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
+func (r *gormBookingGroupRepository) Create(group *models.BookingGroup, leadGuest *models.Guest) error {
+	// Booking kamar ikut tersimpan lewat asosiasi; gagal satu kamar = seluruh grup (dan profil tamu baru) dibatalkan
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if leadGuest != nil {
+			indexGuest(r.keyring, leadGuest)
+			if err := tx.Save(leadGuest).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(group).Error
+	})
+}
+
+func (r *gormBookingGroupRepository) FindByID(id uint) (*models.BookingGroup, error) {
+	var group models.BookingGroup
+	if err := r.db.Preload("Bookings", preloadGroupBookings).First(&group, id).Error; err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
+
+func (r *gormBookingGroupRepository) FindAll(filter *models.BookingGroupFilter, pagination *models.Pagination) ([]models.BookingGroup, error) {
+	var groups []models.BookingGroup
+	query := r.db.Preload("Bookings", preloadGroupBookings).Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.UserID != 0 {
+			query = query.Where("user_id = ?", filter.UserID)
+		}
+		if filter.PropertyID != 0 {
+			query = query.Where("property_id = ?", filter.PropertyID)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("property_id IN ?", filter.PropertyIDs)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (r *gormBookingGroupRepository) Save(group *models.BookingGroup, bookings []*models.Booking) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Bookings").Save(group).Error; err != nil {
+			return err
+		}
+		for _, booking := range bookings {
+			if err := tx.Save(booking).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -3,7 +3,6 @@ package repositories
 import (
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
-	"errors"
 
 	"gorm.io/gorm"
 )
@@ -28,9 +27,14 @@ func (r *gormBookingRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Booking{}, id).Error
 }
 
+// preloadBookingExtras memuat layanan tambahan booking sesuai urutan ditambahkan
+func preloadBookingExtras(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
 func (r *gormBookingRepository) FindByID(id uint) (*models.Booking, error) {
 	var booking models.Booking
-	if err := r.db.First(&booking, id).Error; err != nil {
+	if err := r.db.Preload("Extras", preloadBookingExtras).First(&booking, id).Error; err != nil {
 		return nil, err
 	}
 	return &booking, nil
@@ -38,7 +42,7 @@ func (r *gormBookingRepository) FindByID(id uint) (*models.Booking, error) {
 
 func (r *gormBookingRepository) FindByUserID(userID uint, pagination *models.Pagination) ([]models.Booking, error) {
 	var bookings []models.Booking
-	query := r.db.Where("user_id = ?", userID).Order(pagination.Sort)
+	query := r.db.Preload("Extras", preloadBookingExtras).Where("user_id = ?", userID).Order(pagination.Sort)
 
 	if pagination.Limit > 0 {
 		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
@@ -51,9 +55,21 @@ func (r *gormBookingRepository) FindByUserID(userID uint, pagination *models.Pag
 	return bookings, nil
 }
 
-func (r *gormBookingRepository) FindAll(pagination *models.Pagination) ([]models.Booking, error) {
+func (r *gormBookingRepository) FindAll(filter *models.BookingFilter, pagination *models.Pagination) ([]models.Booking, error) {
 	var bookings []models.Booking
-	query := r.db.Order(pagination.Sort)
+	query := r.db.Preload("Extras", preloadBookingExtras).Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.PropertyID != 0 {
+			query = query.Where("property_id = ?", filter.PropertyID)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("property_id IN ?", filter.PropertyIDs)
+		}
+		if filter.GuestID != 0 {
+			query = query.Where("guest_id = ?", filter.GuestID)
+		}
+	}
 
 	if pagination.Limit > 0 {
 		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
@@ -72,20 +88,23 @@ func (r *gormBookingRepository) UpdateStatus(id uint, newStatus string) error {
 		return result.Error
 	}
 	if result.RowsAffected == 0 {
-		return errors.New("booking tidak ditemukan atau status tidak berubah")
+		return models.ErrBookingNotFound
 	}
 	return nil
 }
 
-func (r *gormBookingRepository) CheckOverlap(roomID uint, checkInDate, checkOutDate string) (bool, error) {
+func (r *gormBookingRepository) CheckOverlap(roomID uint, checkInDate, checkOutDate string, excludeBookingID uint) (bool, error) {
 	// Cek overlap tapi hanya untuk booking yang statusnya confirmed/paid dan bukan cancelled
 	var count int64
 
-	err := r.db.Model(&models.Booking{}).
+	query := r.db.Model(&models.Booking{}).
 		Where("room_id = ?", roomID).
 		Where("booking_status NOT IN (?)", []string{models.StatusCancelled}).
-		Where("check_out_date > ? AND check_in_date < ?", checkInDate, checkOutDate).
-		Count(&count).Error
+		Where("check_out_date > ? AND check_in_date < ?", checkInDate, checkOutDate)
+	if excludeBookingID != 0 {
+		query = query.Where("id <> ?", excludeBookingID)
+	}
+	err := query.Count(&count).Error
 
 	if err != nil {
 		return false, err
@@ -93,3 +112,69 @@ func (r *gormBookingRepository) CheckOverlap(roomID uint, checkInDate, checkOutD
 
 	return count > 0, nil
 }
+
+func (r *gormBookingRepository) FindInHouse(date string) ([]models.Booking, error) {
+	var bookings []models.Booking
+	err := r.db.
+		Where("booking_status = ?", models.StatusConfirmed).
+		Where("checked_in_at IS NOT NULL").
+		Where("check_in_date < ? AND check_out_date > ?", date, date).
+		Find(&bookings).Error
+	return bookings, err
+}
+
+func (r *gormBookingRepository) SaveModification(booking *models.Booking, modification *models.BookingModification, payments []*models.Payment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, payment := range payments {
+			isNew := payment.ID == 0
+			if err := tx.Save(payment).Error; err != nil {
+				return err
+			}
+			if isNew && modification.PaymentID == nil {
+				modification.PaymentID = &payment.ID
+				modification.Payment = payment
+			}
+		}
+		if err := saveBookingWithExtras(tx, booking); err != nil {
+			return err
+		}
+		return tx.Omit("Payment").Create(modification).Error
+	})
+}
+
+// saveBookingWithExtras menyimpan booking lalu setiap layanan tambahannya
+// (baru dibuat, yang lama diperbarui totalnya)
+func saveBookingWithExtras(tx *gorm.DB, booking *models.Booking) error {
+	if err := tx.Omit("Review", "Extras").Save(booking).Error; err != nil {
+		return err
+	}
+	for i := range booking.Extras {
+		booking.Extras[i].BookingID = booking.ID
+		if err := tx.Save(&booking.Extras[i]).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *gormBookingRepository) SaveExtras(booking *models.Booking, removedIDs []uint, payments []*models.Payment) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if len(removedIDs) > 0 {
+			if err := tx.Where("booking_id = ?", booking.ID).Delete(&models.BookingExtra{}, removedIDs).Error; err != nil {
+				return err
+			}
+		}
+		for _, payment := range payments {
+			if err := tx.Save(payment).Error; err != nil {
+				return err
+			}
+		}
+		return saveBookingWithExtras(tx, booking)
+	})
+}
+
+func (r *gormBookingRepository) FindModifications(bookingID uint) ([]models.BookingModification, error) {
+	var modifications []models.BookingModification
+	err := r.db.Preload("Payment").Where("booking_id = ?", bookingID).Order("id desc").Find(&modifications).Error
+	return modifications, err
+}
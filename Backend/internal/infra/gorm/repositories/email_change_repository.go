@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormEmailChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewGormEmailChangeRepository(db *gorm.DB) repositories.EmailChangeRepository {
+	return &gormEmailChangeRepository{db: db}
+}
+
+func (r *gormEmailChangeRepository) Replace(request *models.EmailChangeRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Permintaan lama milik user & permintaan yang sudah kedaluwarsa dibersihkan
+		if err := tx.Where("user_id = ? OR expires_at < ?", request.UserID, time.Now()).
+			Delete(&models.EmailChangeRequest{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(request).Error
+	})
+}
+
+func (r *gormEmailChangeRepository) FindByTokenHash(tokenHash string) (*models.EmailChangeRequest, error) {
+	var request models.EmailChangeRequest
+	err := r.db.Where("token_hash = ? AND expires_at > ?", tokenHash, time.Now()).First(&request).Error
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *gormEmailChangeRepository) Complete(request *models.EmailChangeRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.User{}).Where("id = ?", request.UserID).
+			Update("email", request.NewEmail).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.EmailChangeRequest{}, request.ID).Error
+	})
+}
@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormExtraRepository struct {
+	db *gorm.DB
+}
+
+func NewGormExtraRepository(db *gorm.DB) repositories.ExtraRepository {
+	return &gormExtraRepository{db: db}
+}
+
+func (r *gormExtraRepository) Create(extra *models.Extra) error {
+	return r.db.Create(extra).Error
+}
+
+func (r *gormExtraRepository) Update(extra *models.Extra) error {
+	return r.db.Save(extra).Error
+}
+
+// Delete memakai soft delete; layanan tambahan di booking lama menyimpan salinan nama & harga
+func (r *gormExtraRepository) Delete(id uint) error {
+	return r.db.Delete(&models.Extra{}, id).Error
+}
+
+func (r *gormExtraRepository) FindByID(id uint) (*models.Extra, error) {
+	var extra models.Extra
+	if err := r.db.First(&extra, id).Error; err != nil {
+		return nil, err
+	}
+	return &extra, nil
+}
+
+func (r *gormExtraRepository) FindAll(filter *models.ExtraFilter) ([]models.Extra, error) {
+	var extras []models.Extra
+	query := r.db.Order("property_id, name")
+
+	if filter != nil {
+		if filter.PropertyID != 0 {
+			query = query.Where("property_id = ?", filter.PropertyID)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("property_id IN ?", filter.PropertyIDs)
+		}
+		if filter.ActiveOnly {
+			query = query.Where("is_active = ?", true)
+		}
+	}
+
+	if err := query.Find(&extras).Error; err != nil {
+		return nil, err
+	}
+	return extras, nil
+}
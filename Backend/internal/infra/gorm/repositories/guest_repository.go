@@ -0,0 +1,195 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"backend/pkg/fieldcrypt"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormGuestRepository struct {
+	db      *gorm.DB
+	keyring *fieldcrypt.Keyring
+}
+
+func NewGormGuestRepository(db *gorm.DB, keyring *fieldcrypt.Keyring) repositories.GuestRepository {
+	return &gormGuestRepository{db: db, keyring: keyring}
+}
+
+// index menghitung blind index karena telepon & nomor identitas tersimpan terenkripsi
+func (r *gormGuestRepository) index(guest *models.Guest) {
+	indexGuest(r.keyring, guest)
+}
+
+// indexGuest dipakai juga oleh repository lain yang menyimpan profil tamu dalam transaksinya sendiri
+func indexGuest(keyring *fieldcrypt.Keyring, guest *models.Guest) {
+	guest.PhoneHash = keyring.BlindIndex(string(guest.Phone))
+	guest.IDNumberHash = keyring.BlindIndex(string(guest.IDNumber))
+}
+
+func (r *gormGuestRepository) Create(guest *models.Guest) error {
+	r.index(guest)
+	return r.db.Create(guest).Error
+}
+
+func (r *gormGuestRepository) Update(guest *models.Guest) error {
+	r.index(guest)
+	return r.db.Save(guest).Error
+}
+
+func (r *gormGuestRepository) FindByID(id uint) (*models.Guest, error) {
+	var guest models.Guest
+	if err := r.db.First(&guest, id).Error; err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *gormGuestRepository) FindByIDs(ids []uint) ([]models.Guest, error) {
+	var guests []models.Guest
+	if len(ids) == 0 {
+		return guests, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&guests).Error; err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+func (r *gormGuestRepository) FindAll(filter *models.GuestFilter, pagination *models.Pagination) ([]models.Guest, error) {
+	var guests []models.Guest
+	query := r.db.Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.Search != "" {
+			// Telepon & nomor identitas terenkripsi: hanya bisa dicari dengan nilai lengkap (blind index)
+			like := "%" + filter.Search + "%"
+			hash := r.keyring.BlindIndex(filter.Search)
+			query = query.Where("full_name LIKE ? OR email LIKE ? OR phone_hash = ? OR id_number_hash = ?", like, like, hash, hash)
+		}
+		if filter.VIPOnly {
+			query = query.Where("is_vip = ?", true)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("EXISTS (SELECT 1 FROM bookings WHERE bookings.guest_id = guests.id AND bookings.deleted_at IS NULL AND bookings.property_id IN ?)", filter.PropertyIDs)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&guests).Error; err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+func (r *gormGuestRepository) FindMatch(email, idNumber string) (*models.Guest, error) {
+	var guest models.Guest
+	if email != "" {
+		err := r.db.Where("email = ?", email).Order("id").First(&guest).Error
+		if err == nil {
+			return &guest, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	if idNumber == "" {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if err := r.db.Where("id_number_hash = ?", r.keyring.BlindIndex(idNumber)).Order("id").First(&guest).Error; err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+// guestBookings memfilter booking milik tamu (opsional dibatasi properti)
+func (r *gormGuestRepository) guestBookings(guestID uint, propertyIDs []uint) *gorm.DB {
+	query := r.db.Model(&models.Booking{}).Where("bookings.guest_id = ?", guestID)
+	if len(propertyIDs) > 0 {
+		query = query.Where("bookings.property_id IN ?", propertyIDs)
+	}
+	return query
+}
+
+func (r *gormGuestRepository) Stats(guestID uint, propertyIDs []uint) (*models.GuestStats, error) {
+	var row struct {
+		TotalBookings     int64
+		CancelledBookings int64
+		Stays             int64
+		Nights            int64
+		FirstStay         *time.Time
+		LastStay          *time.Time
+	}
+	err := r.guestBookings(guestID, propertyIDs).
+		Select(`COUNT(bookings.id) AS total_bookings,
+			COALESCE(SUM(bookings.booking_status = ?), 0) AS cancelled_bookings,
+			COALESCE(SUM(bookings.booking_status = ?), 0) AS stays,
+			COALESCE(SUM(CASE WHEN bookings.booking_status = ? THEN DATEDIFF(bookings.check_out_date, bookings.check_in_date) ELSE 0 END), 0) AS nights,
+			MIN(CASE WHEN bookings.booking_status = ? THEN bookings.check_in_date END) AS first_stay,
+			MAX(CASE WHEN bookings.booking_status = ? THEN bookings.check_out_date END) AS last_stay`,
+			models.StatusCancelled, models.StatusCompleted, models.StatusCompleted, models.StatusCompleted, models.StatusCompleted).
+		Scan(&row).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Total belanja dikelompokkan per mata uang properti, seperti laporan properti
+	spend := []models.GuestSpend{}
+	err = r.guestBookings(guestID, propertyIDs).
+		Select("properties.currency AS currency, SUM(bookings.total_price) AS amount").
+		Joins("JOIN properties ON properties.id = bookings.property_id").
+		Where("bookings.payment_status = ?", models.StatusPaid).
+		Group("properties.currency").
+		Order("properties.currency").
+		Scan(&spend).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.GuestStats{
+		TotalBookings:     row.TotalBookings,
+		CancelledBookings: row.CancelledBookings,
+		Stays:             row.Stays,
+		Nights:            row.Nights,
+		FirstStay:         row.FirstStay,
+		LastStay:          row.LastStay,
+		Spend:             spend,
+	}, nil
+}
+
+func (r *gormGuestRepository) HasBookingIn(guestID uint, propertyIDs []uint) (bool, error) {
+	var count int64
+	if err := r.guestBookings(guestID, propertyIDs).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *gormGuestRepository) Merge(target *models.Guest, sourceIDs []uint) error {
+	r.index(target)
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(target).Error; err != nil {
+			return err
+		}
+		// Booking yang sudah dihapus (soft delete) ikut dipindahkan agar riwayat tetap utuh
+		if err := tx.Unscoped().Model(&models.Booking{}).Where("guest_id IN ?", sourceIDs).
+			Update("guest_id", target.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&models.BookingGroup{}).Where("lead_guest_id IN ?", sourceIDs).
+			Update("lead_guest_id", target.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Guest{}).Where("id IN ?", sourceIDs).
+			Update("merged_into_id", target.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.Guest{}, sourceIDs).Error
+	})
+}
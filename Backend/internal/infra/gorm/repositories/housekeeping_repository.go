@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormHousekeepingRepository struct {
+	db *gorm.DB
+}
+
+func NewGormHousekeepingRepository(db *gorm.DB) repositories.HousekeepingRepository {
+	return &gormHousekeepingRepository{db: db}
+}
+
+// selectAssignee hanya memuat kolom publik staf (hash password tidak ikut terkirim)
+func selectAssignee(db *gorm.DB) *gorm.DB {
+	return db.Select("id, username, full_name, role")
+}
+
+func (r *gormHousekeepingRepository) CreateTasks(tasks []models.HousekeepingTask) (int, error) {
+	created := 0
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range tasks {
+			// Tugas yang sudah ada (kamar+tanggal+jenis) dilewati tanpa error
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&tasks[i])
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				continue
+			}
+			created++
+
+			if err := tx.Model(&models.Room{}).Where("id = ?", tasks[i].RoomID).
+				Update("housekeeping_status", models.HousekeepingDirty).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return created, err
+}
+
+func (r *gormHousekeepingRepository) Update(task *models.HousekeepingTask, roomStatus string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(task).Error; err != nil {
+			return err
+		}
+		if roomStatus == "" {
+			return nil
+		}
+		return tx.Model(&models.Room{}).Where("id = ?", task.RoomID).
+			Update("housekeeping_status", roomStatus).Error
+	})
+}
+
+func (r *gormHousekeepingRepository) FindByID(id uint) (*models.HousekeepingTask, error) {
+	var task models.HousekeepingTask
+	if err := r.db.Preload("Room").Preload("AssignedTo", selectAssignee).First(&task, id).Error; err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *gormHousekeepingRepository) FindAll(filter *models.HousekeepingTaskFilter, pagination *models.Pagination) ([]models.HousekeepingTask, error) {
+	var tasks []models.HousekeepingTask
+	query := r.db.Preload("Room").Preload("AssignedTo", selectAssignee).Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.PropertyID != 0 {
+			query = query.Where("property_id = ?", filter.PropertyID)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("property_id IN ?", filter.PropertyIDs)
+		}
+		if filter.TaskDate != "" {
+			query = query.Where("task_date = ?", filter.TaskDate)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if filter.AssignedToID != 0 {
+			query = query.Where("assigned_to_id = ?", filter.AssignedToID)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
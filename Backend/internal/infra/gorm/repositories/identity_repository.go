@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewGormIdentityRepository(db *gorm.DB) repositories.IdentityRepository {
+	return &gormIdentityRepository{db: db}
+}
+
+func (r *gormIdentityRepository) FindByProviderSubject(provider, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	if err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error; err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *gormIdentityRepository) FindByID(id uint) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	if err := r.db.First(&identity, id).Error; err != nil {
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *gormIdentityRepository) FindByUserID(userID uint) ([]models.UserIdentity, error) {
+	var identities []models.UserIdentity
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&identities).Error; err != nil {
+		return nil, err
+	}
+	return identities, nil
+}
+
+func (r *gormIdentityRepository) Create(identity *models.UserIdentity) error {
+	return r.db.Create(identity).Error
+}
+
+func (r *gormIdentityRepository) Update(identity *models.UserIdentity) error {
+	return r.db.Save(identity).Error
+}
+
+func (r *gormIdentityRepository) Delete(id uint) error {
+	return r.db.Delete(&models.UserIdentity{}, id).Error
+}
+
+func (r *gormIdentityRepository) CreateUserWithIdentity(user *models.User, identity *models.UserIdentity) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+		identity.UserID = user.ID
+		return tx.Create(identity).Error
+	})
+}
+
+func (r *gormIdentityRepository) CreateState(state *models.OIDCLoginState) error {
+	// Bersihkan state yang ditinggalkan (user tidak kembali dari halaman provider)
+	if err := r.db.Where("expires_at < ?", time.Now()).Delete(&models.OIDCLoginState{}).Error; err != nil {
+		return err
+	}
+	return r.db.Create(state).Error
+}
+
+func (r *gormIdentityRepository) ConsumeState(state string) (*models.OIDCLoginState, error) {
+	var loginState models.OIDCLoginState
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("state = ? AND expires_at > ?", state, time.Now()).First(&loginState).Error; err != nil {
+			return err
+		}
+		// Hapus bersyarat: jika dua callback paralel memakai state yang sama, hanya satu yang lolos
+		result := tx.Where("state = ?", state).Delete(&models.OIDCLoginState{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &loginState, nil
+}
@@ -25,10 +25,16 @@ func (r *PaymentRepositoryImpl) GetByID(id uint) (*models.Payment, error) {
 
 func (r *PaymentRepositoryImpl) GetByBookingID(bookingID uint) (*models.Payment, error) {
 	var payment models.Payment
-	err := r.db.Where("booking_id = ?", bookingID).First(&payment).Error
+	err := r.db.Where("booking_id = ? AND type = ?", bookingID, models.PaymentTypePayment).First(&payment).Error
 	return &payment, err
 }
 
+func (r *PaymentRepositoryImpl) FindByBookingID(bookingID uint) ([]models.Payment, error) {
+	var payments []models.Payment
+	err := r.db.Where("booking_id = ?", bookingID).Order("id").Find(&payments).Error
+	return payments, err
+}
+
 func (r *PaymentRepositoryImpl) Update(payment *models.Payment) error {
 	return r.db.Save(payment).Error
 }
@@ -0,0 +1,268 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type gormPrivacyRepository struct {
+	db *gorm.DB
+}
+
+func NewGormPrivacyRepository(db *gorm.DB) repositories.PrivacyRepository {
+	return &gormPrivacyRepository{db: db}
+}
+
+func (r *gormPrivacyRepository) CreateRequest(request *models.PrivacyRequest) error {
+	return r.db.Create(request).Error
+}
+
+func (r *gormPrivacyRepository) UpdateRequest(request *models.PrivacyRequest) error {
+	return r.db.Omit("User").Save(request).Error
+}
+
+func (r *gormPrivacyRepository) FindRequestByID(id uint) (*models.PrivacyRequest, error) {
+	var request models.PrivacyRequest
+	if err := r.db.Preload("User", selectAssignee).First(&request, id).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *gormPrivacyRepository) FindRequests(filter *models.PrivacyRequestFilter, pagination *models.Pagination) ([]models.PrivacyRequest, error) {
+	var requests []models.PrivacyRequest
+	query := r.db.Preload("User", selectAssignee).Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.Type != "" {
+			query = query.Where("type = ?", filter.Type)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if filter.UserID != 0 {
+			query = query.Where("user_id = ?", filter.UserID)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&requests).Error; err != nil {
+		return nil, err
+	}
+	return requests, nil
+}
+
+func (r *gormPrivacyRepository) CollectUserData(userID uint) (*models.PersonalDataExport, error) {
+	var user models.User
+	if err := r.db.First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+
+	var bookings []models.Booking
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&bookings).Error; err != nil {
+		return nil, err
+	}
+
+	bookingIDs := make([]uint, 0, len(bookings))
+	exported := make([]models.ExportedBooking, 0, len(bookings))
+	for _, booking := range bookings {
+		bookingIDs = append(bookingIDs, booking.ID)
+		exported = append(exported, models.ExportedBooking{
+			Booking:       booking,
+			GuestPhone:    string(booking.GuestPhone),
+			GuestIDNumber: string(booking.GuestIDNumber),
+		})
+	}
+
+	var groups []models.BookingGroup
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	groupIDs := make([]uint, 0, len(groups))
+	exportedGroups := make([]models.ExportedBookingGroup, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+		exportedGroups = append(exportedGroups, models.ExportedBookingGroup{
+			BookingGroup:   group,
+			LeadGuestPhone: string(group.LeadGuestPhone),
+		})
+	}
+
+	payments := []models.Payment{}
+	if len(bookingIDs) > 0 || len(groupIDs) > 0 {
+		if err := r.db.Where("booking_id IN ? OR group_id IN ?", bookingIDs, groupIDs).Order("id").Find(&payments).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	reviews := []models.Review{}
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+
+	var savedGuests []models.SavedGuest
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&savedGuests).Error; err != nil {
+		return nil, err
+	}
+	exportedGuests := make([]models.ExportedSavedGuest, 0, len(savedGuests))
+	for _, guest := range savedGuests {
+		exportedGuests = append(exportedGuests, models.ExportedSavedGuest{
+			SavedGuest: guest,
+			Phone:      string(guest.Phone),
+			IDNumber:   string(guest.IDNumber),
+		})
+	}
+
+	return &models.PersonalDataExport{
+		Profile: models.ExportedProfile{
+			ID:        user.ID,
+			Username:  user.Username,
+			Email:     user.Email,
+			FullName:  user.FullName,
+			Role:      user.Role,
+			Language:  user.Language,
+			CreatedAt: user.CreatedAt,
+		},
+		Bookings:      exported,
+		BookingGroups: exportedGroups,
+		Payments:      payments,
+		Reviews:       reviews,
+		SavedGuests:   exportedGuests,
+	}, nil
+}
+
+func (r *gormPrivacyRepository) CountActiveBookings(userID uint, date string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Booking{}).
+		Where("user_id = ?", userID).
+		Where("booking_status = ?", models.StatusConfirmed).
+		Where("check_out_date >= ?", date).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *gormPrivacyRepository) CompleteErasure(request *models.PrivacyRequest) error {
+	userID := request.UserID
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Profil tamu yang ditautkan dari booking user (termasuk booking yang sudah dihapus)
+		var guestIDs []uint
+		if err := tx.Unscoped().Model(&models.Booking{}).
+			Where("user_id = ? AND guest_id IS NOT NULL", userID).
+			Distinct().Pluck("guest_id", &guestIDs).Error; err != nil {
+			return err
+		}
+		var leadGuestIDs []uint
+		if err := tx.Unscoped().Model(&models.BookingGroup{}).
+			Where("user_id = ? AND lead_guest_id IS NOT NULL", userID).
+			Distinct().Pluck("lead_guest_id", &leadGuestIDs).Error; err != nil {
+			return err
+		}
+		guestIDs = append(guestIDs, leadGuestIDs...)
+
+		// Booking: data tamu dikosongkan, nominal, tanggal, dan status tetap untuk laporan keuangan
+		if err := tx.Unscoped().Model(&models.Booking{}).Where("user_id = ?", userID).
+			UpdateColumns(map[string]interface{}{
+				"guest_name":       models.AnonymizedName,
+				"guest_email":      "",
+				"guest_phone":      "",
+				"guest_id_number":  "",
+				"special_requests": "",
+				"guest_id":         nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		// Reservasi grup: data tamu utama dikosongkan, kamar & nominal tetap tersimpan di booking
+		if err := tx.Unscoped().Model(&models.BookingGroup{}).Where("user_id = ?", userID).
+			UpdateColumns(map[string]interface{}{
+				"name":             "",
+				"lead_guest_name":  models.AnonymizedName,
+				"lead_guest_email": "",
+				"lead_guest_phone": "",
+				"special_requests": "",
+				"lead_guest_id":    nil,
+			}).Error; err != nil {
+			return err
+		}
+
+		// Alasan perubahan booking berupa teks bebas dari member
+		if err := tx.Model(&models.BookingModification{}).
+			Where("booking_id IN (?)", tx.Unscoped().Model(&models.Booking{}).Select("id").Where("user_id = ?", userID)).
+			Update("reason", "").Error; err != nil {
+			return err
+		}
+
+		// Profil tamu yang tidak lagi dipakai booking lain ikut dianonimkan lalu dihapus
+		for _, guestID := range guestIDs {
+			var remaining int64
+			if err := tx.Unscoped().Model(&models.Booking{}).Where("guest_id = ?", guestID).
+				Count(&remaining).Error; err != nil {
+				return err
+			}
+			if remaining > 0 {
+				continue
+			}
+			if err := tx.Unscoped().Model(&models.BookingGroup{}).Where("lead_guest_id = ?", guestID).
+				Count(&remaining).Error; err != nil {
+				return err
+			}
+			if remaining > 0 {
+				continue
+			}
+			if err := tx.Model(&models.Guest{}).Where("id = ?", guestID).
+				UpdateColumns(map[string]interface{}{
+					"full_name":      models.AnonymizedName,
+					"email":          "",
+					"phone":          "",
+					"phone_hash":     "",
+					"id_number":      "",
+					"id_number_hash": "",
+					"preferences":    "",
+					"notes":          "",
+				}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&models.Guest{}, guestID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Identitas login eksternal (OIDC) memuat subject & email provider
+		if err := tx.Where("user_id = ?", userID).Delete(&models.UserIdentity{}).Error; err != nil {
+			return err
+		}
+
+		// Data tamu tersimpan, daftar tunggu & permintaan ganti email dihapus permanen
+		if err := tx.Where("user_id = ?", userID).Delete(&models.SavedGuest{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&models.WaitlistEntry{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", userID).Delete(&models.EmailChangeRequest{}).Error; err != nil {
+			return err
+		}
+
+		// User: identitas diganti nilai unik tanpa makna, password dikosongkan (tidak bisa login), lalu dihapus
+		if err := tx.Model(&models.User{}).Where("id = ?", userID).
+			UpdateColumns(map[string]interface{}{
+				"username":  fmt.Sprintf("deleted_%d", userID),
+				"email":     fmt.Sprintf("deleted_%d@anonymized.invalid", userID),
+				"full_name": models.AnonymizedName,
+				"password":  "",
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&models.User{}, userID).Error; err != nil {
+			return err
+		}
+
+		return tx.Omit("User").Save(request).Error
+	})
+}
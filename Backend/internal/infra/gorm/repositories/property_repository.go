@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormPropertyRepository struct {
+	db *gorm.DB
+}
+
+func NewGormPropertyRepository(db *gorm.DB) repositories.PropertyRepository {
+	return &gormPropertyRepository{db: db}
+}
+
+func (r *gormPropertyRepository) Create(property *models.Property) error {
+	return r.db.Create(property).Error
+}
+
+func (r *gormPropertyRepository) Update(property *models.Property) error {
+	return r.db.Save(property).Error
+}
+
+func (r *gormPropertyRepository) Delete(id uint) error {
+	return r.db.Delete(&models.Property{}, id).Error
+}
+
+func (r *gormPropertyRepository) FindByID(id uint) (*models.Property, error) {
+	var property models.Property
+	if err := r.db.First(&property, id).Error; err != nil {
+		return nil, err
+	}
+	return &property, nil
+}
+
+func (r *gormPropertyRepository) FindByIDs(ids []uint) ([]models.Property, error) {
+	var properties []models.Property
+	if len(ids) == 0 {
+		return properties, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&properties).Error; err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+func (r *gormPropertyRepository) FindAll(ids []uint) ([]models.Property, error) {
+	var properties []models.Property
+	query := r.db.Order("name")
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	if err := query.Find(&properties).Error; err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+func (r *gormPropertyRepository) FindDefault() (*models.Property, error) {
+	var property models.Property
+	if err := r.db.Order("id").First(&property).Error; err != nil {
+		return nil, err
+	}
+	return &property, nil
+}
+
+func (r *gormPropertyRepository) CountRooms(propertyID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Room{}).Where("property_id = ?", propertyID).Count(&count).Error
+	return count, err
+}
+
+func (r *gormPropertyRepository) FindIDsByUserID(userID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Table("user_properties").
+		Joins("JOIN properties ON properties.id = user_properties.property_id AND properties.deleted_at IS NULL").
+		Where("user_properties.user_id = ?", userID).
+		Pluck("user_properties.property_id", &ids).Error
+	return ids, err
+}
+
+func (r *gormPropertyRepository) ReplaceUserProperties(userID uint, properties []models.Property) error {
+	user := models.User{Model: gorm.Model{ID: userID}}
+	return r.db.Model(&user).Association("Properties").Replace(properties)
+}
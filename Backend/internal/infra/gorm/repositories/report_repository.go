@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormReportRepository struct {
+	db *gorm.DB
+}
+
+func NewGormReportRepository(db *gorm.DB) repositories.ReportRepository {
+	return &gormReportRepository{db: db}
+}
+
+// SummaryByProperty menghitung booking, malam terjual, dan pendapatan (termasuk layanan tambahan) per properti.
+// Dikelompokkan per properti karena setiap properti bisa memakai mata uang berbeda.
+func (r *gormReportRepository) SummaryByProperty(filter *models.ReportFilter) ([]models.PropertyReport, error) {
+	var reports []models.PropertyReport
+
+	query := r.db.Table("properties").
+		Select(`properties.id AS property_id,
+			properties.name AS property_name,
+			properties.currency AS currency,
+			COUNT(bookings.id) AS bookings,
+			COALESCE(SUM(bookings.booking_status = ?), 0) AS cancelled_bookings,
+			COALESCE(SUM(CASE WHEN bookings.booking_status <> ? THEN DATEDIFF(bookings.check_out_date, bookings.check_in_date) ELSE 0 END), 0) AS room_nights,
+			COALESCE(SUM(CASE WHEN bookings.payment_status = ? THEN bookings.total_price ELSE 0 END), 0) AS revenue,
+			COALESCE(SUM(CASE WHEN bookings.payment_status = ? THEN bookings.extras_charge ELSE 0 END), 0) AS extras_revenue`,
+			models.StatusCancelled, models.StatusCancelled, models.StatusPaid, models.StatusPaid).
+		Joins(`LEFT JOIN bookings ON bookings.property_id = properties.id
+			AND bookings.deleted_at IS NULL
+			AND bookings.check_in_date >= ? AND bookings.check_in_date < ?`,
+			filter.From.Format("2006-01-02"), filter.To.Format("2006-01-02")).
+		Where("properties.deleted_at IS NULL").
+		Group("properties.id, properties.name, properties.currency").
+		Order("properties.name")
+
+	if filter.PropertyID != 0 {
+		query = query.Where("properties.id = ?", filter.PropertyID)
+	}
+	if len(filter.PropertyIDs) > 0 {
+		query = query.Where("properties.id IN ?", filter.PropertyIDs)
+	}
+
+	if err := query.Scan(&reports).Error; err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
@@ -14,12 +14,44 @@ func NewGormRoomImageRepository(db *gorm.DB) repositories.RoomImageRepository {
 	return &gormRoomImageRepository{db: db}
 }
 
+// clearPrimary melepas status primary gambar lain di kamar yang sama.
+// Wajib dipanggil dalam transaksi sebelum menyimpan gambar primary baru (unique index per kamar).
+func clearPrimary(tx *gorm.DB, roomID, exceptID uint) error {
+	return tx.Model(&models.RoomImage{}).
+		Where("room_id = ? AND is_primary = ? AND id <> ?", roomID, true, exceptID).
+		Update("is_primary", false).Error
+}
+
 func (r *gormRoomImageRepository) Create(image *models.RoomImage) error {
-	return r.db.Create(image).Error
+	return r.CreateMany([]*models.RoomImage{image})
+}
+
+// CreateMany menyimpan beberapa gambar sekaligus (semua atau tidak sama sekali)
+func (r *gormRoomImageRepository) CreateMany(images []*models.RoomImage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, image := range images {
+			if image.IsPrimary {
+				if err := clearPrimary(tx, image.RoomID, 0); err != nil {
+					return err
+				}
+			}
+			if err := tx.Create(image).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (r *gormRoomImageRepository) Update(image *models.RoomImage) error {
-	return r.db.Save(image).Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if image.IsPrimary {
+			if err := clearPrimary(tx, image.RoomID, image.ID); err != nil {
+				return err
+			}
+		}
+		return tx.Save(image).Error
+	})
 }
 
 func (r *gormRoomImageRepository) Delete(id uint) error {
@@ -36,7 +68,7 @@ func (r *gormRoomImageRepository) FindByID(id uint) (*models.RoomImage, error) {
 
 func (r *gormRoomImageRepository) FindByRoomID(roomID uint) ([]models.RoomImage, error) {
 	var images []models.RoomImage
-	if err := r.db.Where("room_id = ?", roomID).Find(&images).Error; err != nil {
+	if err := r.db.Where("room_id = ?", roomID).Order("sort_order, id").Find(&images).Error; err != nil {
 		return nil, err
 	}
 	return images, nil
@@ -45,4 +77,42 @@ func (r *gormRoomImageRepository) FindByRoomID(roomID uint) ([]models.RoomImage,
 func (r *gormRoomImageRepository) DeleteByRoomID(roomID uint) error {
 	// Hapus secara permanen semua RoomImage yang terasosiasi dengan RoomID
 	return r.db.Unscoped().Where("room_id = ?", roomID).Delete(&models.RoomImage{}).Error
-}
\ No newline at end of file
+}
+
+// Reorder menyimpan urutan baru sesuai posisi di imageIDs dan (opsional) mengganti
+// gambar primary, semuanya dalam satu transaksi
+func (r *gormRoomImageRepository) Reorder(roomID uint, imageIDs []uint, primaryImageID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range imageIDs {
+			if err := tx.Model(&models.RoomImage{}).
+				Where("id = ? AND room_id = ?", id, roomID).
+				Update("sort_order", i+1).Error; err != nil {
+				return err
+			}
+		}
+
+		if primaryImageID == 0 {
+			return nil
+		}
+		if err := clearPrimary(tx, roomID, primaryImageID); err != nil {
+			return err
+		}
+		result := tx.Model(&models.RoomImage{}).
+			Where("id = ? AND room_id = ?", primaryImageID, roomID).
+			Update("is_primary", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// Bisa juga karena sudah primary; pastikan gambar memang ada di kamar ini
+			var count int64
+			if err := tx.Model(&models.RoomImage{}).Where("id = ? AND room_id = ?", primaryImageID, roomID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count == 0 {
+				return gorm.ErrRecordNotFound
+			}
+		}
+		return nil
+	})
+}
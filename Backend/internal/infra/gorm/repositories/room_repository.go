@@ -3,6 +3,8 @@ package repositories
 import (
 	"backend/internal/domain/models"
 	"backend/internal/domain/repositories"
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -14,6 +16,11 @@ func NewGormRoomRepository(db *gorm.DB) repositories.RoomRepository {
 	return &gormRoomRepository{db: db}
 }
 
+// orderImages mengurutkan gambar kamar sesuai urutan galeri
+func orderImages(db *gorm.DB) *gorm.DB {
+	return db.Order("sort_order, id")
+}
+
 func (r *gormRoomRepository) Create(room *models.Room) error {
 	return r.db.Create(room).Error
 }
@@ -26,26 +33,65 @@ func (r *gormRoomRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Room{}, id).Error
 }
 
+// applyFilter menambahkan kondisi RoomFilter ke query kamar
+func applyFilter(query *gorm.DB, filter *models.RoomFilter) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+	if filter.PropertyID != 0 {
+		query = query.Where("rooms.property_id = ?", filter.PropertyID)
+	}
+	if len(filter.PropertyIDs) > 0 {
+		query = query.Where("rooms.property_id IN ?", filter.PropertyIDs)
+	}
+	if filter.RoomID != 0 {
+		query = query.Where("rooms.id = ?", filter.RoomID)
+	}
+	if filter.Type != "" {
+		query = query.Where("rooms.type = ?", filter.Type)
+	}
+	if filter.ReadyOnly {
+		query = query.Where("rooms.housekeeping_status IN ?", []string{models.HousekeepingClean, models.HousekeepingInspected})
+	}
+	if guests := filter.Adults + filter.Children; guests > 0 {
+		// Susunan tamu harus muat: kapasitas termasuk extra bed, dan batas dewasa (0 = MaxOccupancy)
+		query = query.Where("rooms.max_occupancy + rooms.max_extra_beds >= ?", guests).
+			Where("(CASE WHEN rooms.max_adults > 0 THEN rooms.max_adults ELSE rooms.max_occupancy END) >= ?", filter.Adults)
+	}
+	if len(filter.AmenityIDs) > 0 {
+		// Kamar harus punya SEMUA amenity yang diminta
+		withAllAmenities := query.Session(&gorm.Session{NewDB: true}).
+			Table("room_amenities").
+			Select("room_id").
+			Where("amenity_id IN ?", filter.AmenityIDs).
+			Group("room_id").
+			Having("COUNT(DISTINCT amenity_id) = ?", len(filter.AmenityIDs))
+		query = query.Where("rooms.id IN (?)", withAllAmenities)
+	}
+	return query
+}
+
 func (r *gormRoomRepository) FindByID(id uint) (*models.Room, error) {
 	var room models.Room
-	// Preload Images untuk Fitur Galeri Foto
-	if err := r.db.Preload("Images").First(&room, id).Error; err != nil {
+	// Preload Images untuk Fitur Galeri Foto (urut sesuai galeri)
+	if err := r.db.Preload("Images", orderImages).Preload("Amenities").First(&room, id).Error; err != nil {
 		return nil, err
 	}
 	return &room, nil
 }
 
-func (r *gormRoomRepository) FindAll(pagination *models.Pagination) ([]models.Room, error) {
+func (r *gormRoomRepository) FindAll(filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error) {
 	var rooms []models.Room
 	query := r.db.Limit(pagination.Limit).Offset(pagination.Offset).Order(pagination.Sort)
-	
-	if err := query.Preload("Images").Find(&rooms).Error; err != nil {
+	query = applyFilter(query, filter)
+
+	if err := query.Preload("Images", orderImages).Preload("Amenities").Find(&rooms).Error; err != nil {
 		return nil, err
 	}
 	return rooms, nil
 }
 
-func (r *gormRoomRepository) FindAvailable(checkInDate, checkOutDate string, pagination *models.Pagination) ([]models.Room, error) {
+func (r *gormRoomRepository) FindAvailable(checkInDate, checkOutDate string, filter *models.RoomFilter, pagination *models.Pagination) ([]models.Room, error) {
 	var availableRooms []models.Room
 
 	// Subquery untuk mencari Room ID yang sudah dibooking pada periode tertentu
@@ -54,14 +100,32 @@ func (r *gormRoomRepository) FindAvailable(checkInDate, checkOutDate string, pag
 		Where("check_out_date > ? AND check_in_date < ?", checkInDate, checkOutDate).
 		Where("booking_status IN (?)", []string{models.StatusConfirmed, models.StatusPaid}) 
 
+	// Kamar yang sedang ditahan untuk penawaran daftar tunggu juga tidak tersedia
+	heldRooms := r.db.Model(&models.WaitlistEntry{}).
+		Select("offered_room_id").
+		Where("status = ? AND offer_expires_at > ? AND offered_room_id IS NOT NULL", models.WaitlistOffered, time.Now()).
+		Where("check_out_date > ? AND check_in_date < ?", checkInDate, checkOutDate)
+
 	// Query utama: Kamar yang ID-nya TIDAK ADA di hasil sub-query, dan statusnya 'available'
 	query := r.db.Limit(pagination.Limit).Offset(pagination.Offset).Order(pagination.Sort)
-	
-	if err := query.Preload("Images").
+	query = applyFilter(query, filter)
+
+	if err := query.Preload("Images", orderImages).Preload("Amenities").
 		Where("id NOT IN (?)", subQuery).
+		Where("id NOT IN (?)", heldRooms).
 		Where("status = ?", "available").
 		Find(&availableRooms).Error; err != nil {
 		return nil, err
 	}
 	return availableRooms, nil
-}
\ No newline at end of file
+}
+
+// ReplaceAmenities mengganti seluruh daftar amenity kamar (baris lama di room_amenities dihapus)
+func (r *gormRoomRepository) ReplaceAmenities(roomID uint, amenities []models.Amenity) error {
+	room := models.Room{Model: gorm.Model{ID: roomID}}
+	return r.db.Model(&room).Association("Amenities").Replace(amenities)
+}
+
+func (r *gormRoomRepository) UpdateHousekeepingStatus(roomID uint, status string) error {
+	return r.db.Model(&models.Room{}).Where("id = ?", roomID).Update("housekeeping_status", status).Error
+}
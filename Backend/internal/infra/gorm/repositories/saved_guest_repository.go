@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+type gormSavedGuestRepository struct {
+	db *gorm.DB
+}
+
+func NewGormSavedGuestRepository(db *gorm.DB) repositories.SavedGuestRepository {
+	return &gormSavedGuestRepository{db: db}
+}
+
+func (r *gormSavedGuestRepository) FindByUserID(userID uint) ([]models.SavedGuest, error) {
+	guests := []models.SavedGuest{}
+	if err := r.db.Where("user_id = ?", userID).Order("is_default desc, full_name").Find(&guests).Error; err != nil {
+		return nil, err
+	}
+	return guests, nil
+}
+
+func (r *gormSavedGuestRepository) FindByID(id uint) (*models.SavedGuest, error) {
+	var guest models.SavedGuest
+	if err := r.db.First(&guest, id).Error; err != nil {
+		return nil, err
+	}
+	return &guest, nil
+}
+
+func (r *gormSavedGuestRepository) CountByUserID(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.SavedGuest{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+func (r *gormSavedGuestRepository) Save(guest *models.SavedGuest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(guest).Error; err != nil {
+			return err
+		}
+		if !guest.IsDefault {
+			return nil
+		}
+		return tx.Model(&models.SavedGuest{}).
+			Where("user_id = ? AND id <> ?", guest.UserID, guest.ID).
+			Update("is_default", false).Error
+	})
+}
+
+func (r *gormSavedGuestRepository) Delete(id uint) error {
+	return r.db.Delete(&models.SavedGuest{}, id).Error
+}
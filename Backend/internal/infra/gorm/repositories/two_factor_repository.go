@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type gormTwoFactorRepository struct {
+	db *gorm.DB
+}
+
+func NewGormTwoFactorRepository(db *gorm.DB) repositories.TwoFactorRepository {
+	return &gormTwoFactorRepository{db: db}
+}
+
+func (r *gormTwoFactorRepository) SaveSettings(user *models.User) error {
+	return r.db.Model(user).
+		Select("TwoFactorEnabled", "TwoFactorEnabledAt", "TwoFactorSecret", "TwoFactorLastStep").
+		Updates(user).Error
+}
+
+func (r *gormTwoFactorRepository) ClaimStep(userID uint, step int64) (bool, error) {
+	// Update bersyarat agar dua request paralel dengan kode yang sama tidak sama-sama lolos
+	result := r.db.Model(&models.User{}).
+		Where("id = ? AND two_factor_last_step < ?", userID, step).
+		UpdateColumn("two_factor_last_step", step)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *gormTwoFactorRepository) ReplaceRecoveryCodes(userID uint, codeHashes []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		codes := make([]models.RecoveryCode, 0, len(codeHashes))
+		for _, hash := range codeHashes {
+			codes = append(codes, models.RecoveryCode{UserID: userID, CodeHash: hash})
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+func (r *gormTwoFactorRepository) UseRecoveryCode(userID uint, codeHash string) (bool, error) {
+	result := r.db.Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *gormTwoFactorRepository) CountUnusedRecoveryCodes(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *gormTwoFactorRepository) DeleteRecoveryCodes(userID uint) error {
+	return r.db.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error
+}
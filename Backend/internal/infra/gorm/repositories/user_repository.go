@@ -43,6 +43,14 @@ func (r *gormUserRepository) FindByUsername(username string) (*models.User, erro
 	return &user, nil
 }
 
+func (r *gormUserRepository) FindByEmail(email string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("email = ?", email).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
 func (r *gormUserRepository) FindAllMembers(pagination *models.Pagination) ([]models.User, error) {
 	var users []models.User
 
@@ -51,27 +59,54 @@ func (r *gormUserRepository) FindAllMembers(pagination *models.Pagination) ([]mo
 	if pagination.Limit > 0 {
 		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
 	}
-		if err := query.Find(&users).Error; err != nil {
-			return nil, err
-		}
-		return users, nil
+	if err := query.Find(&users).Error; err != nil {
+		return nil, err
 	}
-	
-	func (r *gormUserRepository) FindAll(pagination *models.Pagination) ([]models.User, int64, error) {
-		var users []models.User
-		var total int64
-	
-		// Hitung total semua user
-		if err := r.db.Model(&models.User{}).Count(&total).Error; err != nil {
-			return nil, 0, err
+	return users, nil
+}
+
+func (r *gormUserRepository) FindAll(filter *models.UserFilter, pagination *models.Pagination) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	query := r.db.Model(&models.User{})
+	if filter != nil {
+		if filter.Search != "" {
+			like := "%" + filter.Search + "%"
+			query = query.Where("username LIKE ? OR email LIKE ? OR full_name LIKE ?", like, like, like)
 		}
-		
-		// Ambil data user dengan paginasi
-		query := r.db.Limit(pagination.Limit).Offset(pagination.Offset).Order(pagination.Sort)
-		if err := query.Find(&users).Error; err != nil {
-			return nil, 0, err
+		if filter.Role != "" {
+			query = query.Where("role = ?", filter.Role)
 		}
-		
-		return users, total, nil
+		switch filter.Status {
+		case models.UserStatusActive:
+			query = query.Where("deactivated_at IS NULL")
+		case models.UserStatusInactive:
+			query = query.Where("deactivated_at IS NOT NULL")
+		}
+	}
+
+	// Hitung total user yang cocok dengan filter (sebelum paginasi)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Ambil data user dengan paginasi
+	query = query.Order(pagination.Sort)
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+	if err := query.Find(&users).Error; err != nil {
+		return nil, 0, err
 	}
-	
\ No newline at end of file
+
+	return users, total, nil
+}
+
+func (r *gormUserRepository) CountActiveAdmins() (int64, error) {
+	var count int64
+	err := r.db.Model(&models.User{}).
+		Where("role = ? AND is_group_admin = ? AND deactivated_at IS NULL", models.RoleAdmin, true).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,149 @@
+package repositories
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/repositories"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormWaitlistRepository struct {
+	db *gorm.DB
+}
+
+func NewGormWaitlistRepository(db *gorm.DB) repositories.WaitlistRepository {
+	return &gormWaitlistRepository{db: db}
+}
+
+func (r *gormWaitlistRepository) Create(entry *models.WaitlistEntry) error {
+	return r.db.Create(entry).Error
+}
+
+func (r *gormWaitlistRepository) Update(entry *models.WaitlistEntry) error {
+	return r.db.Save(entry).Error
+}
+
+func (r *gormWaitlistRepository) FindByID(id uint) (*models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	if err := r.db.First(&entry, id).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *gormWaitlistRepository) FindByOfferTokenHash(tokenHash string) (*models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	if err := r.db.Where("offer_token_hash = ?", tokenHash).First(&entry).Error; err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *gormWaitlistRepository) FindByUserID(userID uint) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	err := r.db.Where("user_id = ?", userID).Order("id desc").Find(&entries).Error
+	return entries, err
+}
+
+func (r *gormWaitlistRepository) FindAll(filter *models.WaitlistFilter, pagination *models.Pagination) ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	query := r.db.Order(pagination.Sort)
+
+	if filter != nil {
+		if filter.PropertyID != 0 {
+			query = query.Where("property_id = ?", filter.PropertyID)
+		}
+		if len(filter.PropertyIDs) > 0 {
+			query = query.Where("property_id IN ?", filter.PropertyIDs)
+		}
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+	}
+
+	if pagination.Limit > 0 {
+		query = query.Limit(pagination.Limit).Offset(pagination.Offset)
+	}
+
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *gormWaitlistRepository) FindWaiting() ([]models.WaitlistEntry, error) {
+	var entries []models.WaitlistEntry
+	err := r.db.Where("status = ?", models.WaitlistWaiting).Order("id").Find(&entries).Error
+	return entries, err
+}
+
+// Offer mengunci baris kamar (SELECT ... FOR UPDATE) sehingga penawaran kamar yang sama dari instance lain
+// menunggu sampai transaksi ini selesai, lalu mengecek ulang penahanan dan status antrean di dalam transaksi
+func (r *gormWaitlistRepository) Offer(entry *models.WaitlistEntry) (bool, error) {
+	offered := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var room models.Room
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&room, *entry.OfferedRoomID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		var held int64
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&models.WaitlistEntry{}).
+			Where("offered_room_id = ? AND status = ? AND offer_expires_at > ?", room.ID, models.WaitlistOffered, *entry.OfferedAt).
+			Where("check_out_date > ? AND check_in_date < ?", entry.CheckInDate.Format("2006-01-02"), entry.CheckOutDate.Format("2006-01-02")).
+			Count(&held).Error
+		if err != nil || held > 0 {
+			return err
+		}
+
+		// Antrean yang sudah ditawari / dibatalkan di instance lain tidak ditimpa
+		result := tx.Model(entry).Where("status = ?", models.WaitlistWaiting).
+			Select("status", "offered_room_id", "offer_token_hash", "offered_at", "offer_expires_at", "updated_at").
+			Updates(entry)
+		if result.Error != nil {
+			return result.Error
+		}
+		offered = result.RowsAffected == 1
+		return nil
+	})
+	return offered, err
+}
+
+func (r *gormWaitlistRepository) Expire(now time.Time) (int64, error) {
+	var expired int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.WaitlistEntry{}).
+			Where("status = ? AND offer_expires_at <= ?", models.WaitlistOffered, now).
+			Update("status", models.WaitlistExpired)
+		if result.Error != nil {
+			return result.Error
+		}
+		expired += result.RowsAffected
+
+		result = tx.Model(&models.WaitlistEntry{}).
+			Where("status = ? AND check_in_date < ?", models.WaitlistWaiting, now.Format("2006-01-02")).
+			Update("status", models.WaitlistExpired)
+		if result.Error != nil {
+			return result.Error
+		}
+		expired += result.RowsAffected
+		return nil
+	})
+	return expired, err
+}
+
+func (r *gormWaitlistRepository) IsHeld(roomID uint, checkInDate, checkOutDate string, exceptUserID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.WaitlistEntry{}).
+		Where("offered_room_id = ? AND status = ? AND offer_expires_at > ?", roomID, models.WaitlistOffered, time.Now()).
+		Where("check_out_date > ? AND check_in_date < ?", checkInDate, checkOutDate).
+		Where("user_id <> ?", exceptUserID).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,92 @@
+package docs
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// openAPISpec adalah dokumen OpenAPI 3 untuk seluruh endpoint /api
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+// DocsPrefix adalah lokasi Swagger UI dan dokumen spec
+const DocsPrefix = "/api/docs"
+
+// swaggerUIPage memuat Swagger UI dari CDN dan mengarah ke spec yang di-embed
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8" />
+  <title>Luxury Hotel API Docs</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "` + DocsPrefix + `/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>`
+
+// Register mendaftarkan Swagger UI di /api/docs dan spec di /api/docs/openapi.json
+func Register(router fiber.Router) {
+	router.Get("/docs", func(c *fiber.Ctx) error {
+		c.Type("html", "utf-8")
+		return c.SendString(swaggerUIPage)
+	})
+	router.Get("/docs/openapi.json", func(c *fiber.Ctx) error {
+		c.Type("json", "utf-8")
+		return c.Send(openAPISpec)
+	})
+}
+
+// Spec mengembalikan dokumen OpenAPI mentah
+func Spec() []byte {
+	return openAPISpec
+}
+
+var pathParamPattern = regexp.MustCompile(`:(\w+)`)
+
+// MissingRoutes membandingkan route Fiber yang terdaftar di bawah /api dengan spec
+// dan mengembalikan route ("METHOD /path") yang belum didokumentasikan.
+func MissingRoutes(app *fiber.App) ([]string, error) {
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var missing []string
+	for _, route := range app.GetRoutes(true) {
+		// HEAD otomatis dibuat Fiber untuk setiap GET, dan halaman docs tidak perlu didokumentasikan
+		if route.Method == fiber.MethodHead || !strings.HasPrefix(route.Path, "/api/") || strings.HasPrefix(route.Path, DocsPrefix) {
+			continue
+		}
+
+		// Format Fiber "/rooms/:id" -> format OpenAPI "/rooms/{id}"
+		path := pathParamPattern.ReplaceAllString(strings.TrimSuffix(route.Path, "/"), "{$1}")
+		key := route.Method + " " + path
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if _, ok := spec.Paths[path][strings.ToLower(route.Method)]; !ok {
+			missing = append(missing, key)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing, nil
+}
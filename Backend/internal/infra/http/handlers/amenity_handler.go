@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AmenityHandler struct {
+	amenityService services.AmenityService
+}
+
+func NewAmenityHandler(amenityService services.AmenityService) *AmenityHandler {
+	return &AmenityHandler{amenityService: amenityService}
+}
+
+// GetAllAmenities: Mengambil katalog fasilitas, opsional ?category= (Public)
+func (h *AmenityHandler) GetAllAmenities(c *fiber.Ctx) error {
+	amenities, err := h.amenityService.GetAllAmenities(c.Query("category"))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "AMENITIES_FETCHED", amenities)
+}
+
+// GetAmenityByID: Mengambil detail fasilitas (Admin Only)
+func (h *AmenityHandler) GetAmenityByID(c *fiber.Ctx) error {
+	amenityID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_AMENITY_ID")
+	}
+
+	amenity, err := h.amenityService.GetAmenityByID(uint(amenityID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "AMENITY_FETCHED", amenity)
+}
+
+type AmenityInput struct {
+	Name     string `json:"name" validate:"required,max=100"`
+	Icon     string `json:"icon" validate:"max=50"`
+	Category string `json:"category" validate:"required,max=50"`
+}
+
+// CreateAmenity: Menambah fasilitas (Admin Only)
+func (h *AmenityHandler) CreateAmenity(c *fiber.Ctx) error {
+	var input AmenityInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	amenity, err := h.amenityService.CreateAmenity(&models.Amenity{
+		Name:     input.Name,
+		Icon:     input.Icon,
+		Category: input.Category,
+	})
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "AMENITY_CREATED", amenity)
+}
+
+// UpdateAmenity: Mengubah fasilitas (Admin Only)
+func (h *AmenityHandler) UpdateAmenity(c *fiber.Ctx) error {
+	amenityID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_AMENITY_ID")
+	}
+
+	amenity, err := h.amenityService.GetAmenityByID(uint(amenityID))
+	if err != nil {
+		return err
+	}
+
+	var input AmenityInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	amenity.Name = input.Name
+	amenity.Icon = input.Icon
+	amenity.Category = input.Category
+
+	updatedAmenity, err := h.amenityService.UpdateAmenity(amenity)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "AMENITY_UPDATED", updatedAmenity)
+}
+
+// DeleteAmenity: Menghapus fasilitas (Admin Only)
+func (h *AmenityHandler) DeleteAmenity(c *fiber.Ctx) error {
+	amenityID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_AMENITY_ID")
+	}
+
+	if err := h.amenityService.DeleteAmenity(uint(amenityID)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "AMENITY_DELETED", nil)
+}
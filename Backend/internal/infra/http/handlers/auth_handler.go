@@ -4,10 +4,7 @@ import (
 	"backend/internal/app/services"
 	"backend/internal/domain/models"
 	"backend/pkg/utils"
-	"errors"
-	"log"
 
-	"github.com/go-sql-driver/mysql"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -26,22 +23,35 @@ type LoginInput struct {
 
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var input LoginInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid request body")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
-	token, user, err := h.authService.Login(input.Username, input.Password)
-
+	result, err := h.authService.Login(input.Username, input.Password)
 	if err != nil {
-		if errors.Is(err, models.ErrRecordNotFound) || errors.Is(err, models.ErrInvalidCredentials) {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Username atau Password Salah")
+		return err
+	}
+
+	return respondLogin(c, result)
+}
+
+// respondLogin mengirim token akses, atau challenge token jika 2FA harus diselesaikan dulu
+func respondLogin(c *fiber.Ctx, result *models.LoginResult) error {
+	if result.ChallengeToken != "" {
+		message := "TWO_FACTOR_CODE_REQUIRED"
+		if result.ChallengeType == models.ChallengeTwoFactorEnroll {
+			message = "TWO_FACTOR_ENROLLMENT_REQUIRED"
 		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal login")
+		return utils.RespondSuccess(c, fiber.StatusOK, message, fiber.Map{
+			"challenge_token": result.ChallengeToken,
+			"challenge_type":  result.ChallengeType,
+			"expires_in":      result.ExpiresIn,
+		})
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Login Berhasil", fiber.Map{
-		"token": token,
-		"user":  user,
+	return utils.RespondSuccess(c, fiber.StatusOK, "LOGIN_SUCCESS", fiber.Map{
+		"token": result.Token,
+		"user":  result.User,
 	})
 }
 
@@ -50,12 +60,13 @@ type RegisterInput struct {
 	Password string `json:"password" validate:"required,min=6"`
 	Email    string `json:"email" validate:"required,email"`
 	FullName string `json:"full_name" validate:"required"`
+	Language string `json:"language" validate:"omitempty,oneof=id en"`
 }
 
 func (h *AuthHandler) Register(c *fiber.Ctx) error {
 	var input RegisterInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid request body")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
 	newUser := &models.User{
@@ -64,17 +75,151 @@ func (h *AuthHandler) Register(c *fiber.Ctx) error {
 		Email:    input.Email,
 		FullName: input.FullName,
 		Role:     models.RoleMember, // Default: Member
+		Language: input.Language,
+	}
+
+	// Jika tidak dipilih, gunakan bahasa yang dinegosiasikan dari request
+	if newUser.Language == "" {
+		newUser.Language = utils.Lang(c)
+	}
+
+	if _, err := h.authService.Register(newUser); err != nil {
+		return err
+	}
+	return utils.RespondSuccess(c, fiber.StatusOK, "REGISTER_SUCCESS", nil)
+}
+
+type TwoFactorChallengeInput struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type TwoFactorVerifyInput struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required,max=20"` // Kode TOTP 6 digit atau recovery code
+}
+
+type TwoFactorCodeInput struct {
+	Code string `json:"code" validate:"required,max=20"`
+}
+
+type DisableTwoFactorInput struct {
+	Password string `json:"password"`                        // Kosong untuk akun tanpa password (login OIDC), wajib login ulang dulu
+	Code     string `json:"code" validate:"required,max=20"` // Kode TOTP atau recovery code
+}
+
+// VerifyTwoFactor: Login tahap kedua dengan kode TOTP / recovery code (Public, pakai challenge token)
+func (h *AuthHandler) VerifyTwoFactor(c *fiber.Ctx) error {
+	var input TwoFactorVerifyInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
-	_, err := h.authService.Register(newUser)
+	result, err := h.authService.VerifyTwoFactor(input.ChallengeToken, input.Code)
+	if err != nil {
+		return err
+	}
+
+	return respondLogin(c, result)
+}
+
+// BeginEnrollment: Secret 2FA untuk user yang diwajibkan 2FA saat login (Public, pakai challenge token)
+func (h *AuthHandler) BeginEnrollment(c *fiber.Ctx) error {
+	var input TwoFactorChallengeInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
 
+	setup, err := h.authService.BeginEnrollment(input.ChallengeToken)
 	if err != nil {
-		var mysqlErr *mysql.MySQLError
-		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
-			return utils.RespondError(c, fiber.StatusConflict, "Username atau email sudah digunakan")
-		}
-		log.Println("Register error:", err)
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mendaftarkan user")
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_SETUP_STARTED", setup)
+}
+
+// ConfirmEnrollment: Aktifkan 2FA lalu selesaikan login (Public, pakai challenge token)
+func (h *AuthHandler) ConfirmEnrollment(c *fiber.Ctx) error {
+	var input TwoFactorVerifyInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	result, recoveryCodes, err := h.authService.ConfirmEnrollment(input.ChallengeToken, input.Code)
+	if err != nil {
+		return err
 	}
-	return utils.RespondSuccess(c, fiber.StatusOK, "Pendaftaran berhasil", nil)
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_ENABLED", fiber.Map{
+		"token":          result.Token,
+		"user":           result.User,
+		"recovery_codes": recoveryCodes,
+	})
+}
+
+// GetTwoFactorStatus: Status 2FA akun sendiri
+func (h *AuthHandler) GetTwoFactorStatus(c *fiber.Ctx) error {
+	status, err := h.authService.GetTwoFactorStatus(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_STATUS_FETCHED", status)
+}
+
+// SetupTwoFactor: Mulai setup 2FA, kembalikan secret & URI untuk QR code
+func (h *AuthHandler) SetupTwoFactor(c *fiber.Ctx) error {
+	setup, err := h.authService.SetupTwoFactor(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_SETUP_STARTED", setup)
+}
+
+// EnableTwoFactor: Konfirmasi kode pertama dari authenticator; recovery code hanya ditampilkan sekali
+func (h *AuthHandler) EnableTwoFactor(c *fiber.Ctx) error {
+	var input TwoFactorCodeInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	recoveryCodes, err := h.authService.EnableTwoFactor(c.Locals("userID").(uint), input.Code)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_ENABLED", fiber.Map{
+		"recovery_codes": recoveryCodes,
+	})
+}
+
+// DisableTwoFactor: Nonaktifkan 2FA (butuh password atau login ulang, dan kode)
+func (h *AuthHandler) DisableTwoFactor(c *fiber.Ctx) error {
+	var input DisableTwoFactorInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if err := h.authService.DisableTwoFactor(c.Locals("userID").(uint), reauthProof(c, input.Password, input.Code)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "TWO_FACTOR_DISABLED", nil)
+}
+
+// RegenerateRecoveryCodes: Buat recovery code baru, yang lama tidak berlaku lagi
+func (h *AuthHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	var input TwoFactorCodeInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	recoveryCodes, err := h.authService.RegenerateRecoveryCodes(c.Locals("userID").(uint), input.Code)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "RECOVERY_CODES_REGENERATED", fiber.Map{
+		"recovery_codes": recoveryCodes,
+	})
 }
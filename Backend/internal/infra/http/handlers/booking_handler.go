@@ -4,7 +4,6 @@ import (
 	"backend/internal/app/services"
 	"backend/internal/domain/models"
 	"backend/pkg/utils"
-	"errors"
 	"strconv"
 	"time"
 
@@ -13,23 +12,42 @@ import (
 
 type BookingHandler struct {
 	bookingService services.BookingService
+	userService    services.UserService
 }
 
-func NewBookingHandler(bookingService services.BookingService) *BookingHandler {
-	return &BookingHandler{bookingService: bookingService}
+func NewBookingHandler(bookingService services.BookingService, userService services.UserService) *BookingHandler {
+	return &BookingHandler{bookingService: bookingService, userService: userService}
 }
 
 type CreateBookingInput struct {
-	RoomID           uint   `json:"room_id" validate:"required"`
-	CheckInDate      string `json:"check_in_date" validate:"required"`
-	CheckOutDate     string `json:"check_out_date" validate:"required"`
-	PaymentMethod    string `json:"payment_method"`
-	GuestName        string `json:"guest_name" validate:"required"`
-	GuestEmail       string `json:"guest_email" validate:"required,email"`
-	GuestPhone       string `json:"guest_phone" validate:"required"`
-	GuestIDNumber    string `json:"guest_id_number"`
-	SpecialRequests  string `json:"special_requests"`
-	NumberOfGuests   int    `json:"number_of_guests" validate:"required,min=1"`
+	RoomID          uint                `json:"room_id" validate:"required"`
+	CheckInDate     string              `json:"check_in_date" validate:"required,datetime=2006-01-02"`
+	CheckOutDate    string              `json:"check_out_date" validate:"required,datetime=2006-01-02"`
+	PaymentMethod   string              `json:"payment_method"`
+	SavedGuestID    uint                `json:"saved_guest_id"` // Data tamu kosong diisi dari data tamu tersimpan
+	GuestName       string              `json:"guest_name" validate:"required_without=SavedGuestID"`
+	GuestEmail      string              `json:"guest_email" validate:"required_without=SavedGuestID,omitempty,email"`
+	GuestPhone      string              `json:"guest_phone" validate:"required_without=SavedGuestID"`
+	GuestIDNumber   string              `json:"guest_id_number"`
+	SpecialRequests string              `json:"special_requests"`
+	NumberOfGuests  int                 `json:"number_of_guests" validate:"required_without=Adults,omitempty,min=1"` // Client lama: jumlah tamu dewasa
+	Adults          int                 `json:"adults" validate:"omitempty,min=1"`
+	Children        int                 `json:"children" validate:"omitempty,min=0"`
+	ExtraBeds       int                 `json:"extra_beds" validate:"omitempty,min=0"`
+	Extras          []BookingExtraInput `json:"extras" validate:"omitempty,max=20,dive"` // Layanan tambahan dari katalog properti
+}
+
+type BookingExtraInput struct {
+	ExtraID  uint `json:"extra_id" validate:"required"`
+	Quantity int  `json:"quantity" validate:"omitempty,min=1,max=99"` // Kosong = 1
+}
+
+func (input *BookingExtraInput) toBookingExtra() models.BookingExtra {
+	quantity := input.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	return models.BookingExtra{ExtraID: input.ExtraID, Quantity: quantity}
 }
 
 // CreateBooking: Membuat booking baru (Member Only)
@@ -38,41 +56,70 @@ func (h *BookingHandler) CreateBooking(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(uint)
 
 	var input CreateBookingInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	// Isi data tamu dari data tamu tersimpan milik member (field yang dikirim tetap diutamakan)
+	if input.SavedGuestID != 0 {
+		saved, err := h.userService.GetSavedGuest(userID, input.SavedGuestID)
+		if err != nil {
+			return err
+		}
+		if input.GuestName == "" {
+			input.GuestName = saved.FullName
+		}
+		if input.GuestEmail == "" {
+			input.GuestEmail = saved.Email
+		}
+		if input.GuestPhone == "" {
+			input.GuestPhone = string(saved.Phone)
+		}
+		if input.GuestIDNumber == "" {
+			input.GuestIDNumber = string(saved.IDNumber)
+		}
+		if input.GuestName == "" || input.GuestEmail == "" || input.GuestPhone == "" {
+			return utils.RespondError(c, fiber.StatusBadRequest, "GUEST_DETAILS_INCOMPLETE")
+		}
 	}
 
 	// Parse tanggal
 	checkIn, err := time.Parse("2006-01-02", input.CheckInDate)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format tanggal check-in tidak valid (gunakan format YYYY-MM-DD)")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_IN_DATE")
 	}
 
 	checkOut, err := time.Parse("2006-01-02", input.CheckOutDate)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format tanggal check-out tidak valid (gunakan format YYYY-MM-DD)")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_OUT_DATE")
 	}
 
 	booking := &models.Booking{
-		UserID:         userID,
-		RoomID:         input.RoomID,
-		CheckInDate:    checkIn,
-		CheckOutDate:   checkOut,
-		PaymentMethod:  input.PaymentMethod,
-		GuestName:      input.GuestName,
-		GuestEmail:     input.GuestEmail,
-		GuestPhone:     input.GuestPhone,
-		GuestIDNumber:  input.GuestIDNumber,
+		UserID:          userID,
+		RoomID:          input.RoomID,
+		CheckInDate:     checkIn,
+		CheckOutDate:    checkOut,
+		PaymentMethod:   input.PaymentMethod,
+		GuestName:       input.GuestName,
+		GuestEmail:      input.GuestEmail,
+		GuestPhone:      models.SensitiveString(input.GuestPhone),
+		GuestIDNumber:   models.SensitiveString(input.GuestIDNumber),
 		SpecialRequests: input.SpecialRequests,
-		NumberOfGuests: input.NumberOfGuests,
+		NumberOfGuests:  input.NumberOfGuests,
+		Adults:          input.Adults,
+		Children:        input.Children,
+		ExtraBeds:       input.ExtraBeds,
+	}
+	for _, extra := range input.Extras {
+		booking.Extras = append(booking.Extras, extra.toBookingExtra())
 	}
 
 	createdBooking, err := h.bookingService.CreateBooking(booking)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusCreated, "Pemesanan berhasil dibuat", createdBooking)
+	return utils.RespondSuccess(c, fiber.StatusCreated, "BOOKING_CREATED", createdBooking)
 }
 
 // GetMyBookings: Mengambil booking saya (Member)
@@ -91,14 +138,14 @@ func (h *BookingHandler) GetMyBookings(c *fiber.Ctx) error {
 	bookings, err := h.bookingService.GetUserBookings(userID, pagination)
 	if err != nil {
 		// Return empty array instead of error for now
-		return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data pemesanan", fiber.Map{
+		return utils.RespondSuccess(c, fiber.StatusOK, "BOOKINGS_FETCHED", fiber.Map{
 			"bookings": []models.Booking{},
 			"page":     page,
 			"limit":    limit,
 		})
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data pemesanan", fiber.Map{
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKINGS_FETCHED", fiber.Map{
 		"bookings": bookings,
 		"page":     page,
 		"limit":    limit,
@@ -111,17 +158,14 @@ func (h *BookingHandler) CancelBooking(c *fiber.Ctx) error {
 
 	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID pemesanan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
 	if err := h.bookingService.CancelBooking(uint(bookingID), userID); err != nil {
-		if errors.Is(err, errors.New("anda tidak memiliki izin membatalkan pemesanan ini")) {
-			return utils.RespondError(c, fiber.StatusForbidden, "Anda tidak memiliki izin membatalkan pemesanan ini")
-		}
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Pemesanan berhasil dibatalkan", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_CANCELLED", nil)
 }
 
 // DeleteBooking: Menghapus booking yang cancelled (Member)
@@ -130,18 +174,180 @@ func (h *BookingHandler) DeleteBooking(c *fiber.Ctx) error {
 
 	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID pemesanan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
 	if err := h.bookingService.DeleteBooking(uint(bookingID), userID); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_DELETED", nil)
+}
+
+type ModifyBookingInput struct {
+	RoomID       uint   `json:"room_id"`
+	CheckInDate  string `json:"check_in_date" validate:"omitempty,datetime=2006-01-02"`
+	CheckOutDate string `json:"check_out_date" validate:"omitempty,datetime=2006-01-02"`
+	Adults       int    `json:"adults" validate:"omitempty,min=1"`
+	Children     *int   `json:"children" validate:"omitempty,min=0"`
+	ExtraBeds    *int   `json:"extra_beds" validate:"omitempty,min=0"`
+	Reason       string `json:"reason" validate:"omitempty,max=255"`
+}
+
+// toChange mengubah input menjadi perubahan booking; field kosong = tidak diubah.
+// Mengembalikan kode error jika tanggal tidak valid.
+func (input *ModifyBookingInput) toChange() (*models.BookingChange, string) {
+	change := &models.BookingChange{
+		RoomID:    input.RoomID,
+		Adults:    input.Adults,
+		Children:  input.Children,
+		ExtraBeds: input.ExtraBeds,
+		Reason:    input.Reason,
+	}
+	if input.CheckInDate != "" {
+		checkIn, err := time.Parse("2006-01-02", input.CheckInDate)
+		if err != nil {
+			return nil, "INVALID_CHECK_IN_DATE"
+		}
+		change.CheckInDate = checkIn
+	}
+	if input.CheckOutDate != "" {
+		checkOut, err := time.Parse("2006-01-02", input.CheckOutDate)
+		if err != nil {
+			return nil, "INVALID_CHECK_OUT_DATE"
+		}
+		change.CheckOutDate = checkOut
+	}
+	return change, ""
+}
+
+// ModifyBooking: Mengubah tanggal, kamar, atau jumlah tamu booking sendiri (Member)
+func (h *BookingHandler) ModifyBooking(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	var input ModifyBookingInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+	change, code := input.toChange()
+	if code != "" {
+		return utils.RespondError(c, fiber.StatusBadRequest, code)
+	}
+
+	modification, err := h.bookingService.ModifyBooking(uint(bookingID), userID, change)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_MODIFIED", modification)
+}
+
+// GetBookingModifications: Riwayat perubahan booking sendiri (Member)
+func (h *BookingHandler) GetBookingModifications(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	modifications, err := h.bookingService.GetBookingModifications(uint(bookingID), userID)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_MODIFICATIONS_FETCHED", modifications)
+}
+
+// AddExtra: Menambah layanan tambahan ke booking sendiri (Member)
+func (h *BookingHandler) AddExtra(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	var input BookingExtraInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	booking, err := h.bookingService.AddExtra(uint(bookingID), userID, input.toBookingExtra())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "BOOKING_EXTRA_ADDED", booking)
+}
+
+// RemoveExtra: Menghapus layanan tambahan dari booking sendiri (Member)
+func (h *BookingHandler) RemoveExtra(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+	bookingExtraID, err := strconv.ParseUint(c.Params("extraId"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_EXTRA_ID")
+	}
+
+	booking, err := h.bookingService.RemoveExtra(uint(bookingID), userID, uint(bookingExtraID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_EXTRA_REMOVED", booking)
+}
+
+// GetInvoice: Rincian tagihan booking sendiri (Member)
+func (h *BookingHandler) GetInvoice(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	invoice, err := h.bookingService.GetInvoice(uint(bookingID), userID)
+	if err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Pemesanan berhasil dihapus", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "INVOICE_FETCHED", invoice)
+}
+
+// authorizeBooking memastikan booking termasuk properti yang dikelola admin
+func (h *BookingHandler) authorizeBooking(c *fiber.Ctx, bookingID uint) (*models.Booking, error) {
+	booking, err := h.bookingService.GetBookingByID(bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProperty(c, booking.PropertyID); err != nil {
+		return nil, err
+	}
+	return booking, nil
 }
 
 // GetAllBookings: Mengambil semua booking (Admin Only)
+// Filter properti: ?property_id=1 (admin hanya melihat booking di properti yang dikelola)
 func (h *BookingHandler) GetAllBookings(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
 	page := c.QueryInt("page", 1)
 	limit := c.QueryInt("limit", 10)
 
@@ -152,12 +358,13 @@ func (h *BookingHandler) GetAllBookings(c *fiber.Ctx) error {
 		Offset: (page - 1) * limit,
 	}
 
-	bookings, err := h.bookingService.GetAllBookings(pagination)
+	filter := &models.BookingFilter{PropertyID: propertyID, PropertyIDs: propertyIDs}
+	bookings, err := h.bookingService.GetAllBookings(filter, pagination)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil data pemesanan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data pemesanan", fiber.Map{
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKINGS_FETCHED", fiber.Map{
 		"bookings": bookings,
 		"page":     page,
 		"limit":    limit,
@@ -165,70 +372,204 @@ func (h *BookingHandler) GetAllBookings(c *fiber.Ctx) error {
 }
 
 type UpdatePaymentStatusInput struct {
-	PaymentStatus string `json:"payment_status" validate:"required"`
+	PaymentStatus string `json:"payment_status" validate:"required,oneof=pending paid failed"`
 }
 
 // UpdatePaymentStatus: Mengubah status pembayaran (Admin Only)
 func (h *BookingHandler) UpdatePaymentStatus(c *fiber.Ctx) error {
 	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID pemesanan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
 	var input UpdatePaymentStatusInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
 	}
 
 	updatedBooking, err := h.bookingService.UpdatePaymentStatus(uint(bookingID), input.PaymentStatus)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengubah status pembayaran")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Status pembayaran berhasil diubah", updatedBooking)
+	return utils.RespondSuccess(c, fiber.StatusOK, "PAYMENT_STATUS_UPDATED", updatedBooking)
 }
 
 // GetBookingByID: Mengambil detail booking (Admin Only)
 func (h *BookingHandler) GetBookingByID(c *fiber.Ctx) error {
 	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID pemesanan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
-	booking, err := h.bookingService.GetBookingByID(uint(bookingID))
+	booking, err := h.authorizeBooking(c, uint(bookingID))
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusNotFound, "Pemesanan tidak ditemukan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil detail pemesanan", booking)
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_FETCHED", booking)
 }
 
 type UpdateBookingStatusInput struct {
-	Status string `json:"status" validate:"required"`
+	Status string `json:"status" validate:"required,oneof=confirmed cancelled completed"`
 }
 
 // UpdateBookingStatus: Mengubah status booking (Admin Only)
 func (h *BookingHandler) UpdateBookingStatus(c *fiber.Ctx) error {
 	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID pemesanan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
 	var input UpdateBookingStatusInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
 	}
 
-	booking, err := h.bookingService.GetBookingByID(uint(bookingID))
+	updatedBooking, err := h.bookingService.UpdateBookingStatus(uint(bookingID), input.Status)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusNotFound, "Pemesanan tidak ditemukan")
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_STATUS_UPDATED", updatedBooking)
+}
+
+// CheckInBooking: Mencatat tamu check-in, ditolak jika kamar belum bersih (Admin Only)
+func (h *BookingHandler) CheckInBooking(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
+	}
+
+	booking, err := h.bookingService.CheckInBooking(uint(bookingID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_CHECKED_IN", booking)
+}
+
+// ModifyBookingByAdmin: Mengubah booking tamu oleh staf (Admin Only)
+func (h *BookingHandler) ModifyBookingByAdmin(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	var input ModifyBookingInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+	change, code := input.toChange()
+	if code != "" {
+		return utils.RespondError(c, fiber.StatusBadRequest, code)
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
+	}
+
+	modification, err := h.bookingService.ModifyBookingByAdmin(uint(bookingID), c.Locals("userID").(uint), change)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_MODIFIED", modification)
+}
+
+// GetBookingModificationsByAdmin: Riwayat perubahan booking (Admin Only)
+func (h *BookingHandler) GetBookingModificationsByAdmin(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
+	}
+
+	modifications, err := h.bookingService.GetBookingModificationsByAdmin(uint(bookingID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_MODIFICATIONS_FETCHED", modifications)
+}
+
+// AddExtraByAdmin: Penjualan layanan tambahan di front desk (Admin Only)
+func (h *BookingHandler) AddExtraByAdmin(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	var input BookingExtraInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
+	}
+
+	booking, err := h.bookingService.AddExtraByAdmin(uint(bookingID), c.Locals("userID").(uint), input.toBookingExtra())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "BOOKING_EXTRA_ADDED", booking)
+}
+
+// RemoveExtraByAdmin: Menghapus layanan tambahan dari booking (Admin Only)
+func (h *BookingHandler) RemoveExtraByAdmin(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+	bookingExtraID, err := strconv.ParseUint(c.Params("extraId"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_EXTRA_ID")
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
+	}
+
+	booking, err := h.bookingService.RemoveExtraByAdmin(uint(bookingID), uint(bookingExtraID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKING_EXTRA_REMOVED", booking)
+}
+
+// GetInvoiceByAdmin: Rincian tagihan booking (Admin Only)
+func (h *BookingHandler) GetInvoiceByAdmin(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	if _, err := h.authorizeBooking(c, uint(bookingID)); err != nil {
+		return err
 	}
 
-	booking.BookingStatus = input.Status
-	updatedBooking, err := h.bookingService.UpdateBooking(booking)
+	invoice, err := h.bookingService.GetInvoiceByAdmin(uint(bookingID))
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengubah status booking")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Status booking berhasil diubah", updatedBooking)
+	return utils.RespondSuccess(c, fiber.StatusOK, "INVOICE_FETCHED", invoice)
 }
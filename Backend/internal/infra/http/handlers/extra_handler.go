@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ExtraHandler menangani katalog layanan tambahan (sarapan, antar-jemput bandara, spa, late check-out)
+type ExtraHandler struct {
+	extraService services.ExtraService
+}
+
+func NewExtraHandler(extraService services.ExtraService) *ExtraHandler {
+	return &ExtraHandler{extraService: extraService}
+}
+
+// GetPropertyExtras: Layanan tambahan yang bisa dipesan di satu properti (Public)
+func (h *ExtraHandler) GetPropertyExtras(c *fiber.Ctx) error {
+	propertyID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+
+	extras, err := h.extraService.GetPropertyExtras(uint(propertyID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EXTRAS_FETCHED", extras)
+}
+
+// authorizeExtra memastikan layanan tambahan termasuk properti yang dikelola admin
+func (h *ExtraHandler) authorizeExtra(c *fiber.Ctx, extraID uint) (*models.Extra, error) {
+	extra, err := h.extraService.GetExtraByID(extraID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProperty(c, extra.PropertyID); err != nil {
+		return nil, err
+	}
+	return extra, nil
+}
+
+// GetAllExtras: Katalog layanan tambahan termasuk yang nonaktif (Admin Only)
+// Filter: ?property_id=1
+func (h *ExtraHandler) GetAllExtras(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
+	extras, err := h.extraService.GetAllExtras(&models.ExtraFilter{PropertyID: propertyID, PropertyIDs: propertyIDs})
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EXTRAS_FETCHED", extras)
+}
+
+// GetExtraByID: Mengambil detail layanan tambahan (Admin Only)
+func (h *ExtraHandler) GetExtraByID(c *fiber.Ctx) error {
+	extraID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_EXTRA_ID")
+	}
+
+	extra, err := h.authorizeExtra(c, uint(extraID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EXTRA_FETCHED", extra)
+}
+
+type ExtraInput struct {
+	PropertyID  uint    `json:"property_id"` // Hanya saat membuat; kosong = properti default / satu-satunya properti admin
+	Name        string  `json:"name" validate:"required,max=100"`
+	Description string  `json:"description"`
+	PricingType string  `json:"pricing_type" validate:"required,oneof=per_night per_person per_stay"`
+	Price       float64 `json:"price" validate:"min=0"`
+	IsActive    *bool   `json:"is_active"` // Kosong = aktif (saat membuat) / tidak diubah
+}
+
+// CreateExtra: Menambah layanan tambahan ke katalog properti (Admin Only)
+func (h *ExtraHandler) CreateExtra(c *fiber.Ctx) error {
+	var input ExtraInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	propertyID, err := defaultPropertyID(c, input.PropertyID)
+	if err != nil {
+		return err
+	}
+
+	extra := &models.Extra{
+		PropertyID:  propertyID,
+		Name:        input.Name,
+		Description: input.Description,
+		PricingType: input.PricingType,
+		Price:       input.Price,
+		IsActive:    input.IsActive == nil || *input.IsActive,
+	}
+
+	created, err := h.extraService.CreateExtra(extra)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "EXTRA_CREATED", created)
+}
+
+// UpdateExtra: Mengubah layanan tambahan (Admin Only); properti tidak bisa dipindah
+func (h *ExtraHandler) UpdateExtra(c *fiber.Ctx) error {
+	extraID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_EXTRA_ID")
+	}
+
+	var input ExtraInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	extra, err := h.authorizeExtra(c, uint(extraID))
+	if err != nil {
+		return err
+	}
+
+	extra.Name = input.Name
+	extra.Description = input.Description
+	extra.PricingType = input.PricingType
+	extra.Price = input.Price
+	if input.IsActive != nil {
+		extra.IsActive = *input.IsActive
+	}
+
+	updated, err := h.extraService.UpdateExtra(extra)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EXTRA_UPDATED", updated)
+}
+
+// DeleteExtra: Menghapus layanan tambahan dari katalog (Admin Only)
+func (h *ExtraHandler) DeleteExtra(c *fiber.Ctx) error {
+	extraID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_EXTRA_ID")
+	}
+
+	if _, err := h.authorizeExtra(c, uint(extraID)); err != nil {
+		return err
+	}
+
+	if err := h.extraService.DeleteExtra(uint(extraID)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EXTRA_DELETED", nil)
+}
@@ -0,0 +1,323 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// GroupHandler menangani reservasi grup (beberapa kamar, satu tamu utama, satu pembayaran)
+type GroupHandler struct {
+	bookingService services.BookingService
+	paymentService services.PaymentService
+}
+
+func NewGroupHandler(bookingService services.BookingService, paymentService services.PaymentService) *GroupHandler {
+	return &GroupHandler{bookingService: bookingService, paymentService: paymentService}
+}
+
+type GroupRoomInput struct {
+	RoomID       uint   `json:"room_id" validate:"required"`
+	CheckInDate  string `json:"check_in_date" validate:"required,datetime=2006-01-02"`
+	CheckOutDate string `json:"check_out_date" validate:"required,datetime=2006-01-02"`
+	Adults       int    `json:"adults" validate:"required,min=1"`
+	Children     int    `json:"children" validate:"omitempty,min=0"`
+	ExtraBeds    int    `json:"extra_beds" validate:"omitempty,min=0"`
+	GuestName    string `json:"guest_name" validate:"omitempty,max=255"` // Kosong = tamu utama
+}
+
+type CreateGroupBookingInput struct {
+	Name            string           `json:"name" validate:"omitempty,max=100"`
+	LeadGuestName   string           `json:"lead_guest_name" validate:"required,max=255"`
+	LeadGuestEmail  string           `json:"lead_guest_email" validate:"required,email"`
+	LeadGuestPhone  string           `json:"lead_guest_phone" validate:"required"`
+	SpecialRequests string           `json:"special_requests"`
+	PaymentMethod   string           `json:"payment_method"`
+	Rooms           []GroupRoomInput `json:"rooms" validate:"required,min=2,max=20,dive"`
+}
+
+// CreateGroupBooking: Membuat reservasi grup (Member)
+func (h *GroupHandler) CreateGroupBooking(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	var input CreateGroupBookingInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	rooms := make([]models.GroupRoom, 0, len(input.Rooms))
+	for _, room := range input.Rooms {
+		checkIn, err := time.Parse("2006-01-02", room.CheckInDate)
+		if err != nil {
+			return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_IN_DATE")
+		}
+		checkOut, err := time.Parse("2006-01-02", room.CheckOutDate)
+		if err != nil {
+			return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_OUT_DATE")
+		}
+		rooms = append(rooms, models.GroupRoom{
+			RoomID:       room.RoomID,
+			CheckInDate:  checkIn,
+			CheckOutDate: checkOut,
+			Party:        models.Party{Adults: room.Adults, Children: room.Children, ExtraBeds: room.ExtraBeds},
+			GuestName:    room.GuestName,
+		})
+	}
+
+	group := &models.BookingGroup{
+		UserID:          userID,
+		Name:            input.Name,
+		LeadGuestName:   input.LeadGuestName,
+		LeadGuestEmail:  input.LeadGuestEmail,
+		LeadGuestPhone:  models.SensitiveString(input.LeadGuestPhone),
+		SpecialRequests: input.SpecialRequests,
+		PaymentMethod:   input.PaymentMethod,
+	}
+
+	created, err := h.bookingService.CreateGroupBooking(group, rooms)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "GROUP_CREATED", created)
+}
+
+// GetMyGroups: Daftar reservasi grup saya (Member)
+func (h *GroupHandler) GetMyGroups(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "created_at desc"),
+		Offset: (page - 1) * limit,
+	}
+
+	groups, err := h.bookingService.GetUserGroups(userID, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUPS_FETCHED", fiber.Map{
+		"groups": groups,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// GetMyGroup: Detail reservasi grup saya beserta rooming list (Member)
+func (h *GroupHandler) GetMyGroup(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	group, err := h.bookingService.GetUserGroup(uint(groupID), userID)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUP_FETCHED", group)
+}
+
+// CancelMyGroup: Membatalkan seluruh kamar reservasi grup (Member)
+func (h *GroupHandler) CancelMyGroup(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	group, err := h.bookingService.CancelGroup(uint(groupID), userID)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUP_CANCELLED", group)
+}
+
+type RoomingListEntryInput struct {
+	BookingID     uint   `json:"booking_id" validate:"required"`
+	GuestName     string `json:"guest_name" validate:"required,max=255"`
+	GuestEmail    string `json:"guest_email" validate:"omitempty,email"`
+	GuestPhone    string `json:"guest_phone"`
+	GuestIDNumber string `json:"guest_id_number"`
+}
+
+type RoomingListInput struct {
+	Rooms []RoomingListEntryInput `json:"rooms" validate:"required,min=1,max=20,dive"`
+}
+
+func (input *RoomingListInput) toEntries() []models.RoomingListEntry {
+	entries := make([]models.RoomingListEntry, 0, len(input.Rooms))
+	for _, room := range input.Rooms {
+		entries = append(entries, models.RoomingListEntry{
+			BookingID:     room.BookingID,
+			GuestName:     room.GuestName,
+			GuestEmail:    room.GuestEmail,
+			GuestPhone:    room.GuestPhone,
+			GuestIDNumber: room.GuestIDNumber,
+		})
+	}
+	return entries
+}
+
+// UpdateMyRoomingList: Mengisi data penghuni tiap kamar (Member)
+func (h *GroupHandler) UpdateMyRoomingList(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	var input RoomingListInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	group, err := h.bookingService.UpdateRoomingList(uint(groupID), userID, input.toEntries())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOMING_LIST_UPDATED", group)
+}
+
+// CreateGroupPayment: Satu pembayaran untuk seluruh kamar grup (Member)
+func (h *GroupHandler) CreateGroupPayment(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	var req struct {
+		PaymentMethod string `json:"payment_method" validate:"required"`
+	}
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		return err
+	}
+
+	payment, err := h.paymentService.CreateGroupPayment(uint(groupID), userID, req.PaymentMethod)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "PAYMENT_CREATED", payment)
+}
+
+// authorizeGroup memastikan reservasi grup termasuk properti yang dikelola admin
+func (h *GroupHandler) authorizeGroup(c *fiber.Ctx, groupID uint) (*models.BookingGroup, error) {
+	group, err := h.bookingService.GetGroupByID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProperty(c, group.PropertyID); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// GetAllGroups: Daftar reservasi grup (Admin)
+// Filter: ?property_id=1&status=confirmed
+func (h *GroupHandler) GetAllGroups(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "created_at desc"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.BookingGroupFilter{PropertyID: propertyID, PropertyIDs: propertyIDs, Status: c.Query("status")}
+	groups, err := h.bookingService.GetAllGroups(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUPS_FETCHED", fiber.Map{
+		"groups": groups,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// GetGroupByID: Detail reservasi grup beserta rooming list (Admin)
+func (h *GroupHandler) GetGroupByID(c *fiber.Ctx) error {
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	group, err := h.authorizeGroup(c, uint(groupID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUP_FETCHED", group)
+}
+
+// CancelGroup: Membatalkan reservasi grup (Admin)
+func (h *GroupHandler) CancelGroup(c *fiber.Ctx) error {
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	if _, err := h.authorizeGroup(c, uint(groupID)); err != nil {
+		return err
+	}
+
+	group, err := h.bookingService.CancelGroupByAdmin(uint(groupID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GROUP_CANCELLED", group)
+}
+
+// UpdateRoomingList: Front desk mengisi/mengubah rooming list (Admin)
+func (h *GroupHandler) UpdateRoomingList(c *fiber.Ctx) error {
+	groupID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GROUP_ID")
+	}
+
+	var input RoomingListInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if _, err := h.authorizeGroup(c, uint(groupID)); err != nil {
+		return err
+	}
+
+	group, err := h.bookingService.UpdateRoomingListByAdmin(uint(groupID), input.toEntries())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOMING_LIST_UPDATED", group)
+}
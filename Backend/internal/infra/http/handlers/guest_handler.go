@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type GuestHandler struct {
+	guestService services.GuestService
+}
+
+func NewGuestHandler(guestService services.GuestService) *GuestHandler {
+	return &GuestHandler{guestService: guestService}
+}
+
+// GetAllGuests: Daftar profil tamu (Staf)
+// Query: ?q=budi&vip=true (staf properti hanya melihat tamu yang pernah booking di propertinya)
+func (h *GuestHandler) GetAllGuests(c *fiber.Ctx) error {
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "full_name"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.GuestFilter{
+		Search:  c.Query("q"),
+		VIPOnly: c.QueryBool("vip"),
+	}
+	if scope := propertyScope(c); !scope.IsGlobal() {
+		filter.PropertyIDs = scope.PropertyIDs
+	}
+
+	guests, err := h.guestService.GetGuests(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUESTS_FETCHED", fiber.Map{
+		"guests": guests,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// GetGuestByID: Detail tamu beserta total menginap dan belanja (Staf)
+func (h *GuestHandler) GetGuestByID(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GUEST_ID")
+	}
+
+	guest, err := h.guestService.GetGuestByID(uint(guestID), propertyScope(c))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUEST_FETCHED", guest)
+}
+
+// GetGuestBookings: Riwayat menginap tamu (Staf)
+func (h *GuestHandler) GetGuestBookings(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GUEST_ID")
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "check_in_date desc"),
+		Offset: (page - 1) * limit,
+	}
+
+	bookings, err := h.guestService.GetGuestBookings(uint(guestID), propertyScope(c), pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "BOOKINGS_FETCHED", fiber.Map{
+		"bookings": bookings,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+type GuestInput struct {
+	FullName    string `json:"full_name" validate:"required,max=255"`
+	Email       string `json:"email" validate:"omitempty,email,max=255"`
+	Phone       string `json:"phone" validate:"max=20"`
+	IDNumber    string `json:"id_number" validate:"max=50"`
+	Preferences string `json:"preferences"`
+	IsVIP       bool   `json:"is_vip"`
+	Notes       string `json:"notes"`
+}
+
+// apply menyalin input ke profil tamu. Telepon/nomor identitas yang dikirim balik
+// dalam bentuk tersamar (sama seperti response GET) dianggap tidak diubah.
+func (input *GuestInput) apply(guest *models.Guest) {
+	guest.FullName = input.FullName
+	guest.Email = input.Email
+	if input.Phone != guest.Phone.Masked() {
+		guest.Phone = models.SensitiveString(input.Phone)
+	}
+	if input.IDNumber != guest.IDNumber.Masked() {
+		guest.IDNumber = models.SensitiveString(input.IDNumber)
+	}
+	guest.Preferences = input.Preferences
+	guest.IsVIP = input.IsVIP
+	guest.Notes = input.Notes
+}
+
+// UpdateGuest: Mengubah profil tamu, preferensi, status VIP, dan catatan (Staf)
+func (h *GuestHandler) UpdateGuest(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GUEST_ID")
+	}
+
+	guest, err := h.guestService.GetGuestByID(uint(guestID), propertyScope(c))
+	if err != nil {
+		return err
+	}
+
+	var input GuestInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+	input.apply(guest)
+
+	updatedGuest, err := h.guestService.UpdateGuest(guest)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUEST_UPDATED", updatedGuest)
+}
+
+type MergeGuestsInput struct {
+	GuestIDs []uint `json:"guest_ids" validate:"required,min=1,dive,gt=0"` // Profil duplikat yang digabung ke :id
+}
+
+// MergeGuests: Menggabungkan profil duplikat ke profil :id (Staf)
+func (h *GuestHandler) MergeGuests(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GUEST_ID")
+	}
+
+	var input MergeGuestsInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	guest, err := h.guestService.MergeGuests(uint(guestID), input.GuestIDs, propertyScope(c))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUESTS_MERGED", guest)
+}
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type HousekeepingHandler struct {
+	housekeepingService services.HousekeepingService
+	roomService         services.RoomService
+}
+
+func NewHousekeepingHandler(housekeepingService services.HousekeepingService, roomService services.RoomService) *HousekeepingHandler {
+	return &HousekeepingHandler{housekeepingService: housekeepingService, roomService: roomService}
+}
+
+// authorizeTask memastikan tugas termasuk properti yang dikelola staf
+func (h *HousekeepingHandler) authorizeTask(c *fiber.Ctx, taskID uint) error {
+	task, err := h.housekeepingService.GetTaskByID(taskID)
+	if err != nil {
+		return err
+	}
+	return authorizeProperty(c, task.PropertyID)
+}
+
+// canManage mengecek apakah staf boleh mengerjakan tugas milik staf lain
+func canManage(c *fiber.Ctx) bool {
+	role, _ := c.Locals("role").(string)
+	return models.HasPermission(role, models.PermHousekeepingManage)
+}
+
+// GetTasks: Daftar tugas housekeeping (Staf)
+// Query: ?date=YYYY-MM-DD&status=pending&assigned_to=5&mine=true&property_id=1
+func (h *HousekeepingHandler) GetTasks(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
+	date := c.Query("date")
+	if date != "" {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_TASK_DATE")
+		}
+	}
+
+	filter := &models.HousekeepingTaskFilter{
+		PropertyID:   propertyID,
+		PropertyIDs:  propertyIDs,
+		TaskDate:     date,
+		Status:       c.Query("status"),
+		AssignedToID: uint(c.QueryInt("assigned_to", 0)),
+	}
+	if c.QueryBool("mine") {
+		filter.AssignedToID = c.Locals("userID").(uint)
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 50)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "task_date desc, id"),
+		Offset: (page - 1) * limit,
+	}
+
+	tasks, err := h.housekeepingService.GetTasks(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASKS_FETCHED", fiber.Map{
+		"tasks": tasks,
+		"page":  page,
+		"limit": limit,
+	})
+}
+
+type GenerateTasksInput struct {
+	Date string `json:"date" validate:"omitempty,datetime=2006-01-02"` // Kosong = hari ini
+}
+
+// GenerateStayoverTasks: Membuat tugas stay-over untuk tanggal tertentu (Staf)
+// Juga dijalankan otomatis oleh scheduler harian; aman dipanggil berulang.
+func (h *HousekeepingHandler) GenerateStayoverTasks(c *fiber.Ctx) error {
+	var input GenerateTasksInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	date := time.Now()
+	if input.Date != "" {
+		date, _ = time.ParseInLocation("2006-01-02", input.Date, time.Local)
+	}
+
+	created, err := h.housekeepingService.GenerateStayoverTasks(date)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASKS_GENERATED", fiber.Map{
+		"date":    date.Format("2006-01-02"),
+		"created": created,
+	})
+}
+
+type AssignTaskInput struct {
+	UserID uint `json:"user_id" validate:"required"`
+}
+
+// AssignTask: Menugaskan tugas ke staf housekeeping (Staf)
+func (h *HousekeepingHandler) AssignTask(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_TASK_ID")
+	}
+
+	if err := h.authorizeTask(c, uint(taskID)); err != nil {
+		return err
+	}
+
+	var input AssignTaskInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	task, err := h.housekeepingService.AssignTask(uint(taskID), input.UserID)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASK_ASSIGNED", task)
+}
+
+// StartTask: Mulai membersihkan kamar (Staf Housekeeping)
+func (h *HousekeepingHandler) StartTask(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_TASK_ID")
+	}
+
+	if err := h.authorizeTask(c, uint(taskID)); err != nil {
+		return err
+	}
+
+	task, err := h.housekeepingService.StartTask(uint(taskID), c.Locals("userID").(uint), canManage(c))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASK_STARTED", task)
+}
+
+type CompleteTaskInput struct {
+	Notes string `json:"notes" validate:"max=1000"`
+}
+
+// CompleteTask: Selesai membersihkan kamar (Staf Housekeeping)
+func (h *HousekeepingHandler) CompleteTask(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_TASK_ID")
+	}
+
+	if err := h.authorizeTask(c, uint(taskID)); err != nil {
+		return err
+	}
+
+	var input CompleteTaskInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	task, err := h.housekeepingService.CompleteTask(uint(taskID), c.Locals("userID").(uint), canManage(c), input.Notes)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASK_COMPLETED", task)
+}
+
+type InspectTaskInput struct {
+	Passed *bool  `json:"passed" validate:"required"`
+	Notes  string `json:"notes" validate:"max=1000"`
+}
+
+// InspectTask: Inspeksi kamar yang sudah dibersihkan (Supervisor)
+func (h *HousekeepingHandler) InspectTask(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_TASK_ID")
+	}
+
+	if err := h.authorizeTask(c, uint(taskID)); err != nil {
+		return err
+	}
+
+	var input InspectTaskInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	task, err := h.housekeepingService.InspectTask(uint(taskID), c.Locals("userID").(uint), *input.Passed, input.Notes)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "HOUSEKEEPING_TASK_INSPECTED", task)
+}
+
+type SetHousekeepingStatusInput struct {
+	Status string `json:"status" validate:"required,oneof=dirty cleaning clean inspected"`
+}
+
+// SetRoomHousekeepingStatus: Koreksi manual status kebersihan kamar (Staf)
+func (h *HousekeepingHandler) SetRoomHousekeepingStatus(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+
+	room, err := h.roomService.GetRoomByID(uint(roomID))
+	if err != nil {
+		return err
+	}
+	if err := authorizeProperty(c, room.PropertyID); err != nil {
+		return err
+	}
+
+	var input SetHousekeepingStatusInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if _, err := h.housekeepingService.SetRoomStatus(uint(roomID), input.Status); err != nil {
+		return err
+	}
+
+	updatedRoom, err := h.roomService.GetRoomByID(uint(roomID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_HOUSEKEEPING_UPDATED", updatedRoom)
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/pkg/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type OIDCHandler struct {
+	oidcService services.OIDCService
+}
+
+func NewOIDCHandler(oidcService services.OIDCService) *OIDCHandler {
+	return &OIDCHandler{oidcService: oidcService}
+}
+
+// OIDCCallbackInput dikirim frontend setelah provider me-redirect kembali dengan code & state
+type OIDCCallbackInput struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required,max=64"`
+}
+
+// GetProviders: Daftar provider login eksternal yang aktif (Publik)
+func (h *OIDCHandler) GetProviders(c *fiber.Ctx) error {
+	return utils.RespondSuccess(c, fiber.StatusOK, "OIDC_PROVIDERS_FETCHED", h.oidcService.GetProviders())
+}
+
+// Authorize: Buat URL login provider (state + PKCE disimpan di server)
+func (h *OIDCHandler) Authorize(c *fiber.Ctx) error {
+	authorization, err := h.oidcService.BeginLogin(c.Params("provider"))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "OIDC_AUTHORIZATION_CREATED", authorization)
+}
+
+// Callback: Tukar code dari provider dengan token login (atau challenge 2FA)
+func (h *OIDCHandler) Callback(c *fiber.Ctx) error {
+	var input OIDCCallbackInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	result, err := h.oidcService.CompleteLogin(c.Params("provider"), input.Code, input.State, utils.Lang(c))
+	if err != nil {
+		return err
+	}
+
+	return respondLogin(c, result)
+}
+
+// GetIdentities: Identitas eksternal yang tertaut ke akun sendiri
+func (h *OIDCHandler) GetIdentities(c *fiber.Ctx) error {
+	identities, err := h.oidcService.GetIdentities(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "IDENTITIES_FETCHED", identities)
+}
+
+// BeginLink: Buat URL login provider untuk menautkan identitas ke akun sendiri
+func (h *OIDCHandler) BeginLink(c *fiber.Ctx) error {
+	authorization, err := h.oidcService.BeginLink(c.Locals("userID").(uint), c.Params("provider"))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "OIDC_AUTHORIZATION_CREATED", authorization)
+}
+
+// CompleteLink: Selesaikan penautan dengan code dari provider
+func (h *OIDCHandler) CompleteLink(c *fiber.Ctx) error {
+	var input OIDCCallbackInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	identity, err := h.oidcService.CompleteLink(c.Locals("userID").(uint), c.Params("provider"), input.Code, input.State)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "IDENTITY_LINKED", identity)
+}
+
+// UnlinkIdentity: Lepas identitas eksternal dari akun sendiri
+func (h *OIDCHandler) UnlinkIdentity(c *fiber.Ctx) error {
+	identityID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_IDENTITY_ID")
+	}
+
+	if err := h.oidcService.UnlinkIdentity(c.Locals("userID").(uint), uint(identityID)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "IDENTITY_UNLINKED", nil)
+}
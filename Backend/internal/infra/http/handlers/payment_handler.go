@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"backend/internal/app/services"
+	"backend/pkg/utils"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
@@ -17,45 +18,63 @@ func NewPaymentHandler(paymentService services.PaymentService) *PaymentHandler {
 
 func (h *PaymentHandler) CreatePayment(c *fiber.Ctx) error {
 	var req struct {
-		BookingID     uint   `json:"booking_id"`
-		PaymentMethod string `json:"payment_method"`
+		BookingID     uint   `json:"booking_id" validate:"required"`
+		PaymentMethod string `json:"payment_method" validate:"required"`
 	}
 
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	if err := utils.BindAndValidate(c, &req); err != nil {
+		return err
 	}
 
-	payment, err := h.paymentService.CreatePayment(req.BookingID, req.PaymentMethod)
+	userID := c.Locals("userID").(uint)
+	payment, err := h.paymentService.CreatePayment(req.BookingID, userID, req.PaymentMethod)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return err
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(payment)
+	return utils.RespondSuccess(c, fiber.StatusCreated, "PAYMENT_CREATED", payment)
 }
 
 func (h *PaymentHandler) ProcessPayment(c *fiber.Ctx) error {
 	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid payment ID"})
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PAYMENT_ID")
 	}
 
-	if err := h.paymentService.ProcessPayment(uint(id)); err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	userID := c.Locals("userID").(uint)
+	if err := h.paymentService.ProcessPayment(uint(id), userID); err != nil {
+		return err
 	}
 
-	return c.JSON(fiber.Map{"message": "Payment processed successfully"})
+	return utils.RespondSuccess(c, fiber.StatusOK, "PAYMENT_PROCESSED", nil)
+}
+
+// RefundPayment: Refund pembayaran yang sudah sukses (Staf dengan permission payment:refund)
+func (h *PaymentHandler) RefundPayment(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PAYMENT_ID")
+	}
+
+	payment, err := h.paymentService.RefundPayment(uint(id), propertyScope(c))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PAYMENT_REFUNDED", payment)
 }
 
 func (h *PaymentHandler) GetPaymentByBooking(c *fiber.Ctx) error {
 	bookingID, err := strconv.ParseUint(c.Params("booking_id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "Invalid booking ID"})
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
 	}
 
-	payment, err := h.paymentService.GetPaymentByBookingID(uint(bookingID))
+	userID := c.Locals("userID").(uint)
+	payment, err := h.paymentService.GetPaymentByBookingID(uint(bookingID), userID)
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "Payment not found"})
+		return err
 	}
 
-	return c.JSON(payment)
+	return utils.RespondSuccess(c, fiber.StatusOK, "PAYMENT_FETCHED", payment)
 }
@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PrivacyHandler struct {
+	privacyService services.PrivacyService
+}
+
+func NewPrivacyHandler(privacyService services.PrivacyService) *PrivacyHandler {
+	return &PrivacyHandler{privacyService: privacyService}
+}
+
+type RevealIdentityInput struct {
+	Reason string `json:"reason" validate:"required,min=5,max=255"` // Alasan membuka data, dicatat di log audit
+}
+
+// newPIIAccess menyiapkan catatan audit dari staf yang sedang login
+func newPIIAccess(c *fiber.Ctx, reason string) *models.PIIAccessLog {
+	return &models.PIIAccessLog{
+		UserID:    c.Locals("userID").(uint),
+		Reason:    reason,
+		IPAddress: c.IP(),
+	}
+}
+
+// RevealGuestIdentity: Telepon & nomor identitas lengkap dari profil tamu (Staf, tercatat di audit)
+func (h *PrivacyHandler) RevealGuestIdentity(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_GUEST_ID")
+	}
+
+	var input RevealIdentityInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	identity, err := h.privacyService.RevealGuestIdentity(uint(guestID), propertyScope(c), newPIIAccess(c, input.Reason))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUEST_IDENTITY_REVEALED", identity)
+}
+
+// RevealBookingIdentity: Telepon & nomor identitas lengkap yang diisi saat booking (Staf, tercatat di audit)
+func (h *PrivacyHandler) RevealBookingIdentity(c *fiber.Ctx) error {
+	bookingID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_BOOKING_ID")
+	}
+
+	var input RevealIdentityInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	identity, err := h.privacyService.RevealBookingIdentity(uint(bookingID), propertyScope(c), newPIIAccess(c, input.Reason))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "GUEST_IDENTITY_REVEALED", identity)
+}
+
+// GetPIIAccessLogs: Log siapa membuka data pribadi tamu (Admin Grup)
+// Query: ?user_id=3&guest_id=10&from=2025-01-01&to=2025-01-31
+func (h *PrivacyHandler) GetPIIAccessLogs(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	from, to, err := parseReportPeriod(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_REPORT_DATE")
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 50)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "created_at desc"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.PIIAccessFilter{
+		UserID:  uint(c.QueryInt("user_id", 0)),
+		GuestID: uint(c.QueryInt("guest_id", 0)),
+		From:    from,
+		To:      to,
+	}
+
+	logs, err := h.privacyService.GetPIIAccessLogs(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PII_ACCESS_LOGS_FETCHED", fiber.Map{
+		"logs":  logs,
+		"page":  page,
+		"limit": limit,
+	})
+}
+
+type ErasureRequestInput struct {
+	Reason string `json:"reason" validate:"max=1000"` // Opsional
+}
+
+type ApproveErasureInput struct {
+	Note string `json:"note" validate:"max=255"` // Opsional
+}
+
+type RejectErasureInput struct {
+	Note string `json:"note" validate:"required,min=5,max=255"` // Alasan penolakan, ditampilkan ke member
+}
+
+// ExportMyData: Unduh arsip zip seluruh data pribadi (Member)
+func (h *PrivacyHandler) ExportMyData(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	archive, err := h.privacyService.ExportUserData(userID)
+	if err != nil {
+		return err
+	}
+
+	c.Attachment(fmt.Sprintf("data-pribadi-%d-%s.zip", userID, time.Now().Format("20060102")))
+	c.Set(fiber.HeaderContentType, "application/zip")
+	return c.Send(archive)
+}
+
+// RequestErasure: Ajukan penghapusan akun & data pribadi (Member, menunggu persetujuan admin)
+func (h *PrivacyHandler) RequestErasure(c *fiber.Ctx) error {
+	var input ErasureRequestInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	request, err := h.privacyService.RequestErasure(c.Locals("userID").(uint), input.Reason)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "ERASURE_REQUESTED", request)
+}
+
+// GetMyPrivacyRequests: Riwayat permintaan ekspor & penghapusan data (Member)
+func (h *PrivacyHandler) GetMyPrivacyRequests(c *fiber.Ctx) error {
+	requests, err := h.privacyService.GetUserPrivacyRequests(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PRIVACY_REQUESTS_FETCHED", requests)
+}
+
+// GetPrivacyRequests: Daftar permintaan data pribadi semua member (Admin Grup)
+// Query: ?type=erasure&status=pending&user_id=5
+func (h *PrivacyHandler) GetPrivacyRequests(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "created_at desc"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.PrivacyRequestFilter{
+		Type:   c.Query("type"),
+		Status: c.Query("status"),
+		UserID: uint(c.QueryInt("user_id", 0)),
+	}
+
+	requests, err := h.privacyService.GetPrivacyRequests(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PRIVACY_REQUESTS_FETCHED", fiber.Map{
+		"requests": requests,
+		"page":     page,
+		"limit":    limit,
+	})
+}
+
+// ApproveErasure: Setujui penghapusan, data member langsung dianonimkan (Admin Grup)
+func (h *PrivacyHandler) ApproveErasure(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	requestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PRIVACY_REQUEST_ID")
+	}
+
+	var input ApproveErasureInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	request, err := h.privacyService.ApproveErasure(uint(requestID), c.Locals("userID").(uint), input.Note)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ERASURE_APPROVED", request)
+}
+
+// RejectErasure: Tolak penghapusan dengan alasan (Admin Grup)
+func (h *PrivacyHandler) RejectErasure(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	requestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PRIVACY_REQUEST_ID")
+	}
+
+	var input RejectErasureInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	request, err := h.privacyService.RejectErasure(uint(requestID), c.Locals("userID").(uint), input.Note)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ERASURE_REJECTED", request)
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PropertyHandler struct {
+	propertyService services.PropertyService
+}
+
+func NewPropertyHandler(propertyService services.PropertyService) *PropertyHandler {
+	return &PropertyHandler{propertyService: propertyService}
+}
+
+// GetAllProperties: Mengambil daftar properti (Public; admin hanya melihat properti yang dikelola)
+func (h *PropertyHandler) GetAllProperties(c *fiber.Ctx) error {
+	properties, err := h.propertyService.GetAllProperties(propertyScope(c))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROPERTIES_FETCHED", properties)
+}
+
+// GetPropertyByID: Mengambil detail properti (Public)
+func (h *PropertyHandler) GetPropertyByID(c *fiber.Ctx) error {
+	propertyID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+
+	property, err := h.propertyService.GetPropertyByID(uint(propertyID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROPERTY_FETCHED", property)
+}
+
+type PropertyInput struct {
+	Code         string `json:"code" validate:"required,max=20"`
+	Name         string `json:"name" validate:"required,max=100"`
+	Address      string `json:"address"`
+	City         string `json:"city" validate:"max=100"`
+	Country      string `json:"country" validate:"max=100"`
+	Phone        string `json:"phone" validate:"max=20"`
+	Timezone     string `json:"timezone" validate:"required,max=64"`          // Contoh "Asia/Jakarta"
+	Currency     string `json:"currency" validate:"required,len=3,uppercase"` // Contoh "IDR"
+	CheckInTime  string `json:"check_in_time" validate:"required,datetime=15:04"`
+	CheckOutTime string `json:"check_out_time" validate:"required,datetime=15:04"`
+}
+
+// apply menyalin input ke model properti
+func (input *PropertyInput) apply(property *models.Property) {
+	property.Code = input.Code
+	property.Name = input.Name
+	property.Address = input.Address
+	property.City = input.City
+	property.Country = input.Country
+	property.Phone = input.Phone
+	property.Timezone = input.Timezone
+	property.Currency = input.Currency
+	property.CheckInTime = input.CheckInTime
+	property.CheckOutTime = input.CheckOutTime
+}
+
+// CreateProperty: Menambah properti (Admin Grup Only)
+func (h *PropertyHandler) CreateProperty(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	var input PropertyInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	property := &models.Property{}
+	input.apply(property)
+
+	createdProperty, err := h.propertyService.CreateProperty(property)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "PROPERTY_CREATED", createdProperty)
+}
+
+// UpdateProperty: Mengubah data properti (Admin properti tersebut)
+func (h *PropertyHandler) UpdateProperty(c *fiber.Ctx) error {
+	propertyID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+
+	if err := authorizeProperty(c, uint(propertyID)); err != nil {
+		return err
+	}
+
+	property, err := h.propertyService.GetPropertyByID(uint(propertyID))
+	if err != nil {
+		return err
+	}
+
+	var input PropertyInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+	input.apply(property)
+
+	updatedProperty, err := h.propertyService.UpdateProperty(property)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROPERTY_UPDATED", updatedProperty)
+}
+
+// DeleteProperty: Menghapus properti tanpa kamar (Admin Grup Only)
+func (h *PropertyHandler) DeleteProperty(c *fiber.Ctx) error {
+	propertyID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	if err := h.propertyService.DeleteProperty(uint(propertyID)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROPERTY_DELETED", nil)
+}
+
+// GetUserProperties: Mengambil properti yang ditugaskan ke admin (Admin Grup Only)
+func (h *PropertyHandler) GetUserProperties(c *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
+	}
+
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	properties, err := h.propertyService.GetUserProperties(uint(userID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_PROPERTIES_FETCHED", properties)
+}
+
+type SetUserPropertiesInput struct {
+	PropertyIDs []uint `json:"property_ids" validate:"dive,gt=0"` // Array kosong = akses properti dicabut
+	GroupAdmin  bool   `json:"group_admin"`                       // Akses semua properti (hanya role admin)
+}
+
+// SetUserProperties: Mengganti properti yang dikelola staf atau menjadikan admin sebagai admin grup (Admin Grup Only)
+func (h *PropertyHandler) SetUserProperties(c *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
+	}
+
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	var input SetUserPropertiesInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	properties, err := h.propertyService.SetUserProperties(uint(userID), input.PropertyIDs, input.GroupAdmin)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_PROPERTIES_UPDATED", properties)
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"backend/internal/domain/models"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// propertyScope mengambil scope properti dari PropertyScopeMiddleware.
+// Route publik tidak memiliki scope sehingga dianggap global.
+func propertyScope(c *fiber.Ctx) *models.PropertyScope {
+	scope, _ := c.Locals("propertyScope").(*models.PropertyScope)
+	return scope
+}
+
+// authorizeProperty menolak akses ke properti di luar scope admin
+func authorizeProperty(c *fiber.Ctx, propertyID uint) error {
+	if !propertyScope(c).Allows(propertyID) {
+		return models.ErrPropertyForbidden
+	}
+	return nil
+}
+
+// requireGlobalScope hanya mengizinkan admin grup (User.IsGroupAdmin)
+func requireGlobalScope(c *fiber.Ctx) error {
+	if !propertyScope(c).IsGlobal() {
+		return models.ErrPropertyForbidden
+	}
+	return nil
+}
+
+// parsePropertyQuery membaca ?property_id (kosong = 0, semua properti)
+func parsePropertyQuery(c *fiber.Ctx) (uint, error) {
+	value := c.Query("property_id")
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	return uint(id), err
+}
+
+// scopedPropertyIDs memvalidasi filter property_id terhadap scope admin dan
+// mengembalikan daftar properti yang diizinkan (nil = semua properti)
+func scopedPropertyIDs(c *fiber.Ctx, propertyID uint) ([]uint, error) {
+	if propertyID != 0 {
+		if err := authorizeProperty(c, propertyID); err != nil {
+			return nil, err
+		}
+	}
+	scope := propertyScope(c)
+	if scope.IsGlobal() {
+		return nil, nil
+	}
+	return scope.PropertyIDs, nil
+}
+
+// defaultPropertyID menentukan properti untuk data baru jika property_id tidak diisi:
+// admin yang hanya memegang satu properti otomatis memakai properti tersebut,
+// admin grup memakai properti default (0), admin multi-properti wajib memilih.
+func defaultPropertyID(c *fiber.Ctx, propertyID uint) (uint, error) {
+	if propertyID != 0 {
+		return propertyID, authorizeProperty(c, propertyID)
+	}
+
+	scope := propertyScope(c)
+	switch {
+	case scope.IsGlobal():
+		return 0, nil
+	case len(scope.PropertyIDs) == 1:
+		return scope.PropertyIDs[0], nil
+	default:
+		return 0, models.ErrPropertyRequired
+	}
+}
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReportHandler struct {
+	reportService services.ReportService
+}
+
+func NewReportHandler(reportService services.ReportService) *ReportHandler {
+	return &ReportHandler{reportService: reportService}
+}
+
+// parseReportPeriod membaca ?from=YYYY-MM-DD&to=YYYY-MM-DD (keduanya inklusif).
+// Default: bulan berjalan.
+func parseReportPeriod(c *fiber.Ctx) (time.Time, time.Time, error) {
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 1, 0)
+
+	if value := c.Query("from"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return from, to, err
+		}
+		from = parsed
+	}
+	if value := c.Query("to"); value != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", value, time.Local)
+		if err != nil {
+			return from, to, err
+		}
+		to = parsed.AddDate(0, 0, 1)
+	}
+	return from, to, nil
+}
+
+// GetPropertyReport: Ringkasan booking dan pendapatan per properti (Admin Only)
+// Query: ?property_id=1&from=2025-01-01&to=2025-01-31
+func (h *ReportHandler) GetPropertyReport(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	from, to, err := parseReportPeriod(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_REPORT_DATE")
+	}
+
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
+	reports, err := h.reportService.GetPropertySummary(&models.ReportFilter{
+		PropertyID:  propertyID,
+		PropertyIDs: propertyIDs,
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "REPORT_FETCHED", fiber.Map{
+		"properties": reports,
+		"from":       from.Format("2006-01-02"),
+		"to":         to.AddDate(0, 0, -1).Format("2006-01-02"),
+	})
+}
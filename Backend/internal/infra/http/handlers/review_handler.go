@@ -4,7 +4,6 @@ import (
 	"backend/internal/app/services"
 	"backend/internal/domain/models"
 	"backend/pkg/utils"
-	"errors"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
@@ -29,8 +28,8 @@ func (h *ReviewHandler) CreateReview(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(uint)
 
 	var input CreateReviewInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
 	review := &models.Review{
@@ -42,20 +41,17 @@ func (h *ReviewHandler) CreateReview(c *fiber.Ctx) error {
 
 	createdReview, err := h.reviewService.CreateReview(review)
 	if err != nil {
-		if errors.Is(err, errors.New("anda sudah memberikan ulasan untuk pemesanan ini")) {
-			return utils.RespondError(c, fiber.StatusConflict, "Anda sudah memberikan ulasan untuk pemesanan ini")
-		}
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusCreated, "Ulasan berhasil dibuat", createdReview)
+	return utils.RespondSuccess(c, fiber.StatusCreated, "REVIEW_CREATED", createdReview)
 }
 
 // GetRoomReviews: Mengambil semua review kamar (Public)
 func (h *ReviewHandler) GetRoomReviews(c *fiber.Ctx) error {
 	roomID, err := strconv.ParseUint(c.Params("roomId"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID kamar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
 	}
 
 	page := c.QueryInt("page", 1)
@@ -70,10 +66,10 @@ func (h *ReviewHandler) GetRoomReviews(c *fiber.Ctx) error {
 
 	reviews, err := h.reviewService.GetRoomReviews(uint(roomID), pagination)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil data ulasan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data ulasan", fiber.Map{
+	return utils.RespondSuccess(c, fiber.StatusOK, "REVIEWS_FETCHED", fiber.Map{
 		"reviews": reviews,
 		"page":    page,
 		"limit":   limit,
@@ -84,45 +80,39 @@ func (h *ReviewHandler) GetRoomReviews(c *fiber.Ctx) error {
 func (h *ReviewHandler) GetReviewByID(c *fiber.Ctx) error {
 	reviewID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID ulasan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_REVIEW_ID")
 	}
 
 	review, err := h.reviewService.GetReviewByID(uint(reviewID))
 	if err != nil {
-		if errors.Is(err, errors.New("ulasan tidak ditemukan")) {
-			return utils.RespondError(c, fiber.StatusNotFound, "Ulasan tidak ditemukan")
-		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil data ulasan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data ulasan", review)
+	return utils.RespondSuccess(c, fiber.StatusOK, "REVIEW_FETCHED", review)
 }
 
 // DeleteReview: Menghapus review (Admin Only)
 func (h *ReviewHandler) DeleteReview(c *fiber.Ctx) error {
 	reviewID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID ulasan tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_REVIEW_ID")
 	}
 
 	if err := h.reviewService.DeleteReview(uint(reviewID)); err != nil {
-		if errors.Is(err, errors.New("ulasan tidak ditemukan")) {
-			return utils.RespondError(c, fiber.StatusNotFound, "Ulasan tidak ditemukan")
-		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal menghapus ulasan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Ulasan berhasil dihapus", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "REVIEW_DELETED", nil)
 }
 
 // GetAllReviews: Mengambil semua review (Public)
 func (h *ReviewHandler) GetAllReviews(c *fiber.Ctx) error {
 	reviews, err := h.reviewService.GetAllReviews()
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil ulasan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil ulasan", fiber.Map{"reviews": reviews})
+	return utils.RespondSuccess(c, fiber.StatusOK, "REVIEWS_FETCHED", fiber.Map{"reviews": reviews})
 }
 
 // GetMyReviews: Mengambil review user sendiri (Member Only)
@@ -131,8 +121,8 @@ func (h *ReviewHandler) GetMyReviews(c *fiber.Ctx) error {
 
 	reviews, err := h.reviewService.GetUserReviews(userID)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil ulasan")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil ulasan", fiber.Map{"reviews": reviews})
+	return utils.RespondSuccess(c, fiber.StatusOK, "REVIEWS_FETCHED", fiber.Map{"reviews": reviews})
 }
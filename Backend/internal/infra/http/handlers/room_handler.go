@@ -4,11 +4,11 @@ import (
 	"backend/internal/app/services"
 	"backend/internal/domain/models"
 	"backend/pkg/utils"
-	"errors"
 	"fmt"
+	"log"
+	"mime/multipart"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -21,8 +21,51 @@ func NewRoomHandler(roomService services.RoomService) *RoomHandler {
 	return &RoomHandler{roomService: roomService}
 }
 
-// GetAllRooms: Mengambil semua kamar (Public)
+// parseIDList mengubah "1,2,3" menjadi []uint (string kosong = nil)
+func parseIDList(value string) ([]uint, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	var ids []uint
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("ID tidak valid: %q", part)
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids, nil
+}
+
+// authorizeRoom memastikan kamar termasuk properti yang dikelola admin
+func (h *RoomHandler) authorizeRoom(c *fiber.Ctx, roomID uint) (*models.Room, error) {
+	room, err := h.roomService.GetRoomByID(roomID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeProperty(c, room.PropertyID); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+// GetAllRooms: Mengambil semua kamar (Public & Admin)
+// Filter fasilitas: ?amenities=1,2 (kamar harus punya semua amenity tersebut)
+// Filter properti: ?property_id=1 (admin hanya melihat kamar di properti yang dikelola)
 func (h *RoomHandler) GetAllRooms(c *fiber.Ctx) error {
+	amenityIDs, err := parseIDList(c.Query("amenities"))
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_AMENITY_ID")
+	}
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
 	page := c.QueryInt("page", 1)
 	limit := c.QueryInt("limit", 10)
 
@@ -33,12 +76,13 @@ func (h *RoomHandler) GetAllRooms(c *fiber.Ctx) error {
 		Offset: (page - 1) * limit,
 	}
 
-	rooms, err := h.roomService.GetAllRooms(pagination)
+	filter := &models.RoomFilter{AmenityIDs: amenityIDs, PropertyID: propertyID, PropertyIDs: propertyIDs}
+	rooms, err := h.roomService.GetAllRooms(filter, pagination)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil data kamar")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data kamar", fiber.Map{
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOMS_FETCHED", fiber.Map{
 		"rooms": rooms,
 		"page":  page,
 		"limit": limit,
@@ -49,30 +93,31 @@ func (h *RoomHandler) GetAllRooms(c *fiber.Ctx) error {
 func (h *RoomHandler) GetRoomByID(c *fiber.Ctx) error {
 	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID kamar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
 	}
 
 	room, err := h.roomService.GetRoomByID(uint(roomID))
 	if err != nil {
-		if errors.Is(err, errors.New("kamar tidak ditemukan")) {
-			return utils.RespondError(c, fiber.StatusNotFound, "Kamar tidak ditemukan")
-		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil data kamar")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil data kamar", room)
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_FETCHED", room)
 }
 
 type GetAvailableRoomsInput struct {
-	CheckInDate  string `json:"check_in_date" validate:"required"`
-	CheckOutDate string `json:"check_out_date" validate:"required"`
+	CheckInDate  string `json:"check_in_date" validate:"required,datetime=2006-01-02"`
+	CheckOutDate string `json:"check_out_date" validate:"required,datetime=2006-01-02"`
+	AmenityIDs   []uint `json:"amenity_ids" validate:"omitempty,dive,gt=0"` // Kamar harus punya semua amenity ini
+	PropertyID   uint   `json:"property_id"`                                // 0 = semua properti
+	Adults       int    `json:"adults" validate:"omitempty,min=1"`          // Kamar yang muat susunan tamu ini (0 = tidak difilter)
+	Children     int    `json:"children" validate:"omitempty,min=0"`
 }
 
 // GetAvailableRooms: Mengambil kamar yang tersedia (Public)
 func (h *RoomHandler) GetAvailableRooms(c *fiber.Ctx) error {
 	var input GetAvailableRoomsInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
 	page := c.QueryInt("page", 1)
@@ -85,12 +130,17 @@ func (h *RoomHandler) GetAvailableRooms(c *fiber.Ctx) error {
 		Offset: (page - 1) * limit,
 	}
 
-	rooms, err := h.roomService.GetAvailableRooms(input.CheckInDate, input.CheckOutDate, pagination)
+	rooms, err := h.roomService.GetAvailableRooms(input.CheckInDate, input.CheckOutDate, &models.RoomFilter{
+		AmenityIDs: input.AmenityIDs,
+		PropertyID: input.PropertyID,
+		Adults:     input.Adults,
+		Children:   input.Children,
+	}, pagination)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengambil kamar tersedia")
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Berhasil mengambil kamar tersedia", fiber.Map{
+	return utils.RespondSuccess(c, fiber.StatusOK, "AVAILABLE_ROOMS_FETCHED", fiber.Map{
 		"rooms": rooms,
 		"page":  page,
 		"limit": limit,
@@ -98,225 +148,428 @@ func (h *RoomHandler) GetAvailableRooms(c *fiber.Ctx) error {
 }
 
 type CreateRoomInput struct {
-	RoomNumber   string  `json:"room_number" validate:"required"`
-	Type         string  `json:"type" validate:"required"`
-	Price        float64 `json:"price" validate:"required"`
-	Description  string  `json:"description"`
-	MaxOccupancy int     `json:"max_occupancy" validate:"required"`
-	ImageURL     string  `json:"image_url"`
+	PropertyID   uint    `json:"property_id" form:"property_id"` // 0 = properti admin / properti default
+	RoomNumber   string  `json:"room_number" form:"room_number" validate:"required,max=10"`
+	Type         string  `json:"type" form:"type" validate:"required,max=50"`
+	Price        float64 `json:"price" form:"price" validate:"required,gt=0"`
+	Description  string  `json:"description" form:"description"`
+	MaxOccupancy int     `json:"max_occupancy" form:"max_occupancy" validate:"required,min=1"`
+	ImageURL     string  `json:"image_url" form:"image_url"`
+
+	// Aturan okupansi & biaya tamu tambahan (opsional)
+	MaxAdults       int     `json:"max_adults" form:"max_adults" validate:"omitempty,min=1,ltefield=MaxOccupancy"`
+	BaseOccupancy   int     `json:"base_occupancy" form:"base_occupancy" validate:"omitempty,min=1,ltefield=MaxOccupancy"`
+	ExtraAdultPrice float64 `json:"extra_adult_price" form:"extra_adult_price" validate:"omitempty,gte=0"`
+	ExtraChildPrice float64 `json:"extra_child_price" form:"extra_child_price" validate:"omitempty,gte=0"`
+	MaxExtraBeds    int     `json:"max_extra_beds" form:"max_extra_beds" validate:"omitempty,gte=0"`
+	ExtraBedPrice   float64 `json:"extra_bed_price" form:"extra_bed_price" validate:"omitempty,gte=0"`
 }
 
 // CreateRoom: Membuat kamar baru (Admin Only)
 func (h *RoomHandler) CreateRoom(c *fiber.Ctx) error {
 	// Parse multipart form
-	roomNumber := c.FormValue("room_number")
-	roomType := c.FormValue("type")
-	priceStr := c.FormValue("price")
-	description := c.FormValue("description")
-	maxOccupancyStr := c.FormValue("max_occupancy")
-
-	fmt.Printf("DEBUG CreateRoom - room_number: %s, type: %s, price: %s, max_occupancy: %s\n", 
-		roomNumber, roomType, priceStr, maxOccupancyStr)
-
-	if roomNumber == "" || roomType == "" || priceStr == "" || maxOccupancyStr == "" {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Required fields missing")
-	}
-
-	price, err := strconv.ParseFloat(priceStr, 64)
-	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid price format")
+	var input CreateRoomInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
-	maxOccupancy, err := strconv.Atoi(maxOccupancyStr)
+	propertyID, err := defaultPropertyID(c, input.PropertyID)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid max occupancy format")
+		return err
 	}
 
 	room := &models.Room{
-		RoomNumber:   roomNumber,
-		Type:         roomType,
-		Price:        price,
-		Description:  description,
-		MaxOccupancy: maxOccupancy,
+		PropertyID:   propertyID,
+		RoomNumber:   input.RoomNumber,
+		Type:         input.Type,
+		Price:        input.Price,
+		Description:  input.Description,
+		MaxOccupancy: input.MaxOccupancy,
 		Status:       "available",
+
+		MaxAdults:       input.MaxAdults,
+		BaseOccupancy:   input.BaseOccupancy,
+		ExtraAdultPrice: input.ExtraAdultPrice,
+		ExtraChildPrice: input.ExtraChildPrice,
+		MaxExtraBeds:    input.MaxExtraBeds,
+		ExtraBedPrice:   input.ExtraBedPrice,
 	}
 
 	createdRoom, err := h.roomService.CreateRoom(room)
 	if err != nil {
-		fmt.Printf("DEBUG CreateRoom error: %v\n", err)
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
-	}
-
-	// Handle file upload
-	file, err := c.FormFile("image")
-	if err == nil && file != nil {
-		// Generate unique filename without spaces
-		timestamp := time.Now().Unix()
-		sanitizedName := strings.ReplaceAll(file.Filename, " ", "_")
-		filename := fmt.Sprintf("%d_%d_%s", createdRoom.ID, timestamp, sanitizedName)
-		savePath := fmt.Sprintf("./uploads/rooms/%s", filename)
-		
-		if err := c.SaveFile(file, savePath); err == nil {
-			imageURL := fmt.Sprintf("/uploads/rooms/%s", filename)
-			image := &models.RoomImage{
-				RoomID:    createdRoom.ID,
-				ImageURL:  imageURL,
-				IsPrimary: true,
-			}
-			h.roomService.AddRoomImage(image)
-		}
+		return err
+	}
+
+	// Handle file upload (gambar pertama otomatis menjadi primary)
+	if err := h.uploadFormImages(c, createdRoom.ID); err != nil {
+		log.Printf("Gagal upload gambar kamar %d: %v", createdRoom.ID, err)
 	}
 
 	// Reload room with images
 	finalRoom, _ := h.roomService.GetRoomByID(createdRoom.ID)
 
-	return utils.RespondSuccess(c, fiber.StatusCreated, "Kamar berhasil dibuat", finalRoom)
+	return utils.RespondSuccess(c, fiber.StatusCreated, "ROOM_CREATED", finalRoom)
 }
 
 type UpdateRoomInput struct {
-	RoomNumber   string  `json:"room_number"`
-	Type         string  `json:"type"`
-	Price        float64 `json:"price"`
-	Description  string  `json:"description"`
-	Status       string  `json:"status"`
-	MaxOccupancy int     `json:"max_occupancy"`
+	PropertyID   uint    `json:"property_id" form:"property_id"` // Pindah properti (0 = tidak diubah)
+	RoomNumber   string  `json:"room_number" form:"room_number" validate:"omitempty,max=10"`
+	Type         string  `json:"type" form:"type" validate:"omitempty,max=50"`
+	Price        float64 `json:"price" form:"price" validate:"omitempty,gt=0"`
+	Description  string  `json:"description" form:"description"`
+	Status       string  `json:"status" form:"status" validate:"omitempty,oneof=available booked maintenance"`
+	MaxOccupancy int     `json:"max_occupancy" form:"max_occupancy" validate:"omitempty,min=1"`
+
+	// Aturan okupansi (nil = tidak diubah; 0 pada max_adults/base_occupancy = sama dengan max_occupancy)
+	MaxAdults       *int     `json:"max_adults" form:"max_adults" validate:"omitempty,gte=0"`
+	BaseOccupancy   *int     `json:"base_occupancy" form:"base_occupancy" validate:"omitempty,gte=0"`
+	ExtraAdultPrice *float64 `json:"extra_adult_price" form:"extra_adult_price" validate:"omitempty,gte=0"`
+	ExtraChildPrice *float64 `json:"extra_child_price" form:"extra_child_price" validate:"omitempty,gte=0"`
+	MaxExtraBeds    *int     `json:"max_extra_beds" form:"max_extra_beds" validate:"omitempty,gte=0"`
+	ExtraBedPrice   *float64 `json:"extra_bed_price" form:"extra_bed_price" validate:"omitempty,gte=0"`
 }
 
 // UpdateRoom: Mengubah data kamar (Admin Only)
 func (h *RoomHandler) UpdateRoom(c *fiber.Ctx) error {
 	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID kamar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
 	}
 
 	// Ambil room yang ada terlebih dahulu
-	existingRoom, err := h.roomService.GetRoomByID(uint(roomID))
+	existingRoom, err := h.authorizeRoom(c, uint(roomID))
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusNotFound, "Kamar tidak ditemukan")
+		return err
 	}
 
 	// Parse multipart form
-	roomNumber := c.FormValue("room_number")
-	roomType := c.FormValue("type")
-	priceStr := c.FormValue("price")
-	description := c.FormValue("description")
-	maxOccupancyStr := c.FormValue("max_occupancy")
+	var input UpdateRoomInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
 
 	// Update field yang diberikan
-	if roomNumber != "" {
-		existingRoom.RoomNumber = roomNumber
+	if input.PropertyID != 0 {
+		if err := authorizeProperty(c, input.PropertyID); err != nil {
+			return err
+		}
+		existingRoom.PropertyID = input.PropertyID
 	}
-	if roomType != "" {
-		existingRoom.Type = roomType
+	if input.RoomNumber != "" {
+		existingRoom.RoomNumber = input.RoomNumber
 	}
-	if priceStr != "" {
-		price, err := strconv.ParseFloat(priceStr, 64)
-		if err == nil && price > 0 {
-			existingRoom.Price = price
-		}
+	if input.Type != "" {
+		existingRoom.Type = input.Type
 	}
-	if description != "" {
-		existingRoom.Description = description
+	if input.Price > 0 {
+		existingRoom.Price = input.Price
 	}
-	if maxOccupancyStr != "" {
-		maxOccupancy, err := strconv.Atoi(maxOccupancyStr)
-		if err == nil && maxOccupancy > 0 {
-			existingRoom.MaxOccupancy = maxOccupancy
-		}
+	if input.Description != "" {
+		existingRoom.Description = input.Description
+	}
+	if input.Status != "" {
+		existingRoom.Status = input.Status
+	}
+	if input.MaxOccupancy > 0 {
+		existingRoom.MaxOccupancy = input.MaxOccupancy
+	}
+	if input.MaxAdults != nil {
+		existingRoom.MaxAdults = *input.MaxAdults
+	}
+	if input.BaseOccupancy != nil {
+		existingRoom.BaseOccupancy = *input.BaseOccupancy
+	}
+	if input.ExtraAdultPrice != nil {
+		existingRoom.ExtraAdultPrice = *input.ExtraAdultPrice
+	}
+	if input.ExtraChildPrice != nil {
+		existingRoom.ExtraChildPrice = *input.ExtraChildPrice
+	}
+	if input.MaxExtraBeds != nil {
+		existingRoom.MaxExtraBeds = *input.MaxExtraBeds
+	}
+	if input.ExtraBedPrice != nil {
+		existingRoom.ExtraBedPrice = *input.ExtraBedPrice
 	}
 
 	updatedRoom, err := h.roomService.UpdateRoom(existingRoom)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal mengubah kamar")
-	}
-
-	// Handle file upload
-	file, err := c.FormFile("image")
-	if err == nil && file != nil {
-		// Generate unique filename without spaces
-		timestamp := time.Now().Unix()
-		sanitizedName := strings.ReplaceAll(file.Filename, " ", "_")
-		filename := fmt.Sprintf("%d_%d_%s", updatedRoom.ID, timestamp, sanitizedName)
-		savePath := fmt.Sprintf("./uploads/rooms/%s", filename)
-		
-		if err := c.SaveFile(file, savePath); err == nil {
-			imageURL := fmt.Sprintf("/uploads/rooms/%s", filename)
-			image := &models.RoomImage{
-				RoomID:    updatedRoom.ID,
-				ImageURL:  imageURL,
-				IsPrimary: true,
-			}
-			h.roomService.AddRoomImage(image)
-		}
+		return err
+	}
+
+	// Handle file upload (ditambahkan ke akhir galeri, primary tidak berubah)
+	if err := h.uploadFormImages(c, updatedRoom.ID); err != nil {
+		log.Printf("Gagal upload gambar kamar %d: %v", updatedRoom.ID, err)
 	}
 
 	// Reload room with images
 	finalRoom, _ := h.roomService.GetRoomByID(uint(roomID))
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Kamar berhasil diubah", finalRoom)
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_UPDATED", finalRoom)
 }
 
 // DeleteRoom: Menghapus kamar (Admin Only)
 func (h *RoomHandler) DeleteRoom(c *fiber.Ctx) error {
 	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID kamar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
 	}
 
 	if err := h.roomService.DeleteRoom(uint(roomID)); err != nil {
-		if errors.Is(err, errors.New("kamar tidak ditemukan")) {
-			return utils.RespondError(c, fiber.StatusNotFound, "Kamar tidak ditemukan")
-		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal menghapus kamar")
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_DELETED", nil)
+}
+
+type UpdateRoomStatusInput struct {
+	Status string `json:"status" validate:"required,oneof=available booked maintenance"`
+}
+
+// UpdateRoomStatus: Mengubah status kamar (Staf dengan permission room:update_status)
+func (h *RoomHandler) UpdateRoomStatus(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	var input UpdateRoomStatusInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Kamar berhasil dihapus", nil)
+	room, err := h.roomService.UpdateRoomStatus(uint(roomID), input.Status)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_STATUS_UPDATED", room)
+}
+
+type SetRoomAmenitiesInput struct {
+	AmenityIDs []uint `json:"amenity_ids" validate:"dive,gt=0"` // Array kosong = hapus semua fasilitas
+}
+
+// SetRoomAmenities: Mengganti seluruh fasilitas kamar (Admin Only)
+func (h *RoomHandler) SetRoomAmenities(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	var input SetRoomAmenitiesInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	room, err := h.roomService.SetRoomAmenities(uint(roomID), input.AmenityIDs)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_AMENITIES_UPDATED", room)
 }
 
 type AddRoomImageInput struct {
-	ImageURL  string `json:"image_url" validate:"required"`
-	IsPrimary bool   `json:"is_primary"`
+	ImageURL  string `json:"image_url" form:"image_url" validate:"required"`
+	AltText   string `json:"alt_text" form:"alt_text" validate:"max=255"`
+	Caption   string `json:"caption" form:"caption" validate:"max=255"`
+	IsPrimary bool   `json:"is_primary" form:"is_primary"`
+}
+
+// formImageFiles mengambil file dari field multipart "images" (banyak file) dan "image" (satu file)
+func formImageFiles(c *fiber.Ctx) (*multipart.Form, []*multipart.FileHeader) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, nil
+	}
+	return form, append(form.File["images"], form.File["image"]...)
+}
+
+// uploadImages meneruskan file multipart ke RoomService (disimpan lewat FileStorage).
+// alt_text dan caption dicocokkan dengan file berdasarkan urutan; is_primary berlaku untuk file pertama.
+func (h *RoomHandler) uploadImages(roomID uint, form *multipart.Form, files []*multipart.FileHeader) ([]models.RoomImage, error) {
+	formValue := func(key string, i int) string {
+		if values := form.Value[key]; i < len(values) {
+			return values[i]
+		}
+		return ""
+	}
+
+	uploads := make([]services.RoomImageUpload, 0, len(files))
+	for i, file := range files {
+		src, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer src.Close()
+
+		uploads = append(uploads, services.RoomImageUpload{
+			Filename:  file.Filename,
+			File:      src,
+			Size:      file.Size,
+			AltText:   formValue("alt_text", i),
+			Caption:   formValue("caption", i),
+			IsPrimary: i == 0 && formValue("is_primary", 0) == "true",
+		})
+	}
+
+	return h.roomService.UploadRoomImages(roomID, uploads)
+}
+
+// uploadFormImages meng-upload gambar dari form CreateRoom/UpdateRoom (jika ada)
+func (h *RoomHandler) uploadFormImages(c *fiber.Ctx, roomID uint) error {
+	form, files := formImageFiles(c)
+	if len(files) == 0 {
+		return nil
+	}
+	_, err := h.uploadImages(roomID, form, files)
+	return err
 }
 
 // AddRoomImage: Menambah gambar kamar (Admin Only)
+// Menerima upload file (multipart field "images" untuk banyak file atau "image" untuk satu file)
+// atau URL eksternal (image_url).
 func (h *RoomHandler) AddRoomImage(c *fiber.Ctx) error {
 	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID kamar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	if form, files := formImageFiles(c); len(files) > 0 {
+		createdImages, err := h.uploadImages(uint(roomID), form, files)
+		if err != nil {
+			return err
+		}
+		// Kompatibel dengan client lama: field "image" mengembalikan satu objek
+		if len(form.File["images"]) == 0 && len(createdImages) == 1 {
+			return utils.RespondSuccess(c, fiber.StatusCreated, "ROOM_IMAGE_ADDED", createdImages[0])
+		}
+		return utils.RespondSuccess(c, fiber.StatusCreated, "ROOM_IMAGES_ADDED", createdImages)
 	}
 
 	var input AddRoomImageInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Format request tidak valid")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
 
 	roomImage := &models.RoomImage{
 		RoomID:    uint(roomID),
 		ImageURL:  input.ImageURL,
+		AltText:   input.AltText,
+		Caption:   input.Caption,
 		IsPrimary: input.IsPrimary,
 	}
 
 	createdImage, err := h.roomService.AddRoomImage(roomImage)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, err.Error())
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "ROOM_IMAGE_ADDED", createdImage)
+}
+
+type UpdateRoomImageInput struct {
+	AltText   *string `json:"alt_text" validate:"omitempty,max=255"`
+	Caption   *string `json:"caption" validate:"omitempty,max=255"`
+	IsPrimary bool    `json:"is_primary"`
+}
+
+// UpdateRoomImage: Mengubah alt text/caption atau menjadikan gambar primary (Admin Only)
+func (h *RoomHandler) UpdateRoomImage(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
+	imageID, err := strconv.ParseUint(c.Params("imageId"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_IMAGE_ID")
+	}
+
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	var input UpdateRoomImageInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	updatedImage, err := h.roomService.UpdateRoomImage(uint(roomID), uint(imageID), services.RoomImageUpdate{
+		AltText:   input.AltText,
+		Caption:   input.Caption,
+		IsPrimary: input.IsPrimary,
+	})
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_IMAGE_UPDATED", updatedImage)
+}
+
+type ReorderRoomImagesInput struct {
+	ImageIDs       []uint `json:"image_ids" validate:"required,min=1,dive,gt=0"`
+	PrimaryImageID uint   `json:"primary_image_id"`
+}
+
+// ReorderRoomImages: Menyimpan urutan galeri dan (opsional) gambar primary (Admin Only)
+func (h *RoomHandler) ReorderRoomImages(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusCreated, "Gambar kamar berhasil ditambah", createdImage)
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	var input ReorderRoomImagesInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	images, err := h.roomService.ReorderRoomImages(uint(roomID), input.ImageIDs, input.PrimaryImageID)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_IMAGES_REORDERED", images)
 }
 
 // DeleteRoomImage: Menghapus gambar kamar (Admin Only)
 func (h *RoomHandler) DeleteRoomImage(c *fiber.Ctx) error {
+	roomID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_ROOM_ID")
+	}
 	imageID, err := strconv.ParseUint(c.Params("imageId"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "ID gambar tidak valid")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_IMAGE_ID")
 	}
 
-	if err := h.roomService.DeleteRoomImage(uint(imageID)); err != nil {
-		if errors.Is(err, errors.New("gambar tidak ditemukan")) {
-			return utils.RespondError(c, fiber.StatusNotFound, "Gambar tidak ditemukan")
-		}
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Gagal menghapus gambar")
+	if _, err := h.authorizeRoom(c, uint(roomID)); err != nil {
+		return err
+	}
+
+	if err := h.roomService.DeleteRoomImage(uint(roomID), uint(imageID)); err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Gambar kamar berhasil dihapus", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROOM_IMAGE_DELETED", nil)
 }
@@ -1,99 +1,432 @@
 package handlers
 
 import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
 	"backend/pkg/utils"
 	"strconv"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"gorm.io/gorm"
 )
 
 type UserHandler struct {
-	db *gorm.DB
+	userService services.UserService
 }
 
-func NewUserHandler(db *gorm.DB) *UserHandler {
-	return &UserHandler{db: db}
+func NewUserHandler(userService services.UserService) *UserHandler {
+	return &UserHandler{userService: userService}
 }
 
-// GetAllUsers - Admin only
+type CreateUserInput struct {
+	Username string `json:"username" validate:"required,min=3,max=50"`
+	Password string `json:"password" validate:"required,min=6"`
+	Email    string `json:"email" validate:"required,email,max=100"`
+	FullName string `json:"full_name" validate:"required,max=100"`
+	Role     string `json:"role" validate:"required,oneof=admin member front_desk housekeeping revenue_manager accountant"`
+	Language string `json:"language" validate:"omitempty,oneof=id en"`
+}
+
+type UpdateUserInput struct {
+	Username string `json:"username" validate:"omitempty,min=3,max=50"`
+	Email    string `json:"email" validate:"omitempty,email,max=100"`
+	FullName string `json:"full_name" validate:"omitempty,max=100"`
+	Role     string `json:"role" validate:"omitempty,oneof=admin member front_desk housekeeping revenue_manager accountant"`
+}
+
+type UpdateUserRoleInput struct {
+	Role string `json:"role" validate:"required,oneof=admin member front_desk housekeeping revenue_manager accountant"`
+}
+
+// GetAllUsers - Admin only (cari ?q=, filter ?role= & ?status=active|inactive)
 func (h *UserHandler) GetAllUsers(c *fiber.Ctx) error {
-	var users []struct {
-		ID       uint   `json:"ID"`
-		Username string `json:"Username"`
-		FullName string `json:"FullName"`
-		Email    string `json:"Email"`
-		Role     string `json:"Role"`
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "username"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.UserFilter{
+		Search: c.Query("q"),
+		Role:   c.Query("role"),
+		Status: c.Query("status"),
 	}
 
-	if err := h.db.Table("users").Select("id, username, full_name, email, role").Find(&users).Error; err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Failed to fetch users")
+	users, total, err := h.userService.GetAllUsers(filter, pagination)
+	if err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Success", fiber.Map{"users": users})
+	return utils.RespondSuccess(c, fiber.StatusOK, "USERS_FETCHED", fiber.Map{
+		"users": users,
+		"total": total,
+		"page":  page,
+		"limit": limit,
+	})
 }
 
-// DeleteUser - Admin only
-func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
+// GetUserByID - Admin only
+func (h *UserHandler) GetUserByID(c *fiber.Ctx) error {
 	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid user ID")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
 	}
 
-	if err := h.db.Table("users").Where("id = ? AND role != ?", userID, "admin").Delete(nil).Error; err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Failed to delete user")
+	user, err := h.userService.GetUserByID(uint(userID))
+	if err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "User deleted successfully", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_FETCHED", user)
 }
 
-// UpdateUserRole - Admin only
-func (h *UserHandler) UpdateUserRole(c *fiber.Ctx) error {
+// CreateUser - Admin grup only (akun staf atau member baru).
+// Admin per properti ditolak: user baru tanpa properti berarti akses global.
+func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	var input CreateUserInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	user, err := h.userService.CreateUserByAdmin(&models.User{
+		Username: input.Username,
+		Password: input.Password,
+		Email:    input.Email,
+		FullName: input.FullName,
+		Role:     input.Role,
+		Language: input.Language,
+	})
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "USER_CREATED", user)
+}
+
+// UpdateUser - Admin grup only (field kosong tidak diubah)
+func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
 	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid user ID")
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
 	}
 
-	var input struct {
-		Role string `json:"role"`
+	var input UpdateUserInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
 	}
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid request")
+
+	user, err := h.userService.UpdateUserByAdmin(uint(userID), input.FullName, input.Email, input.Username, input.Role)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_UPDATED", user)
+}
+
+// DeactivateUser - Admin grup only (pengganti hapus permanen)
+func (h *UserHandler) DeactivateUser(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
 	}
 
-	if input.Role != "admin" && input.Role != "member" {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid role")
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
 	}
 
-	if err := h.db.Table("users").Where("id = ?", userID).Update("role", input.Role).Error; err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Failed to update role")
+	user, err := h.userService.DeactivateUserByAdmin(c.Locals("userID").(uint), uint(userID))
+	if err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Role updated successfully", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_DEACTIVATED", user)
 }
 
+// ReactivateUser - Admin grup only
+func (h *UserHandler) ReactivateUser(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
+	}
+
+	user, err := h.userService.ReactivateUserByAdmin(uint(userID))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_REACTIVATED", user)
+}
+
+// UpdateUserRole - Admin grup only (cegah admin per properti menaikkan role menjadi admin global)
+func (h *UserHandler) UpdateUserRole(c *fiber.Ctx) error {
+	if err := requireGlobalScope(c); err != nil {
+		return err
+	}
+
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_USER_ID")
+	}
+
+	var input UpdateUserRoleInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	user, err := h.userService.UpdateUserByAdmin(uint(userID), "", "", "", input.Role)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "USER_ROLE_UPDATED", user)
+}
+
+// RoleInfo adalah satu role beserta permission-nya
+type RoleInfo struct {
+	Role        string              `json:"role"`
+	Staff       bool                `json:"staff"`
+	Permissions []models.Permission `json:"permissions"`
+}
+
+// GetRoles - Daftar role yang bisa ditetapkan beserta permission-nya (untuk form admin)
+func (h *UserHandler) GetRoles(c *fiber.Ctx) error {
+	roles := make([]RoleInfo, 0, len(models.AllRoles))
+	for _, role := range models.AllRoles {
+		permissions := models.RolePermissions[role]
+		if permissions == nil {
+			permissions = []models.Permission{}
+		}
+		roles = append(roles, RoleInfo{Role: role, Staff: models.IsStaffRole(role), Permissions: permissions})
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ROLES_FETCHED", roles)
+}
+
+// GetMyPermissions - Permission milik staf yang sedang login (untuk menampilkan menu admin)
+func (h *UserHandler) GetMyPermissions(c *fiber.Ctx) error {
+	role, _ := c.Locals("role").(string)
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PERMISSIONS_FETCHED", RoleInfo{
+		Role:        role,
+		Staff:       models.IsStaffRole(role),
+		Permissions: models.RolePermissions[role],
+	})
+}
+
+// UpdateProfileInput tidak memuat email: perubahan email lewat RequestEmailChange (perlu verifikasi)
 type UpdateProfileInput struct {
-	Username string `json:"username"`
-	FullName string `json:"full_name"`
-	Email    string `json:"email"`
+	Username string `json:"username" validate:"omitempty,min=3,max=50"`
+	FullName string `json:"full_name" validate:"omitempty,max=100"`
+	Language string `json:"language" validate:"omitempty,oneof=id en"`
+}
+
+// Password kosong untuk akun yang belum punya password (login OIDC): isi Code (2FA) atau login ulang dulu
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"current_password"`
+	Code            string `json:"code"`
+	NewPassword     string `json:"new_password" validate:"required,min=6"`
+}
+
+type ChangeEmailInput struct {
+	NewEmail string `json:"new_email" validate:"required,email,max=100"`
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+type ConfirmEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type DeleteAccountInput struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+// reauthProof menyusun bukti identitas dari body request dan waktu login token akses
+func reauthProof(c *fiber.Ctx, password, code string) models.Reauthentication {
+	issuedAt, _ := c.Locals("issuedAt").(time.Time)
+	return models.Reauthentication{Password: password, Code: code, IssuedAt: issuedAt}
+}
+
+type SavedGuestInput struct {
+	Label     string `json:"label" validate:"omitempty,max=50"`
+	FullName  string `json:"full_name" validate:"required,max=255"`
+	Email     string `json:"email" validate:"omitempty,email,max=255"`
+	Phone     string `json:"phone" validate:"omitempty,max=30"`
+	IDNumber  string `json:"id_number" validate:"omitempty,max=50"`
+	IsDefault bool   `json:"is_default"`
+}
+
+func (input *SavedGuestInput) toModel() *models.SavedGuest {
+	return &models.SavedGuest{
+		Label:     input.Label,
+		FullName:  input.FullName,
+		Email:     input.Email,
+		Phone:     models.SensitiveString(input.Phone),
+		IDNumber:  models.SensitiveString(input.IDNumber),
+		IsDefault: input.IsDefault,
+	}
 }
 
-// UpdateProfile - Member only
+// GetProfile - Profil sendiri
+func (h *UserHandler) GetProfile(c *fiber.Ctx) error {
+	user, err := h.userService.GetUserProfile(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROFILE_FETCHED", user)
+}
+
+// UpdateProfile - Member only (field kosong tidak diubah)
 func (h *UserHandler) UpdateProfile(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(uint)
 
 	var input UpdateProfileInput
-	if err := c.BodyParser(&input); err != nil {
-		return utils.RespondError(c, fiber.StatusBadRequest, "Invalid input")
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	user, err := h.userService.UpdateUserProfile(userID, input.FullName, "", input.Username, input.Language)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PROFILE_UPDATED", user)
+}
+
+// ChangePassword - Ganti password sendiri (verifikasi password lama)
+func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
+	var input ChangePasswordInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if err := h.userService.ChangePassword(c.Locals("userID").(uint), reauthProof(c, input.CurrentPassword, input.Code), input.NewPassword); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "PASSWORD_CHANGED", nil)
+}
+
+// RequestEmailChange - Kirim link verifikasi ke email baru
+func (h *UserHandler) RequestEmailChange(c *fiber.Ctx) error {
+	var input ChangeEmailInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	request, err := h.userService.RequestEmailChange(c.Locals("userID").(uint), input.NewEmail, reauthProof(c, input.Password, input.Code))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusAccepted, "EMAIL_VERIFICATION_SENT", fiber.Map{
+		"new_email":  request.NewEmail,
+		"expires_at": request.ExpiresAt,
+	})
+}
+
+// ConfirmEmailChange - Buka link verifikasi email baru (Publik, token dari email)
+func (h *UserHandler) ConfirmEmailChange(c *fiber.Ctx) error {
+	var input ConfirmEmailInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	user, err := h.userService.ConfirmEmailChange(input.Token)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "EMAIL_CHANGED", user)
+}
+
+// DeleteAccount - Hapus akun sendiri (data pribadi dianonimkan, riwayat transaksi tetap)
+func (h *UserHandler) DeleteAccount(c *fiber.Ctx) error {
+	var input DeleteAccountInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	if err := h.userService.DeleteOwnAccount(c.Locals("userID").(uint), reauthProof(c, input.Password, input.Code)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "ACCOUNT_DELETED", nil)
+}
+
+// GetSavedGuests - Data tamu tersimpan milik sendiri
+func (h *UserHandler) GetSavedGuests(c *fiber.Ctx) error {
+	guests, err := h.userService.GetSavedGuests(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "SAVED_GUESTS_FETCHED", guests)
+}
+
+// CreateSavedGuest - Simpan data tamu untuk booking berikutnya
+func (h *UserHandler) CreateSavedGuest(c *fiber.Ctx) error {
+	var input SavedGuestInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	guest, err := h.userService.CreateSavedGuest(c.Locals("userID").(uint), input.toModel())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "SAVED_GUEST_CREATED", guest)
+}
+
+// UpdateSavedGuest - Ubah data tamu tersimpan (telepon & nomor identitas kosong = tidak diubah)
+func (h *UserHandler) UpdateSavedGuest(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_SAVED_GUEST_ID")
+	}
+
+	var input SavedGuestInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	guest, err := h.userService.UpdateSavedGuest(c.Locals("userID").(uint), uint(guestID), input.toModel())
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "SAVED_GUEST_UPDATED", guest)
+}
+
+// DeleteSavedGuest - Hapus data tamu tersimpan
+func (h *UserHandler) DeleteSavedGuest(c *fiber.Ctx) error {
+	guestID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_SAVED_GUEST_ID")
 	}
 
-	if err := h.db.Table("users").Where("id = ?", userID).Updates(map[string]interface{}{
-		"username":  input.Username,
-		"full_name": input.FullName,
-		"email":     input.Email,
-	}).Error; err != nil {
-		return utils.RespondError(c, fiber.StatusInternalServerError, "Failed to update profile")
+	if err := h.userService.DeleteSavedGuest(c.Locals("userID").(uint), uint(guestID)); err != nil {
+		return err
 	}
 
-	return utils.RespondSuccess(c, fiber.StatusOK, "Profile updated successfully", nil)
+	return utils.RespondSuccess(c, fiber.StatusOK, "SAVED_GUEST_DELETED", nil)
 }
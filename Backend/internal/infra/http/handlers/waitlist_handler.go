@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"backend/internal/app/services"
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// WaitlistHandler menangani daftar tunggu kamar untuk tanggal yang penuh
+type WaitlistHandler struct {
+	waitlistService services.WaitlistService
+	bookingService  services.BookingService
+}
+
+func NewWaitlistHandler(waitlistService services.WaitlistService, bookingService services.BookingService) *WaitlistHandler {
+	return &WaitlistHandler{waitlistService: waitlistService, bookingService: bookingService}
+}
+
+type JoinWaitlistInput struct {
+	RoomID       uint   `json:"room_id"` // Kamar tertentu, atau property_id + room_type (kamar apa pun dengan tipe tersebut)
+	PropertyID   uint   `json:"property_id" validate:"required_without=RoomID"`
+	RoomType     string `json:"room_type" validate:"required_without=RoomID,omitempty,max=50"`
+	CheckInDate  string `json:"check_in_date" validate:"required,datetime=2006-01-02"`
+	CheckOutDate string `json:"check_out_date" validate:"required,datetime=2006-01-02"`
+	Adults       int    `json:"adults" validate:"required,min=1"`
+	Children     int    `json:"children" validate:"omitempty,min=0"`
+}
+
+// JoinWaitlist: Masuk daftar tunggu untuk kamar / tipe kamar yang penuh (Member)
+func (h *WaitlistHandler) JoinWaitlist(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uint)
+
+	var input JoinWaitlistInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	checkIn, err := time.Parse("2006-01-02", input.CheckInDate)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_IN_DATE")
+	}
+	checkOut, err := time.Parse("2006-01-02", input.CheckOutDate)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_CHECK_OUT_DATE")
+	}
+
+	entry := &models.WaitlistEntry{
+		UserID:       userID,
+		PropertyID:   input.PropertyID,
+		RoomType:     input.RoomType,
+		CheckInDate:  checkIn,
+		CheckOutDate: checkOut,
+		Adults:       input.Adults,
+		Children:     input.Children,
+	}
+	if input.RoomID != 0 {
+		entry.RoomID = &input.RoomID
+	}
+
+	created, err := h.waitlistService.JoinWaitlist(entry)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "WAITLIST_JOINED", created)
+}
+
+// GetMyWaitlist: Daftar tunggu saya (Member)
+func (h *WaitlistHandler) GetMyWaitlist(c *fiber.Ctx) error {
+	entries, err := h.waitlistService.GetUserEntries(c.Locals("userID").(uint))
+	if err != nil {
+		return err
+	}
+	return utils.RespondSuccess(c, fiber.StatusOK, "WAITLIST_FETCHED", entries)
+}
+
+// CancelWaitlist: Keluar dari daftar tunggu / menolak penawaran (Member)
+func (h *WaitlistHandler) CancelWaitlist(c *fiber.Ctx) error {
+	entryID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_WAITLIST_ID")
+	}
+
+	if err := h.waitlistService.CancelEntry(uint(entryID), c.Locals("userID").(uint)); err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "WAITLIST_CANCELLED", nil)
+}
+
+type ClaimWaitlistInput struct {
+	Token           string `json:"token" validate:"required"`
+	PaymentMethod   string `json:"payment_method"`
+	GuestName       string `json:"guest_name" validate:"required"`
+	GuestEmail      string `json:"guest_email" validate:"required,email"`
+	GuestPhone      string `json:"guest_phone" validate:"required"`
+	GuestIDNumber   string `json:"guest_id_number"`
+	SpecialRequests string `json:"special_requests"`
+}
+
+// ClaimWaitlistOffer: Mengklaim kamar yang ditawarkan lewat link email menjadi booking (Member)
+func (h *WaitlistHandler) ClaimWaitlistOffer(c *fiber.Ctx) error {
+	var input ClaimWaitlistInput
+	if err := utils.BindAndValidate(c, &input); err != nil {
+		return err
+	}
+
+	booking := &models.Booking{
+		UserID:          c.Locals("userID").(uint),
+		PaymentMethod:   input.PaymentMethod,
+		GuestName:       input.GuestName,
+		GuestEmail:      input.GuestEmail,
+		GuestPhone:      models.SensitiveString(input.GuestPhone),
+		GuestIDNumber:   models.SensitiveString(input.GuestIDNumber),
+		SpecialRequests: input.SpecialRequests,
+	}
+
+	created, err := h.bookingService.ClaimWaitlistOffer(input.Token, booking)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusCreated, "WAITLIST_CLAIMED", created)
+}
+
+// GetAllWaitlist: Daftar tunggu per properti (Admin)
+// Filter: ?property_id=1&status=waiting
+func (h *WaitlistHandler) GetAllWaitlist(c *fiber.Ctx) error {
+	propertyID, err := parsePropertyQuery(c)
+	if err != nil {
+		return utils.RespondError(c, fiber.StatusBadRequest, "INVALID_PROPERTY_ID")
+	}
+	propertyIDs, err := scopedPropertyIDs(c, propertyID)
+	if err != nil {
+		return err
+	}
+
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 20)
+
+	pagination := &models.Pagination{
+		Page:   page,
+		Limit:  limit,
+		Sort:   c.Query("sort", "id asc"),
+		Offset: (page - 1) * limit,
+	}
+
+	filter := &models.WaitlistFilter{PropertyID: propertyID, PropertyIDs: propertyIDs, Status: c.Query("status")}
+	entries, err := h.waitlistService.GetAllEntries(filter, pagination)
+	if err != nil {
+		return err
+	}
+
+	return utils.RespondSuccess(c, fiber.StatusOK, "WAITLIST_FETCHED", fiber.Map{
+		"entries": entries,
+		"page":    page,
+		"limit":   limit,
+	})
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"backend/internal/app/services"
+	"backend/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ActiveUserMiddleware: Menolak token milik akun yang sudah dinonaktifkan atau dihapus.
+// Status akun dibaca dari database setiap request (area /admin dicek PropertyScopeMiddleware).
+func ActiveUserMiddleware(authService services.AuthService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals(CtxUserIDKey).(uint)
+		if !ok {
+			return utils.RespondError(c, fiber.StatusUnauthorized, "UNAUTHENTICATED")
+		}
+
+		if err := authService.CheckActiveUser(userID); err != nil {
+			return err
+		}
+		return c.Next()
+	}
+}
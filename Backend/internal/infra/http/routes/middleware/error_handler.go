@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+	"errors"
+	"log"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+// ErrorHandler: Satu-satunya tempat pemetaan error domain ke status HTTP.
+// Handler cukup `return err` dari service dan ErrorHandler yang menentukan response.
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	// Error validasi dan body request (dari utils.BindAndValidate)
+	var validationErr *utils.ValidationError
+	if errors.As(err, &validationErr) || errors.Is(err, utils.ErrInvalidBody) {
+		return utils.RespondBindError(c, err)
+	}
+
+	// Waktu tunggu untuk error rate limit / akun terkunci
+	var retryErr *models.RetryAfterError
+	if errors.As(err, &retryErr) {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfterSeconds(retryErr.RetryAfter)))
+	}
+
+	// Error domain dengan kode yang stabil
+	var domainErr *models.DomainError
+	if errors.As(err, &domainErr) {
+		return utils.RespondErrorCode(c, statusForKind(domainErr.Kind), domainErr.Code, domainErr.Message)
+	}
+
+	// Error gorm yang lolos dari service
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return utils.RespondErrorCode(c, fiber.StatusNotFound, "NOT_FOUND", "Data tidak ditemukan")
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return utils.RespondErrorCode(c, fiber.StatusConflict, "CONFLICT", "Data sudah ada")
+	}
+
+	// Error bawaan Fiber (404 route, 405, body terlalu besar, dll)
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return utils.RespondErrorCode(c, fiberErr.Code, "HTTP_ERROR", fiberErr.Message)
+	}
+
+	log.Printf("Unhandled error pada %s %s: %v", c.Method(), c.Path(), err)
+	return utils.RespondErrorCode(c, fiber.StatusInternalServerError, "INTERNAL_ERROR", "Terjadi kesalahan pada server")
+}
+
+func statusForKind(kind error) int {
+	switch {
+	case errors.Is(kind, models.ErrNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(kind, models.ErrForbidden):
+		return fiber.StatusForbidden
+	case errors.Is(kind, models.ErrConflict):
+		return fiber.StatusConflict
+	case errors.Is(kind, models.ErrValidation):
+		return fiber.StatusUnprocessableEntity
+	case errors.Is(kind, models.ErrUnauthorized):
+		return fiber.StatusUnauthorized
+	case errors.Is(kind, models.ErrTooManyRequests):
+		return fiber.StatusTooManyRequests
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
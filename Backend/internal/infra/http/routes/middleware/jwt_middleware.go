@@ -3,6 +3,7 @@ package middleware
 import (
 	"backend/internal/config"
 	"backend/internal/domain/models"
+	"backend/pkg/i18n"
 	"backend/pkg/utils"
 	"errors"
 	"strings"
@@ -21,12 +22,12 @@ func JWTMiddleware(cfg *config.Config) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Token tidak ditemukan")
+			return utils.RespondError(c, fiber.StatusUnauthorized, "TOKEN_MISSING")
 		}
 
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || parts[0] != "Bearer" {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Format token tidak valid (gunakan 'Bearer <token>')")
+			return utils.RespondError(c, fiber.StatusUnauthorized, "TOKEN_INVALID_FORMAT")
 		}
 
 		tokenString := parts[1]
@@ -39,18 +40,33 @@ func JWTMiddleware(cfg *config.Config) fiber.Handler {
 		})
 
 		if err != nil || !token.Valid {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Token tidak valid atau sudah kadaluarsa")
+			return utils.RespondError(c, fiber.StatusUnauthorized, "TOKEN_INVALID")
 		}
 
 		claims, ok := token.Claims.(*models.Claims)
 		if !ok {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Token tidak dapat diproses")
+			return utils.RespondError(c, fiber.StatusUnauthorized, "TOKEN_UNPROCESSABLE")
+		}
+
+		// Challenge token 2FA hanya berlaku di endpoint /auth/2fa, bukan sebagai token akses
+		if claims.Purpose != "" {
+			return utils.RespondError(c, fiber.StatusUnauthorized, "TOKEN_INVALID")
 		}
 
 		c.Locals(CtxUserIDKey, claims.UserID)
 		c.Locals(CtxRoleKey, claims.Role)
 		c.Locals("userID", claims.UserID)
 		c.Locals("role", claims.Role)
+		// Waktu login, dipakai sebagai bukti login ulang untuk aksi sensitif akun tanpa password
+		if claims.IssuedAt != nil {
+			c.Locals("issuedAt", claims.IssuedAt.Time)
+		}
+
+		// Preferensi bahasa user lebih diutamakan daripada Accept-Language,
+		// kecuali client meminta bahasa secara eksplisit lewat ?lang=
+		if claims.Lang != "" && i18n.IsSupported(claims.Lang) && !i18n.IsSupported(c.Query("lang")) {
+			c.Locals(i18n.LocalsKey, claims.Lang)
+		}
 
 		return c.Next()
 	}
@@ -70,7 +86,7 @@ func RoleMiddleware(allowedRoles ...string) fiber.Handler {
 		}
 
 		if !allowed {
-			return utils.RespondError(c, fiber.StatusForbidden, "Anda tidak memiliki akses ke resource ini")
+			return utils.RespondError(c, fiber.StatusForbidden, "FORBIDDEN_ROLE")
 		}
 
 		return c.Next()
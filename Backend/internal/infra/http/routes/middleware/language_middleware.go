@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"backend/pkg/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LanguageMiddleware: Menentukan bahasa response dari query ?lang= atau header Accept-Language.
+// Preferensi bahasa user (dari JWT) akan menimpa nilai ini di JWTMiddleware.
+func LanguageMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		lang := c.Query("lang")
+		if !i18n.IsSupported(lang) {
+			lang = i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+		}
+
+		c.Locals(i18n.LocalsKey, lang)
+		return c.Next()
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"backend/internal/domain/models"
+	"backend/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// StaffMiddleware: Hanya role staf (lihat models.StaffRoles) yang boleh masuk ke area /admin
+func StaffMiddleware() fiber.Handler {
+	return RoleMiddleware(models.StaffRoles...)
+}
+
+// PermissionMiddleware: Cek permission role user (bukan nama role).
+// Role diambil dari scope yang dimuat ulang PropertyScopeMiddleware, bukan dari JWT, sehingga
+// perubahan role langsung berlaku. Jika diberi beberapa permission, user cukup memiliki salah satunya.
+func PermissionMiddleware(permissions ...models.Permission) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		scope, ok := c.Locals(CtxPropertyScopeKey).(*models.PropertyScope)
+		if !ok || scope == nil {
+			return utils.RespondError(c, fiber.StatusUnauthorized, "UNAUTHENTICATED")
+		}
+
+		for _, permission := range permissions {
+			if models.HasPermission(scope.Role, permission) {
+				return c.Next()
+			}
+		}
+
+		return utils.RespondError(c, fiber.StatusForbidden, "FORBIDDEN_PERMISSION")
+	}
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"backend/internal/app/services"
+	"backend/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CtxPropertyScopeKey menyimpan *models.PropertyScope milik admin yang sedang login
+const CtxPropertyScopeKey = "propertyScope"
+
+// PropertyScopeMiddleware: Memuat properti yang boleh dikelola admin.
+// Scope dan role dibaca dari database setiap request (bukan dari JWT) agar pencabutan akses dan
+// perubahan role langsung berlaku; role di Locals ditimpa dengan role terbaru.
+func PropertyScopeMiddleware(propertyService services.PropertyService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		userID, ok := c.Locals(CtxUserIDKey).(uint)
+		if !ok {
+			return utils.RespondError(c, fiber.StatusUnauthorized, "UNAUTHENTICATED")
+		}
+
+		scope, err := propertyService.GetAdminScope(userID)
+		if err != nil {
+			return err
+		}
+
+		c.Locals(CtxPropertyScopeKey, scope)
+		c.Locals(CtxRoleKey, scope.Role)
+		c.Locals("role", scope.Role)
+		return c.Next()
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"backend/internal/domain/models"
+	"backend/internal/domain/ratelimit"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RateLimitRule mendefinisikan satu batas request dalam satu window
+type RateLimitRule struct {
+	Name   string                    // Bagian dari key counter, contoh "auth"
+	Limit  int                       // Jumlah request maksimal per window; 0 = nonaktif
+	Window time.Duration             // Panjang window, dihitung sejak request pertama
+	Key    func(c *fiber.Ctx) string // Identitas yang dibatasi; string kosong = tidak dibatasi
+}
+
+// KeyByIP membatasi per alamat IP client
+func KeyByIP(c *fiber.Ctx) string {
+	return "ip:" + c.IP()
+}
+
+// KeyByLoginUsername membatasi per akun yang dicoba di body login (huruf besar/kecil disamakan)
+func KeyByLoginUsername(c *fiber.Ctx) string {
+	var body struct {
+		Username string `json:"username"`
+	}
+	if err := c.BodyParser(&body); err != nil || strings.TrimSpace(body.Username) == "" {
+		return ""
+	}
+	return "account:" + strings.ToLower(strings.TrimSpace(body.Username))
+}
+
+// RateLimitMiddleware: Batasi request per key dalam window tetap.
+// Header X-RateLimit-Limit/Remaining/Reset dikirim di setiap response; saat limit terlampaui
+// response 429 RATE_LIMITED dengan header Retry-After.
+// Jika store tidak bisa dihubungi, request tetap dilayani (fail-open) agar API tidak ikut mati.
+func RateLimitMiddleware(store ratelimit.Store, rule RateLimitRule) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if rule.Limit <= 0 {
+			return c.Next()
+		}
+		key := rule.Key(c)
+		if key == "" {
+			return c.Next()
+		}
+
+		count, ttl, err := store.Increment(context.Background(), fmt.Sprintf("%s:%s", rule.Name, key), rule.Window)
+		if err != nil {
+			log.Printf("⚠️ Rate limit %s dilewati: %v", rule.Name, err)
+			return c.Next()
+		}
+
+		remaining := int64(rule.Limit) - count
+		if remaining < 0 {
+			remaining = 0
+		}
+		c.Set("X-RateLimit-Limit", strconv.Itoa(rule.Limit))
+		c.Set("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+		c.Set("X-RateLimit-Reset", strconv.Itoa(retryAfterSeconds(ttl)))
+
+		if count > int64(rule.Limit) {
+			return models.WithRetryAfter(models.ErrRateLimited, ttl)
+		}
+		return c.Next()
+	}
+}
+
+// retryAfterSeconds membulatkan durasi ke atas dalam detik (minimal 1)
+func retryAfterSeconds(d time.Duration) int {
+	seconds := int((d + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
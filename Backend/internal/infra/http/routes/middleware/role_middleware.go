@@ -10,11 +10,11 @@ func AuthorizeRole(requiredRole string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		userRole, ok := c.Locals(CtxRoleKey).(string)
 		if !ok {
-			return utils.RespondError(c, fiber.StatusUnauthorized, "Akses ditolak: user belum terauthentikasi")
+			return utils.RespondError(c, fiber.StatusUnauthorized, "UNAUTHENTICATED")
 		}
 
 		if userRole != requiredRole {
-			return utils.RespondError(c, fiber.StatusForbidden, "Akses ditolak: anda tidak memiliki hak akses "+requiredRole)
+			return utils.RespondError(c, fiber.StatusForbidden, "FORBIDDEN_ROLE")
 		}
 
 		return c.Next()
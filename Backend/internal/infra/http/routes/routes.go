@@ -1,9 +1,14 @@
 package routes
 
 import (
+	"backend/internal/app/services"
 	"backend/internal/config"
+	"backend/internal/domain/models"
+	"backend/internal/domain/ratelimit"
+	"backend/internal/infra/http/docs"
 	"backend/internal/infra/http/handlers"
 	"backend/internal/infra/http/routes/middleware"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -16,21 +21,73 @@ func SetupRoutes(
 	reviewHandler *handlers.ReviewHandler,
 	userHandler *handlers.UserHandler,
 	paymentHandler *handlers.PaymentHandler,
+	amenityHandler *handlers.AmenityHandler,
+	propertyHandler *handlers.PropertyHandler,
+	reportHandler *handlers.ReportHandler,
+	housekeepingHandler *handlers.HousekeepingHandler,
+	guestHandler *handlers.GuestHandler,
+	privacyHandler *handlers.PrivacyHandler,
+	oidcHandler *handlers.OIDCHandler,
+	groupHandler *handlers.GroupHandler,
+	waitlistHandler *handlers.WaitlistHandler,
+	extraHandler *handlers.ExtraHandler,
+	authService services.AuthService,
+	propertyService services.PropertyService,
+	limiterStore ratelimit.Store,
 	cfg *config.Config,
 ) {
-	// Public Routes (Tanpa autentikasi)
-	public := app.Group("/api")
+	// Rate limit (lihat RATE_LIMIT_* di .env); counter disimpan di limiterStore
+	apiLimit := middleware.RateLimitMiddleware(limiterStore, middleware.RateLimitRule{
+		Name: "api", Limit: cfg.RateLimitAPI, Window: time.Minute, Key: middleware.KeyByIP,
+	})
+	authLimit := middleware.RateLimitMiddleware(limiterStore, middleware.RateLimitRule{
+		Name: "auth", Limit: cfg.RateLimitAuth, Window: time.Minute, Key: middleware.KeyByIP,
+	})
+	loginAccountLimit := middleware.RateLimitMiddleware(limiterStore, middleware.RateLimitRule{
+		Name: "login", Limit: cfg.RateLimitLoginAccount, Window: 15 * time.Minute, Key: middleware.KeyByLoginUsername,
+	})
+	searchLimit := middleware.RateLimitMiddleware(limiterStore, middleware.RateLimitRule{
+		Name: "search", Limit: cfg.RateLimitSearch, Window: time.Minute, Key: middleware.KeyByIP,
+	})
+
+	// Public Routes (Tanpa autentikasi); limit umum per IP berlaku untuk seluruh /api
+	public := app.Group("/api", apiLimit)
+
+	// API Docs (OpenAPI spec + Swagger UI)
+	docs.Register(public)
 
 	// Auth Routes
 	auth := public.Group("/auth")
-	auth.Post("/register", authHandler.Register)
-	auth.Post("/login", authHandler.Login)
+	auth.Post("/register", authLimit, authHandler.Register)
+	auth.Post("/login", authLimit, loginAccountLimit, authHandler.Login)
+
+	// Two-Factor Login Routes (Public, memakai challenge token dari login)
+	auth.Post("/2fa/verify", authLimit, authHandler.VerifyTwoFactor)
+	auth.Post("/2fa/enroll", authLimit, authHandler.BeginEnrollment)
+	auth.Post("/2fa/enroll/confirm", authLimit, authHandler.ConfirmEnrollment)
+
+	// Verifikasi Email Baru (Public, token dari link email)
+	auth.Post("/verify-email", authLimit, userHandler.ConfirmEmailChange)
+
+	// OpenID Connect Login Routes (Public; code & state dikirim frontend dari halaman redirect)
+	auth.Get("/oidc/providers", oidcHandler.GetProviders)
+	auth.Post("/oidc/:provider/authorize", authLimit, oidcHandler.Authorize)
+	auth.Post("/oidc/:provider/callback", authLimit, oidcHandler.Callback)
 
 	// Room Routes (Public - Lihat dan Cari)
 	rooms := public.Group("/rooms")
 	rooms.Get("", roomHandler.GetAllRooms)
 	rooms.Get("/:id", roomHandler.GetRoomByID)
-	rooms.Post("/available", roomHandler.GetAvailableRooms)
+	rooms.Post("/available", searchLimit, roomHandler.GetAvailableRooms)
+
+	// Property Routes (Public - Daftar hotel dalam grup)
+	properties := public.Group("/properties")
+	properties.Get("", propertyHandler.GetAllProperties)
+	properties.Get("/:id", propertyHandler.GetPropertyByID)
+	properties.Get("/:id/extras", extraHandler.GetPropertyExtras)
+
+	// Amenity Routes (Public - Katalog untuk filter pencarian)
+	public.Get("/amenities", amenityHandler.GetAllAmenities)
 
 	// Review Routes (Public - Lihat)
 	reviews := public.Group("/reviews")
@@ -40,24 +97,79 @@ func SetupRoutes(
 
 	// Protected Routes (Memerlukan autentikasi)
 	protected := app.Group("/api", middleware.JWTMiddleware(cfg))
+	// Akun yang dinonaktifkan / dihapus tidak bisa memakai token yang masih berlaku
+	activeUser := middleware.ActiveUserMiddleware(authService)
+
+	// Two-Factor Routes (Semua user yang login)
+	twoFactor := protected.Group("/account/2fa", activeUser)
+	twoFactor.Get("", authHandler.GetTwoFactorStatus)
+	twoFactor.Post("/setup", authHandler.SetupTwoFactor)
+	twoFactor.Post("/enable", authHandler.EnableTwoFactor)
+	twoFactor.Post("/disable", authHandler.DisableTwoFactor)
+	twoFactor.Post("/recovery-codes", authHandler.RegenerateRecoveryCodes)
+
+	// Linked Identity Routes (Semua user yang login)
+	identities := protected.Group("/account/identities", activeUser)
+	identities.Get("", oidcHandler.GetIdentities)
+	identities.Post("/:provider/link", oidcHandler.BeginLink)
+	identities.Post("/:provider/callback", oidcHandler.CompleteLink)
+	identities.Delete("/:id", oidcHandler.UnlinkIdentity)
 
 	// Member Routes
-	member := protected.Group("/member")
+	member := protected.Group("/member", activeUser)
 
 	// Booking Routes (Member)
 	bookings := member.Group("/bookings")
 	bookings.Post("", bookingHandler.CreateBooking)
 	bookings.Get("", bookingHandler.GetMyBookings)
 	bookings.Put("/:id/cancel", bookingHandler.CancelBooking)
+	bookings.Put("/:id/modify", bookingHandler.ModifyBooking)
+	bookings.Get("/:id/modifications", bookingHandler.GetBookingModifications)
+	bookings.Post("/:id/extras", bookingHandler.AddExtra)
+	bookings.Delete("/:id/extras/:extraId", bookingHandler.RemoveExtra)
+	bookings.Get("/:id/invoice", bookingHandler.GetInvoice)
 	bookings.Delete("/:id", bookingHandler.DeleteBooking)
 
+	// Reservasi Grup Routes (Member)
+	groups := member.Group("/groups")
+	groups.Post("", groupHandler.CreateGroupBooking)
+	groups.Get("", groupHandler.GetMyGroups)
+	groups.Get("/:id", groupHandler.GetMyGroup)
+	groups.Put("/:id/cancel", groupHandler.CancelMyGroup)
+	groups.Put("/:id/rooming-list", groupHandler.UpdateMyRoomingList)
+	groups.Post("/:id/payments", groupHandler.CreateGroupPayment)
+
+	// Daftar Tunggu Routes (Member)
+	waitlist := member.Group("/waitlist")
+	waitlist.Post("", waitlistHandler.JoinWaitlist)
+	waitlist.Get("", waitlistHandler.GetMyWaitlist)
+	waitlist.Post("/claim", waitlistHandler.ClaimWaitlistOffer)
+	waitlist.Delete("/:id", waitlistHandler.CancelWaitlist)
+
 	// Review Routes (Member)
 	memberReviews := member.Group("/reviews")
 	memberReviews.Get("", reviewHandler.GetMyReviews)
 	memberReviews.Post("", reviewHandler.CreateReview)
 
-	// Profile Routes (Member)
+	// Profile & Account Routes (Member)
+	member.Get("/profile", userHandler.GetProfile)
 	member.Put("/profile", userHandler.UpdateProfile)
+	member.Put("/password", authLimit, userHandler.ChangePassword)
+	member.Post("/email", authLimit, userHandler.RequestEmailChange)
+	member.Delete("/account", authLimit, userHandler.DeleteAccount)
+
+	// Saved Guest Routes (Member - data tamu untuk booking cepat)
+	savedGuests := member.Group("/saved-guests")
+	savedGuests.Get("", userHandler.GetSavedGuests)
+	savedGuests.Post("", userHandler.CreateSavedGuest)
+	savedGuests.Put("/:id", userHandler.UpdateSavedGuest)
+	savedGuests.Delete("/:id", userHandler.DeleteSavedGuest)
+
+	// Privacy Routes (Member - UU PDP)
+	memberPrivacy := member.Group("/privacy")
+	memberPrivacy.Get("/export", privacyHandler.ExportMyData)
+	memberPrivacy.Get("/requests", privacyHandler.GetMyPrivacyRequests)
+	memberPrivacy.Post("/erasure", privacyHandler.RequestErasure)
 
 	// Payment Routes (Member)
 	payments := member.Group("/payments")
@@ -65,35 +177,129 @@ func SetupRoutes(
 	payments.Get("/booking/:booking_id", paymentHandler.GetPaymentByBooking)
 	payments.Post("/:id/process", paymentHandler.ProcessPayment)
 
-	// Admin Routes
-	admin := protected.Group("/admin", middleware.RoleMiddleware("admin"))
+	// Admin Routes (semua role staf; akses tiap endpoint ditentukan permission, lihat models.RolePermissions)
+	// Scope properti dimuat setelah cek role agar setiap handler admin bisa membatasi data per properti
+	admin := protected.Group("/admin", middleware.StaffMiddleware(), middleware.PropertyScopeMiddleware(propertyService))
+	can := middleware.PermissionMiddleware
+
+	// Role & Permission Routes (Staf)
+	admin.Get("/permissions", userHandler.GetMyPermissions)
+	admin.Get("/roles", can(models.PermUserManage), userHandler.GetRoles)
+
+	// Property Management Routes (Admin)
+	adminProperties := admin.Group("/properties")
+	adminProperties.Get("", propertyHandler.GetAllProperties)
+	adminProperties.Get("/:id", propertyHandler.GetPropertyByID)
+	adminProperties.Post("", can(models.PermPropertyWrite), propertyHandler.CreateProperty)
+	adminProperties.Put("/:id", can(models.PermPropertyWrite), propertyHandler.UpdateProperty)
+	adminProperties.Delete("/:id", can(models.PermPropertyWrite), propertyHandler.DeleteProperty)
 
 	// Room Management Routes (Admin)
 	adminRooms := admin.Group("/rooms")
 	adminRooms.Get("", roomHandler.GetAllRooms)
-	adminRooms.Post("", roomHandler.CreateRoom)
-	adminRooms.Put("/:id", roomHandler.UpdateRoom)
-	adminRooms.Delete("/:id", roomHandler.DeleteRoom)
+	adminRooms.Post("", can(models.PermRoomWrite), roomHandler.CreateRoom)
+	adminRooms.Put("/:id", can(models.PermRoomWrite), roomHandler.UpdateRoom)
+	adminRooms.Delete("/:id", can(models.PermRoomWrite), roomHandler.DeleteRoom)
+	adminRooms.Put("/:id/status", can(models.PermRoomUpdateStatus), roomHandler.UpdateRoomStatus)
+	adminRooms.Put("/:id/housekeeping-status", can(models.PermHousekeepingManage), housekeepingHandler.SetRoomHousekeepingStatus)
+	adminRooms.Put("/:id/amenities", can(models.PermRoomWrite), roomHandler.SetRoomAmenities)
 
 	// Room Image Management Routes (Admin)
-	adminRoomImages := admin.Group("/rooms/:id/images")
+	adminRoomImages := admin.Group("/rooms/:id/images", can(models.PermRoomWrite))
 	adminRoomImages.Post("", roomHandler.AddRoomImage)
+	adminRoomImages.Put("/order", roomHandler.ReorderRoomImages) // Harus sebelum "/:imageId"
+	adminRoomImages.Put("/:imageId", roomHandler.UpdateRoomImage)
 	adminRoomImages.Delete("/:imageId", roomHandler.DeleteRoomImage)
 
+	// Amenity Management Routes (Admin)
+	adminAmenities := admin.Group("/amenities")
+	adminAmenities.Get("", amenityHandler.GetAllAmenities)
+	adminAmenities.Get("/:id", amenityHandler.GetAmenityByID)
+	adminAmenities.Post("", can(models.PermAmenityWrite), amenityHandler.CreateAmenity)
+	adminAmenities.Put("/:id", can(models.PermAmenityWrite), amenityHandler.UpdateAmenity)
+	adminAmenities.Delete("/:id", can(models.PermAmenityWrite), amenityHandler.DeleteAmenity)
+
+	// Layanan Tambahan Routes (Admin - katalog per properti)
+	adminExtras := admin.Group("/extras")
+	adminExtras.Get("", extraHandler.GetAllExtras)
+	adminExtras.Get("/:id", extraHandler.GetExtraByID)
+	adminExtras.Post("", can(models.PermExtraWrite), extraHandler.CreateExtra)
+	adminExtras.Put("/:id", can(models.PermExtraWrite), extraHandler.UpdateExtra)
+	adminExtras.Delete("/:id", can(models.PermExtraWrite), extraHandler.DeleteExtra)
+
 	// Booking Management Routes (Admin)
 	adminBookings := admin.Group("/bookings")
-	adminBookings.Get("", bookingHandler.GetAllBookings)
-	adminBookings.Get("/:id", bookingHandler.GetBookingByID)
-	adminBookings.Put("/:id/status", bookingHandler.UpdateBookingStatus)
-	adminBookings.Put("/:id/payment-status", bookingHandler.UpdatePaymentStatus)
+	adminBookings.Get("", can(models.PermBookingRead), bookingHandler.GetAllBookings)
+	adminBookings.Get("/:id", can(models.PermBookingRead), bookingHandler.GetBookingByID)
+	adminBookings.Put("/:id/status", can(models.PermBookingUpdateStatus), bookingHandler.UpdateBookingStatus)
+	adminBookings.Put("/:id/payment-status", can(models.PermPaymentUpdateStatus), bookingHandler.UpdatePaymentStatus)
+	adminBookings.Put("/:id/check-in", can(models.PermBookingUpdateStatus), bookingHandler.CheckInBooking)
+	adminBookings.Put("/:id/modify", can(models.PermBookingModify), bookingHandler.ModifyBookingByAdmin)
+	adminBookings.Get("/:id/modifications", can(models.PermBookingRead), bookingHandler.GetBookingModificationsByAdmin)
+	adminBookings.Post("/:id/guest-identity", can(models.PermGuestPII), privacyHandler.RevealBookingIdentity)
+	adminBookings.Post("/:id/extras", can(models.PermBookingModify), bookingHandler.AddExtraByAdmin)
+	adminBookings.Delete("/:id/extras/:extraId", can(models.PermBookingModify), bookingHandler.RemoveExtraByAdmin)
+	adminBookings.Get("/:id/invoice", can(models.PermBookingRead), bookingHandler.GetInvoiceByAdmin)
+
+	// Reservasi Grup Routes (Admin)
+	adminGroups := admin.Group("/groups")
+	adminGroups.Get("", can(models.PermBookingRead), groupHandler.GetAllGroups)
+	adminGroups.Get("/:id", can(models.PermBookingRead), groupHandler.GetGroupByID)
+	adminGroups.Put("/:id/cancel", can(models.PermBookingUpdateStatus), groupHandler.CancelGroup)
+	adminGroups.Put("/:id/rooming-list", can(models.PermBookingModify), groupHandler.UpdateRoomingList)
+
+	// Daftar Tunggu Routes (Admin)
+	admin.Get("/waitlist", can(models.PermBookingRead), waitlistHandler.GetAllWaitlist)
+
+	// Guest Profile Routes (Staf)
+	adminGuests := admin.Group("/guests")
+	adminGuests.Get("", can(models.PermGuestRead), guestHandler.GetAllGuests)
+	adminGuests.Get("/:id", can(models.PermGuestRead), guestHandler.GetGuestByID)
+	adminGuests.Get("/:id/bookings", can(models.PermGuestRead), guestHandler.GetGuestBookings)
+	adminGuests.Put("/:id", can(models.PermGuestManage), guestHandler.UpdateGuest)
+	adminGuests.Post("/:id/merge", can(models.PermGuestManage), guestHandler.MergeGuests)
+	adminGuests.Post("/:id/identity", can(models.PermGuestPII), privacyHandler.RevealGuestIdentity)
+
+	// Housekeeping Routes (Staf)
+	adminHousekeeping := admin.Group("/housekeeping/tasks")
+	adminHousekeeping.Get("", can(models.PermHousekeepingRead), housekeepingHandler.GetTasks)
+	adminHousekeeping.Post("/generate", can(models.PermHousekeepingManage), housekeepingHandler.GenerateStayoverTasks)
+	adminHousekeeping.Put("/:id/assign", can(models.PermHousekeepingManage), housekeepingHandler.AssignTask)
+	adminHousekeeping.Put("/:id/start", can(models.PermHousekeepingUpdate, models.PermHousekeepingManage), housekeepingHandler.StartTask)
+	adminHousekeeping.Put("/:id/complete", can(models.PermHousekeepingUpdate, models.PermHousekeepingManage), housekeepingHandler.CompleteTask)
+	adminHousekeeping.Put("/:id/inspect", can(models.PermHousekeepingInspect), housekeepingHandler.InspectTask)
+
+	// Payment Management Routes (Admin)
+	adminPayments := admin.Group("/payments")
+	adminPayments.Post("/:id/refund", can(models.PermPaymentRefund), paymentHandler.RefundPayment)
 
 	// Review Management Routes (Admin)
 	adminReviews := admin.Group("/reviews")
-	adminReviews.Delete("/:id", reviewHandler.DeleteReview)
+	adminReviews.Delete("/:id", can(models.PermReviewModerate), reviewHandler.DeleteReview)
 
 	// User Management Routes (Admin)
 	adminUsers := admin.Group("/users")
-	adminUsers.Get("", userHandler.GetAllUsers)
-	adminUsers.Put("/:id/role", userHandler.UpdateUserRole)
-	adminUsers.Delete("/:id", userHandler.DeleteUser)
+	adminUsers.Get("", can(models.PermUserRead), userHandler.GetAllUsers)
+	adminUsers.Get("/:id", can(models.PermUserRead), userHandler.GetUserByID)
+	adminUsers.Post("", can(models.PermUserManage), userHandler.CreateUser)
+	adminUsers.Put("/:id", can(models.PermUserManage), userHandler.UpdateUser)
+	adminUsers.Put("/:id/role", can(models.PermUserManage), userHandler.UpdateUserRole)
+	adminUsers.Put("/:id/reactivate", can(models.PermUserManage), userHandler.ReactivateUser)
+	adminUsers.Delete("/:id", can(models.PermUserManage), userHandler.DeactivateUser) // Nonaktifkan, bukan hapus permanen
+	adminUsers.Get("/:id/properties", can(models.PermUserManage), propertyHandler.GetUserProperties)
+	adminUsers.Put("/:id/properties", can(models.PermUserManage), propertyHandler.SetUserProperties)
+
+	// Audit Routes (Admin)
+	adminAudit := admin.Group("/audit", can(models.PermAuditRead))
+	adminAudit.Get("/pii-access", privacyHandler.GetPIIAccessLogs)
+
+	// Privacy Request Routes (Admin)
+	adminPrivacy := admin.Group("/privacy/requests", can(models.PermPrivacyManage))
+	adminPrivacy.Get("", privacyHandler.GetPrivacyRequests)
+	adminPrivacy.Put("/:id/approve", privacyHandler.ApproveErasure)
+	adminPrivacy.Put("/:id/reject", privacyHandler.RejectErasure)
+
+	// Report Routes (Admin)
+	adminReports := admin.Group("/reports", can(models.PermReportRead))
+	adminReports.Get("/properties", reportHandler.GetPropertyReport)
 }
@@ -0,0 +1,23 @@
+package mailer
+
+import (
+	"backend/internal/domain/mailer"
+	"context"
+	"log"
+)
+
+// LogMailer menulis email ke log server alih-alih mengirimnya (untuk pengembangan)
+type LogMailer struct{}
+
+func NewLogMailer() *LogMailer {
+	return &LogMailer{}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg mailer.Message) error {
+	log.Printf("📧 Email ke %s: %s\n%s", msg.To, msg.Subject, msg.Body)
+	return nil
+}
+
+func (m *LogMailer) String() string {
+	return "log"
+}
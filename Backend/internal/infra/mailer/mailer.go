@@ -0,0 +1,22 @@
+package mailer
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain/mailer"
+	"fmt"
+)
+
+// NewMailer memilih implementasi Mailer berdasarkan MAIL_DRIVER
+func NewMailer(cfg *config.Config) (mailer.Mailer, error) {
+	switch cfg.MailDriver {
+	case "", "log":
+		return NewLogMailer(), nil
+	case "smtp":
+		if cfg.SMTPHost == "" || cfg.MailFrom == "" {
+			return nil, fmt.Errorf("MAIL_DRIVER=smtp membutuhkan SMTP_HOST dan MAIL_FROM")
+		}
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom), nil
+	default:
+		return nil, fmt.Errorf("MAIL_DRIVER tidak dikenal: %s", cfg.MailDriver)
+	}
+}
@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"backend/internal/domain/mailer"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPMailer mengirim email teks lewat server SMTP (STARTTLS otomatis jika didukung server)
+type SMTPMailer struct {
+	addr     string
+	auth     smtp.Auth
+	from     string // Header From, boleh berisi nama tampilan
+	envelope string // Alamat MAIL FROM (tanpa nama tampilan)
+}
+
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	m := &SMTPMailer{
+		addr: net.JoinHostPort(host, fmt.Sprint(port)),
+		from: from,
+	}
+	m.envelope = from
+	if address, err := mail.ParseAddress(from); err == nil {
+		m.envelope = address.Address
+	}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg mailer.Message) error {
+	// Header tidak boleh mengandung baris baru (cegah header injection dari input user)
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return fmt.Errorf("alamat atau subjek email tidak valid")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return smtp.SendMail(m.addr, m.auth, m.envelope, []string{msg.To}, []byte(b.String()))
+}
+
+func (m *SMTPMailer) String() string {
+	return "smtp://" + m.addr
+}
@@ -0,0 +1,114 @@
+package oidc
+
+import (
+	"backend/internal/config"
+	domain "backend/internal/domain/oidc"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	gooidc "github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+)
+
+// Provider membungkus go-oidc. Discovery (.well-known/openid-configuration) dilakukan saat
+// pertama kali dipakai sehingga server tetap bisa start walau provider sedang tidak terjangkau.
+type Provider struct {
+	cfg config.OIDCProviderConfig
+
+	mu       sync.Mutex
+	oauth    *oauth2.Config
+	verifier *gooidc.IDTokenVerifier
+}
+
+// NewProviders membuat Provider untuk setiap konfigurasi OIDC_PROVIDERS
+func NewProviders(cfg *config.Config) []domain.Provider {
+	providers := make([]domain.Provider, 0, len(cfg.OIDCProviders))
+	for _, providerCfg := range cfg.OIDCProviders {
+		providers = append(providers, &Provider{cfg: providerCfg})
+	}
+	return providers
+}
+
+func (p *Provider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *Provider) DisplayName() string {
+	return p.cfg.DisplayName
+}
+
+// discover memuat endpoint dan kunci provider sekali; gagal discovery akan dicoba lagi di request berikutnya
+func (p *Provider) discover() (*oauth2.Config, *gooidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.oauth != nil {
+		return p.oauth, p.verifier, nil
+	}
+
+	// Context background (bukan context request): go-oidc memakainya juga untuk mengambil ulang JWKS nanti
+	provider, err := gooidc.NewProvider(context.Background(), p.cfg.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("discovery OIDC %s: %w", p.cfg.Name, err)
+	}
+
+	p.oauth = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       p.cfg.Scopes,
+	}
+	p.verifier = provider.Verifier(&gooidc.Config{ClientID: p.cfg.ClientID})
+	return p.oauth, p.verifier, nil
+}
+
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	oauthCfg, _, err := p.discover()
+	if err != nil {
+		return "", err
+	}
+	return oauthCfg.AuthCodeURL(state, gooidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)), nil
+}
+
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*domain.Identity, error) {
+	oauthCfg, verifier, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := oauthCfg.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+	if err != nil {
+		return nil, fmt.Errorf("tukar authorization code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("response token tidak berisi id_token")
+	}
+
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("verifikasi id_token: %w", err)
+	}
+	if idToken.Nonce != nonce {
+		return nil, errors.New("nonce id_token tidak cocok")
+	}
+
+	var claims struct {
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+		Name          string `json:"name"`
+	}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("baca claim id_token: %w", err)
+	}
+
+	return &domain.Identity{
+		Subject:       idToken.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval adalah jeda minimal antar pembersihan counter kedaluwarsa
+const sweepInterval = time.Minute
+
+type memoryEntry struct {
+	count     int64
+	expiresAt time.Time
+}
+
+// MemoryStore menyimpan counter di memori proses (hilang saat restart, tidak dibagi antar instance)
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	lastSweep time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]memoryEntry{}, lastSweep: time.Now()}
+}
+
+func (s *MemoryStore) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	entry, ok := s.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		entry = memoryEntry{expiresAt: now.Add(window)}
+	}
+	entry.count++
+	s.entries[key] = entry
+	return entry.count, entry.expiresAt.Sub(now), nil
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (int64, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	entry, ok := s.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return 0, 0, nil
+	}
+	return entry.count, entry.expiresAt.Sub(now), nil
+}
+
+func (s *MemoryStore) Set(ctx context.Context, key string, value int64, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryEntry{count: value, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep membuang counter kedaluwarsa agar memori tidak tumbuh terus (dipanggil dengan lock)
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,19 @@
+package ratelimit
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain/ratelimit"
+	"fmt"
+)
+
+// NewStore memilih implementasi Store berdasarkan RATE_LIMIT_STORE
+func NewStore(cfg *config.Config) (ratelimit.Store, error) {
+	switch cfg.RateLimitStore {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "redis":
+		return NewRedisStore(cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	default:
+		return nil, fmt.Errorf("RATE_LIMIT_STORE tidak dikenal: %s", cfg.RateLimitStore)
+	}
+}
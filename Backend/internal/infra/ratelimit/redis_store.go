@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix memisahkan counter rate limit dari data lain di Redis yang sama
+const keyPrefix = "ratelimit:"
+
+// incrementScript: INCR + PEXPIRE secara atomik; masa berlaku hanya diset saat hit pertama
+var incrementScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return {count, redis.call('PTTL', KEYS[1])}
+`)
+
+// RedisStore menyimpan counter di Redis (atau server kompatibel seperti Valkey/KeyDB)
+// sehingga limit berlaku bersama untuk semua instance server
+type RedisStore struct {
+	client *redis.Client
+}
+
+func NewRedisStore(addr, password string, db int) (*RedisStore, error) {
+	client := redis.NewClient(&redis.Options{Addr: addr, Password: password, DB: db})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("gagal terhubung ke Redis %s: %w", addr, err)
+	}
+	return &RedisStore{client: client}, nil
+}
+
+func (s *RedisStore) Increment(ctx context.Context, key string, window time.Duration) (int64, time.Duration, error) {
+	result, err := incrementScript.Run(ctx, s.client, []string{keyPrefix + key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	return result[0], time.Duration(result[1]) * time.Millisecond, nil
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (int64, time.Duration, error) {
+	pipe := s.client.Pipeline()
+	countCmd := pipe.Get(ctx, keyPrefix+key)
+	ttlCmd := pipe.PTTL(ctx, keyPrefix+key)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return 0, 0, err
+	}
+
+	count, err := countCmd.Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	// PTTL negatif: key tanpa masa berlaku (-1) atau sudah hilang (-2)
+	ttl := ttlCmd.Val()
+	if ttl < 0 {
+		ttl = 0
+	}
+	return count, ttl, nil
+}
+
+func (s *RedisStore) Set(ctx context.Context, key string, value int64, ttl time.Duration) error {
+	return s.client.Set(ctx, keyPrefix+key, value, ttl).Err()
+}
+
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	return s.client.Del(ctx, keyPrefix+key).Err()
+}
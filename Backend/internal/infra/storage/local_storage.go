@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// LocalStorage menyimpan file di disk lokal dan menyajikannya lewat ServeHandler.
+// Hanya cocok untuk satu instance server; gunakan S3Storage untuk multi-instance.
+type LocalStorage struct {
+	baseDir    string
+	publicBase string
+	signingKey []byte
+	urlTTL     time.Duration
+}
+
+// NewLocalStorage membuat storage disk. signingKey kosong = URL publik tanpa tanda tangan.
+func NewLocalStorage(baseDir, publicBase, signingKey string, urlTTL time.Duration) *LocalStorage {
+	return &LocalStorage{
+		baseDir:    baseDir,
+		publicBase: strings.TrimSuffix(publicBase, "/"),
+		signingKey: []byte(signingKey),
+		urlTTL:     urlTTL,
+	}
+}
+
+// resolve mengubah key menjadi path di disk dan menolak path traversal ("../")
+func (s *LocalStorage) resolve(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" {
+		return "", errors.New("key file tidak valid")
+	}
+	return filepath.Join(s.baseDir, filepath.FromSlash(cleaned)), nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(fullPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, r)
+	return err
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *LocalStorage) URL(ctx context.Context, key string) (string, error) {
+	escapedKey := (&url.URL{Path: strings.TrimPrefix(key, "/")}).EscapedPath()
+	publicURL := s.publicBase + "/" + escapedKey
+	if len(s.signingKey) == 0 {
+		return publicURL, nil
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(s.urlTTL).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+	return publicURL + "?" + query.Encode(), nil
+}
+
+func (s *LocalStorage) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(strings.TrimPrefix(key, "/") + "|" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ServeHandler menyajikan file di bawah publicBase dan memverifikasi signed URL jika aktif.
+// Didaftarkan dengan: app.Get(publicBase+"/*", local.ServeHandler())
+func (s *LocalStorage) ServeHandler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key, err := url.PathUnescape(c.Params("*"))
+		if err != nil {
+			return fiber.ErrBadRequest
+		}
+
+		if len(s.signingKey) > 0 {
+			expires := c.Query("expires")
+			expiresAt, err := strconv.ParseInt(expires, 10, 64)
+			if err != nil || time.Now().Unix() > expiresAt {
+				return fiber.NewError(fiber.StatusForbidden, "URL file sudah kadaluarsa")
+			}
+			if !hmac.Equal([]byte(s.sign(key, expires)), []byte(c.Query("signature"))) {
+				return fiber.NewError(fiber.StatusForbidden, "Tanda tangan URL tidak valid")
+			}
+		}
+
+		fullPath, err := s.resolve(key)
+		if err != nil {
+			return fiber.ErrNotFound
+		}
+		if _, err := os.Stat(fullPath); err != nil {
+			return fiber.ErrNotFound
+		}
+		return c.SendFile(fullPath)
+	}
+}
+
+// PublicBase mengembalikan prefix URL publik, contoh "/uploads"
+func (s *LocalStorage) PublicBase() string {
+	return s.publicBase
+}
+
+// String untuk log startup
+func (s *LocalStorage) String() string {
+	return fmt.Sprintf("local(%s)", s.baseDir)
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage menyimpan file di bucket S3-compatible (AWS S3, MinIO, dll).
+// URL yang dikembalikan selalu berupa presigned URL dengan masa berlaku urlTTL.
+type S3Storage struct {
+	client *minio.Client
+	bucket string
+	urlTTL time.Duration
+}
+
+// NewS3Storage membuat client S3 dan memastikan bucket tersedia
+func NewS3Storage(ctx context.Context, endpoint, region, bucket, accessKey, secretKey string, useSSL bool, urlTTL time.Duration) (*S3Storage, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := client.BucketExists(ctx, bucket)
+	if err != nil {
+		return nil, fmt.Errorf("gagal mengecek bucket %s: %w", bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{Region: region}); err != nil {
+			return nil, fmt.Errorf("gagal membuat bucket %s: %w", bucket, err)
+		}
+	}
+
+	return &S3Storage{client: client, bucket: bucket, urlTTL: urlTTL}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	// RemoveObject tidak error jika object sudah tidak ada
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3Storage) URL(ctx context.Context, key string) (string, error) {
+	signed, err := s.client.PresignedGetObject(ctx, s.bucket, key, s.urlTTL, url.Values{})
+	if err != nil {
+		return "", err
+	}
+	return signed.String(), nil
+}
+
+// String untuk log startup
+func (s *S3Storage) String() string {
+	return fmt.Sprintf("s3(%s/%s)", s.client.EndpointURL().Host, s.bucket)
+}
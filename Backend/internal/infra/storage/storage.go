@@ -0,0 +1,23 @@
+package storage
+
+import (
+	"backend/internal/config"
+	"backend/internal/domain/storage"
+	"context"
+	"fmt"
+	"time"
+)
+
+// NewFileStorage memilih implementasi FileStorage berdasarkan STORAGE_DRIVER
+func NewFileStorage(cfg *config.Config) (storage.FileStorage, error) {
+	urlTTL := time.Duration(cfg.StorageURLTTLMinutes) * time.Minute
+
+	switch cfg.StorageDriver {
+	case "", "local":
+		return NewLocalStorage(cfg.StorageLocalDir, cfg.StoragePublicBaseURL, cfg.StorageSigningKey, urlTTL), nil
+	case "s3":
+		return NewS3Storage(context.Background(), cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey, cfg.S3UseSSL, urlTTL)
+	default:
+		return nil, fmt.Errorf("STORAGE_DRIVER tidak dikenal: %s", cfg.StorageDriver)
+	}
+}
@@ -0,0 +1,152 @@
+// Package fieldcrypt mengenkripsi kolom sensitif (nomor identitas, telepon) dengan AES-256-GCM.
+// Ciphertext menyimpan ID kunci yang dipakai sehingga kunci lama tetap bisa mendekripsi
+// setelah rotasi, dan blind index (HMAC-SHA256) memungkinkan pencarian nilai yang sama
+// tanpa menyimpan plaintext.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix menandai nilai terenkripsi; nilai tanpa prefix dianggap plaintext lama (sebelum enkripsi aktif)
+const prefix = "enc:"
+
+var (
+	ErrNoKeys          = errors.New("kunci enkripsi belum dikonfigurasi")
+	ErrInvalidKey      = errors.New("kunci enkripsi harus base64 dari 32 byte (AES-256)")
+	ErrUnknownKey      = errors.New("ciphertext memakai kunci yang tidak dikonfigurasi")
+	ErrInvalidCipher   = errors.New("format ciphertext tidak valid")
+	ErrMissingIndexKey = errors.New("kunci blind index belum dikonfigurasi")
+)
+
+// Keyring menyimpan semua kunci yang dikenal; kunci aktif dipakai untuk enkripsi baru
+type Keyring struct {
+	activeID string
+	keys     map[string]cipher.AEAD
+	indexKey []byte
+}
+
+// New membuat Keyring dari spesifikasi "id:base64key,id:base64key".
+// Kunci pertama adalah kunci aktif; kunci berikutnya hanya dipakai untuk dekripsi data lama.
+// indexKey dipakai untuk blind index dan tidak ikut dirotasi (nilai index harus stabil).
+func New(keySpec, indexKey string) (*Keyring, error) {
+	if strings.TrimSpace(keySpec) == "" {
+		return nil, ErrNoKeys
+	}
+	if indexKey == "" {
+		return nil, ErrMissingIndexKey
+	}
+
+	k := &Keyring{keys: map[string]cipher.AEAD{}, indexKey: []byte(indexKey)}
+	for _, entry := range strings.Split(keySpec, ",") {
+		id, encoded, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("format kunci %q harus id:base64key", entry)
+		}
+		if _, exists := k.keys[id]; exists {
+			return nil, fmt.Errorf("ID kunci %q terdaftar lebih dari sekali", id)
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(raw) != 32 {
+			return nil, fmt.Errorf("kunci %q: %w", id, ErrInvalidKey)
+		}
+		block, err := aes.NewCipher(raw)
+		if err != nil {
+			return nil, err
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		k.keys[id] = aead
+		if k.activeID == "" {
+			k.activeID = id
+		}
+	}
+	return k, nil
+}
+
+// ActiveKeyID mengembalikan ID kunci yang dipakai untuk enkripsi baru
+func (k *Keyring) ActiveKeyID() string {
+	return k.activeID
+}
+
+// Encrypt mengenkripsi plaintext dengan kunci aktif. String kosong tidak dienkripsi.
+// Format hasil: "enc:<keyID>:<base64(nonce|ciphertext)>"
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	aead := k.keys[k.activeID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(k.activeID))
+	return prefix + k.activeID + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt mengembalikan plaintext. Nilai tanpa prefix dikembalikan apa adanya
+// (data lama yang belum dienkripsi ulang dengan `reencrypt`).
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	id, encoded, ok := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !ok {
+		return "", ErrInvalidCipher
+	}
+	aead, exists := k.keys[id]
+	if !exists {
+		return "", fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCipher
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(id))
+	if err != nil {
+		return "", ErrInvalidCipher
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReencrypt mengecek apakah nilai masih plaintext atau memakai kunci selain kunci aktif
+func (k *Keyring) NeedsReencrypt(value string) bool {
+	if value == "" {
+		return false
+	}
+	return !strings.HasPrefix(value, prefix+k.activeID+":")
+}
+
+// BlindIndex menghasilkan hash deterministik untuk pencarian/deduplikasi.
+// Spasi dan tanda hubung dibuang serta huruf diseragamkan agar format penulisan tidak berpengaruh.
+func (k *Keyring) BlindIndex(value string) string {
+	normalized := strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(value))
+	if normalized == "" {
+		return ""
+	}
+	mac := hmac.New(sha256.New, k.indexKey)
+	mac.Write([]byte(normalized))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsEncrypted mengecek apakah nilai berformat ciphertext fieldcrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Bahasa yang didukung API
+const (
+	LangID      = "id"
+	LangEN      = "en"
+	DefaultLang = LangID
+
+	// LocalsKey adalah key fiber.Ctx.Locals tempat bahasa request disimpan
+	LocalsKey = "lang"
+)
+
+// catalogs berisi semua pesan per bahasa, dikunci dengan kode pesan/error
+var catalogs = map[string]map[string]string{
+	LangID: messagesID,
+	LangEN: messagesEN,
+}
+
+// IsSupported mengecek apakah bahasa tersedia di katalog
+func IsSupported(lang string) bool {
+	_, ok := catalogs[lang]
+	return ok
+}
+
+// Lookup mengambil pesan untuk key pada bahasa tertentu (fallback ke DefaultLang)
+func Lookup(lang, key string) (string, bool) {
+	if msgs, ok := catalogs[lang]; ok {
+		if msg, ok := msgs[key]; ok {
+			return msg, true
+		}
+	}
+	msg, ok := catalogs[DefaultLang][key]
+	return msg, ok
+}
+
+// T menerjemahkan key dan mengisi argumen format. Jika key tidak dikenal, key dikembalikan apa adanya.
+func T(lang, key string, args ...interface{}) string {
+	msg, ok := Lookup(lang, key)
+	if !ok {
+		return key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Negotiate memilih bahasa terbaik dari header Accept-Language (misal "en-US,en;q=0.9,id;q=0.8")
+func Negotiate(acceptLanguage string) string {
+	type candidate struct {
+		lang string
+		q    float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		q := 1.0
+		tag := part
+		if idx := strings.Index(part, ";"); idx != -1 {
+			tag = strings.TrimSpace(part[:idx])
+			if param := strings.TrimSpace(part[idx+1:]); strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+
+		// Ambil subtag utama saja: "en-US" -> "en"
+		primary := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+		if q > 0 && IsSupported(primary) {
+			candidates = append(candidates, candidate{lang: primary, q: q})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLang
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
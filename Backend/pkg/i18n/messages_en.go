@@ -0,0 +1,304 @@
+package i18n
+
+// messagesEN adalah katalog pesan Bahasa Inggris untuk tamu mancanegara
+var messagesEN = map[string]string{
+	// --- General ---
+	"SUCCESS":           "Success",
+	"NOT_FOUND":         "Data not found",
+	"CONFLICT":          "Data already exists",
+	"INVALID_BODY":      "Invalid request format",
+	"VALIDATION_FAILED": "Validation failed",
+	"INTERNAL_ERROR":    "An internal server error occurred",
+
+	// --- Auth & Token ---
+	"LOGIN_SUCCESS":                  "Login successful",
+	"REGISTER_SUCCESS":               "Registration successful",
+	"TWO_FACTOR_CODE_REQUIRED":       "Enter the code from your authenticator app or a recovery code",
+	"TWO_FACTOR_ENROLLMENT_REQUIRED": "Two-factor authentication is required for this account; set it up to continue signing in",
+	"TWO_FACTOR_STATUS_FETCHED":      "Two-factor status fetched successfully",
+	"TWO_FACTOR_SETUP_STARTED":       "Scan the QR code or enter the secret in your authenticator app, then confirm with the first code",
+	"TWO_FACTOR_ENABLED":             "Two-factor authentication enabled; store your recovery codes somewhere safe",
+	"TWO_FACTOR_DISABLED":            "Two-factor authentication disabled",
+	"RECOVERY_CODES_REGENERATED":     "New recovery codes generated; the old ones no longer work",
+	"OIDC_PROVIDERS_FETCHED":         "Login providers retrieved",
+	"OIDC_AUTHORIZATION_CREATED":     "Continue signing in on the provider page",
+	"IDENTITIES_FETCHED":             "Linked accounts retrieved",
+	"IDENTITY_LINKED":                "External account linked",
+	"IDENTITY_UNLINKED":              "External account unlinked",
+	"TOKEN_MISSING":                  "Token not found",
+	"TOKEN_INVALID_FORMAT":           "Invalid token format (use 'Bearer <token>')",
+	"TOKEN_INVALID":                  "Token is invalid or has expired",
+	"TOKEN_UNPROCESSABLE":            "Token could not be processed",
+	"UNAUTHENTICATED":                "Access denied: user is not authenticated",
+	"FORBIDDEN_ROLE":                 "You do not have access to this resource",
+	"FORBIDDEN_PERMISSION":           "Your role does not have permission for this action",
+
+	// --- User ---
+	"INVALID_USER_ID":         "Invalid user ID",
+	"USERS_FETCHED":           "Users retrieved successfully",
+	"USER_FETCHED":            "User retrieved successfully",
+	"USER_CREATED":            "User created successfully",
+	"USER_UPDATED":            "User updated successfully",
+	"USER_DEACTIVATED":        "User deactivated successfully",
+	"USER_REACTIVATED":        "User reactivated successfully",
+	"USER_ROLE_UPDATED":       "Role updated successfully",
+	"ROLES_FETCHED":           "Roles fetched successfully",
+	"PERMISSIONS_FETCHED":     "Permissions fetched successfully",
+	"PROFILE_UPDATED":         "Profile updated successfully",
+	"PROFILE_FETCHED":         "Profile retrieved successfully",
+	"PASSWORD_CHANGED":        "Password changed successfully",
+	"EMAIL_VERIFICATION_SENT": "A verification link has been sent to the new email address",
+	"EMAIL_CHANGED":           "Email address changed successfully",
+	"ACCOUNT_DELETED":         "Account deleted successfully",
+	"INVALID_SAVED_GUEST_ID":  "Invalid saved guest ID",
+	"SAVED_GUESTS_FETCHED":    "Saved guests retrieved successfully",
+	"SAVED_GUEST_CREATED":     "Guest details saved successfully",
+	"SAVED_GUEST_UPDATED":     "Saved guest updated successfully",
+	"SAVED_GUEST_DELETED":     "Saved guest deleted successfully",
+
+	// --- Rooms ---
+	"INVALID_ROOM_ID":         "Invalid room ID",
+	"INVALID_IMAGE_ID":        "Invalid image ID",
+	"ROOMS_FETCHED":           "Rooms retrieved successfully",
+	"ROOM_FETCHED":            "Room retrieved successfully",
+	"AVAILABLE_ROOMS_FETCHED": "Available rooms retrieved successfully",
+	"ROOM_CREATED":            "Room created successfully",
+	"ROOM_UPDATED":            "Room updated successfully",
+	"ROOM_DELETED":            "Room deleted successfully",
+	"ROOM_STATUS_UPDATED":     "Room status updated successfully",
+	"ROOM_IMAGE_ADDED":        "Room image added successfully",
+	"ROOM_IMAGE_DELETED":      "Room image deleted successfully",
+	"ROOM_IMAGES_ADDED":       "Room images added successfully",
+	"ROOM_IMAGE_UPDATED":      "Room image updated successfully",
+	"ROOM_IMAGES_REORDERED":   "Room image order saved successfully",
+	"ROOM_AMENITIES_UPDATED":  "Room amenities updated successfully",
+
+	// --- Properties ---
+	"INVALID_PROPERTY_ID":     "Invalid property ID",
+	"PROPERTIES_FETCHED":      "Properties fetched successfully",
+	"PROPERTY_FETCHED":        "Property fetched successfully",
+	"PROPERTY_CREATED":        "Property created successfully",
+	"PROPERTY_UPDATED":        "Property updated successfully",
+	"PROPERTY_DELETED":        "Property deleted successfully",
+	"USER_PROPERTIES_FETCHED": "Admin properties fetched successfully",
+	"USER_PROPERTIES_UPDATED": "Admin properties updated successfully",
+	"REPORT_FETCHED":          "Report fetched successfully",
+	"INVALID_REPORT_DATE":     "Report dates must use the YYYY-MM-DD format",
+
+	// --- Amenities ---
+	"INVALID_AMENITY_ID": "Invalid amenity ID",
+	"AMENITIES_FETCHED":  "Amenities fetched successfully",
+	"AMENITY_FETCHED":    "Amenity fetched successfully",
+	"AMENITY_CREATED":    "Amenity created successfully",
+	"AMENITY_UPDATED":    "Amenity updated successfully",
+	"AMENITY_DELETED":    "Amenity deleted successfully",
+
+	// --- Bookings ---
+	"INVALID_BOOKING_ID":            "Invalid booking ID",
+	"INVALID_CHECK_IN_DATE":         "Invalid check-in date format (use YYYY-MM-DD)",
+	"INVALID_CHECK_OUT_DATE":        "Invalid check-out date format (use YYYY-MM-DD)",
+	"BOOKINGS_FETCHED":              "Bookings retrieved successfully",
+	"BOOKING_FETCHED":               "Booking details retrieved successfully",
+	"BOOKING_CREATED":               "Booking created successfully",
+	"GUEST_DETAILS_INCOMPLETE":      "Guest details are incomplete (name, email and phone are required)",
+	"BOOKING_CANCELLED":             "Booking cancelled successfully",
+	"BOOKING_DELETED":               "Booking deleted successfully",
+	"BOOKING_STATUS_UPDATED":        "Booking status updated successfully",
+	"BOOKING_CHECKED_IN":            "Guest checked in successfully",
+	"BOOKING_MODIFIED":              "Booking modified successfully",
+	"BOOKING_MODIFICATIONS_FETCHED": "Booking modification history retrieved successfully",
+	"PAYMENT_STATUS_UPDATED":        "Payment status updated successfully",
+	"INVALID_GROUP_ID":              "Invalid group reservation ID",
+	"GROUP_CREATED":                 "Group reservation created successfully",
+	"GROUPS_FETCHED":                "Group reservations retrieved successfully",
+	"GROUP_FETCHED":                 "Group reservation retrieved successfully",
+	"GROUP_CANCELLED":               "Group reservation cancelled successfully",
+	"ROOMING_LIST_UPDATED":          "Rooming list updated successfully",
+	"INVALID_WAITLIST_ID":           "Invalid waitlist ID",
+	"WAITLIST_JOINED":               "You have joined the waitlist; we will email you when a room becomes available",
+	"WAITLIST_FETCHED":              "Waitlist retrieved successfully",
+	"WAITLIST_CANCELLED":            "You have left the waitlist",
+	"WAITLIST_CLAIMED":              "The waitlisted room was booked successfully",
+	"INVALID_EXTRA_ID":              "Invalid extra ID",
+	"INVALID_BOOKING_EXTRA_ID":      "Invalid booking extra ID",
+	"EXTRAS_FETCHED":                "Extras retrieved successfully",
+	"EXTRA_FETCHED":                 "Extra retrieved successfully",
+	"EXTRA_CREATED":                 "Extra added to the catalog successfully",
+	"EXTRA_UPDATED":                 "Extra updated successfully",
+	"EXTRA_DELETED":                 "Extra removed from the catalog successfully",
+	"BOOKING_EXTRA_ADDED":           "Extra added to the booking successfully",
+	"BOOKING_EXTRA_REMOVED":         "Extra removed from the booking successfully",
+	"INVOICE_FETCHED":               "Invoice retrieved successfully",
+
+	// --- Guest Profiles ---
+	"INVALID_GUEST_ID":        "Invalid guest ID",
+	"GUESTS_FETCHED":          "Guests fetched successfully",
+	"GUEST_FETCHED":           "Guest fetched successfully",
+	"GUEST_UPDATED":           "Guest profile updated successfully",
+	"GUESTS_MERGED":           "Guest profiles merged successfully",
+	"GUEST_IDENTITY_REVEALED": "Guest identity revealed; this access has been logged",
+	"PII_ACCESS_LOGS_FETCHED": "Personal data access logs fetched successfully",
+
+	// --- Personal Data (Privacy) ---
+	"INVALID_PRIVACY_REQUEST_ID": "Invalid privacy request ID",
+	"ERASURE_REQUESTED":          "Erasure request submitted and awaiting admin approval",
+	"PRIVACY_REQUESTS_FETCHED":   "Privacy requests fetched successfully",
+	"ERASURE_APPROVED":           "Request approved; the member's personal data has been anonymized",
+	"ERASURE_REJECTED":           "Erasure request rejected",
+
+	// --- Housekeeping ---
+	"INVALID_TASK_ID":              "Invalid housekeeping task ID",
+	"INVALID_TASK_DATE":            "Task date must be in YYYY-MM-DD format",
+	"HOUSEKEEPING_TASKS_FETCHED":   "Housekeeping tasks fetched successfully",
+	"HOUSEKEEPING_TASKS_GENERATED": "Stay-over tasks generated successfully",
+	"HOUSEKEEPING_TASK_ASSIGNED":   "Task assigned successfully",
+	"HOUSEKEEPING_TASK_STARTED":    "Task started",
+	"HOUSEKEEPING_TASK_COMPLETED":  "Task completed",
+	"HOUSEKEEPING_TASK_INSPECTED":  "Inspection result saved",
+	"ROOM_HOUSEKEEPING_UPDATED":    "Room housekeeping status updated successfully",
+
+	// --- Reviews ---
+	"INVALID_REVIEW_ID": "Invalid review ID",
+	"REVIEWS_FETCHED":   "Reviews retrieved successfully",
+	"REVIEW_FETCHED":    "Review retrieved successfully",
+	"REVIEW_CREATED":    "Review created successfully",
+	"REVIEW_DELETED":    "Review deleted successfully",
+
+	// --- Payments ---
+	"INVALID_PAYMENT_ID": "Invalid payment ID",
+	"PAYMENT_CREATED":    "Payment created successfully",
+	"PAYMENT_PROCESSED":  "Payment processed successfully",
+	"PAYMENT_REFUNDED":   "Payment refunded successfully",
+	"PAYMENT_FETCHED":    "Payment retrieved successfully",
+
+	// --- Domain Errors (models.DomainError.Code) ---
+	"INVALID_CREDENTIALS":          "Incorrect username or password",
+	"USER_NOT_FOUND":               "User not found",
+	"ACCOUNT_LOCKED":               "Account temporarily locked after too many failed login attempts; try again later",
+	"RATE_LIMITED":                 "Too many requests; please try again later",
+	"USER_ALREADY_EXISTS":          "Username or email is already in use",
+	"USERNAME_TAKEN":               "Username is already taken",
+	"EMAIL_TAKEN":                  "Email is already in use",
+	"ACCOUNT_DEACTIVATED":          "Your account has been deactivated, please contact an administrator",
+	"LAST_ADMIN":                   "The last active group administrator cannot be deactivated or have their role or access changed",
+	"CANNOT_DEACTIVATE_SELF":       "You cannot deactivate your own account",
+	"INVALID_TWO_FACTOR_CHALLENGE": "Two-factor session is invalid or expired; please sign in again",
+	"INVALID_TWO_FACTOR_CODE":      "Incorrect two-factor code or recovery code",
+	"TWO_FACTOR_ALREADY_ENABLED":   "Two-factor authentication is already enabled",
+	"TWO_FACTOR_NOT_ENABLED":       "Two-factor authentication is not enabled",
+	"TWO_FACTOR_SETUP_NOT_STARTED": "Start two-factor setup first",
+	"TWO_FACTOR_MANDATORY":         "Two-factor authentication is mandatory for this account and cannot be disabled",
+	"OIDC_PROVIDER_NOT_FOUND":      "Login provider not found",
+	"INVALID_OIDC_STATE":           "Login session is invalid or has expired, please try again",
+	"OIDC_LOGIN_FAILED":            "Sign-in with the provider failed",
+	"OIDC_EMAIL_NOT_VERIFIED":      "The provider account email is not verified",
+	"OIDC_ACCOUNT_EXISTS":          "This email belongs to a staff account; sign in with your password and link the account from settings",
+	"IDENTITY_ALREADY_LINKED":      "This external account is already linked to another user",
+	"IDENTITY_NOT_FOUND":           "Linked account not found",
+	"LAST_LOGIN_METHOD":            "Cannot unlink the only sign-in method of this account",
+	"INVALID_IDENTITY_ID":          "Invalid linked account ID",
+	"INVALID_CURRENT_PASSWORD":     "Current password is incorrect",
+	"REAUTH_REQUIRED":              "Enter your 2FA code or sign in again with your provider to continue",
+	"EMAIL_UNCHANGED":              "The new email is the same as the current one",
+	"INVALID_EMAIL_TOKEN":          "Email verification link is invalid or has expired",
+	"SAVED_GUEST_NOT_FOUND":        "Saved guest not found",
+	"SAVED_GUEST_LIMIT":            "You have reached the maximum number of saved guests",
+	"ROOM_NOT_FOUND":               "Room not found",
+	"ROOM_IMAGE_NOT_FOUND":         "Image not found",
+	"INVALID_ROOM_DATA":            "Room data is incomplete or invalid",
+	"ROOM_NUMBER_TAKEN":            "Room number is already used in this property",
+	"AMENITY_NOT_FOUND":            "Amenity not found",
+	"AMENITY_ALREADY_EXISTS":       "Amenity name is already in use",
+	"PROPERTY_NOT_FOUND":           "Property not found",
+	"PROPERTY_ALREADY_EXISTS":      "Property code is already in use",
+	"PROPERTY_FORBIDDEN":           "You do not have access to this property",
+	"PROPERTY_REQUIRED":            "Please choose a property (property_id)",
+	"PROPERTY_HAS_ROOMS":           "Property still has rooms",
+	"INVALID_TIMEZONE":             "Unknown timezone",
+	"PROPERTY_ASSIGN_NON_STAFF":    "Properties can only be assigned to staff",
+	"NO_PROPERTY_ASSIGNED":         "Your account has not been assigned to any property",
+	"GROUP_ADMIN_ROLE_REQUIRED":    "Only the admin role can be a group admin",
+	"INVALID_REPORT_PERIOD":        "Report end date must be after the start date",
+	"INVALID_IMAGE":                "File must be a valid jpeg, png, or webp image",
+	"IMAGE_TOO_LARGE":              "Image file size or dimensions exceed the limit",
+	"TOO_MANY_IMAGES":              "Too many images in a single upload",
+	"INVALID_IMAGE_ORDER":          "Image order must list every room image exactly once",
+	"BOOKING_NOT_FOUND":            "Booking not found",
+	"BOOKING_FORBIDDEN":            "You are not allowed to access this booking",
+	"ROOM_ALREADY_BOOKED":          "The room is already booked for that period",
+	"INVALID_DATE_FORMAT":          "Invalid check-in/check-out date format",
+	"MINIMUM_STAY":                 "Bookings require a minimum stay of 1 night",
+	"BOOKING_ALREADY_COMPLETED":    "Completed bookings cannot be cancelled",
+	"BOOKING_ALREADY_CANCELLED":    "The booking has already been cancelled",
+	"BOOKING_NOT_CANCELLED":        "Only cancelled bookings can be deleted",
+	"BOOKING_ALREADY_PAID":         "Paid bookings cannot be deleted",
+	"BOOKING_NOT_MODIFIABLE":       "This booking can no longer be modified",
+	"BOOKING_IN_HOUSE_CHANGE":      "The guest has checked in; only the check-out date and number of guests can be changed",
+	"BOOKING_UNCHANGED":            "No changes were made to the booking",
+	"MODIFICATION_DATE_PAST":       "The new dates cannot be in the past",
+	"ROOM_PROPERTY_MISMATCH":       "The new room must be in the same property",
+	"OCCUPANCY_EXCEEDED":           "The number of guests exceeds the room capacity",
+	"EXTRA_BEDS_EXCEEDED":          "The requested extra beds exceed what the room allows",
+	"ADULT_REQUIRED":               "At least one adult is required per room",
+	"GROUP_NOT_FOUND":              "Group reservation not found",
+	"GROUP_ROOM_COUNT":             "A group reservation must contain 2 to 20 rooms",
+	"GROUP_PROPERTY_MISMATCH":      "All rooms in a group reservation must be in the same property",
+	"GROUP_ROOM_CONFLICT":          "The same room was requested more than once for overlapping dates",
+	"GROUP_ALREADY_CANCELLED":      "The group reservation has already been cancelled",
+	"GROUP_NOT_CANCELLABLE":        "The group reservation cannot be cancelled because a room is already checked in or completed",
+	"GROUP_ALREADY_PAID":           "The group reservation has already been paid",
+	"GROUP_BOOKING_PAYMENT":        "Rooms in a group reservation are paid through the group payment",
+	"ROOMING_LIST_INVALID_BOOKING": "The booking is not an active room in this group reservation",
+	"WAITLIST_NOT_FOUND":           "Waitlist entry not found",
+	"WAITLIST_ROOM_AVAILABLE":      "A room is still available for those dates; please book it directly",
+	"WAITLIST_DUPLICATE":           "You are already on the waitlist for this room and dates",
+	"WAITLIST_LIMIT":               "You can have at most 5 active waitlist entries",
+	"WAITLIST_NOT_ACTIVE":          "This waitlist entry is no longer active",
+	"WAITLIST_CHECK_IN_PAST":       "The check-in date cannot be in the past",
+	"INVALID_WAITLIST_OFFER":       "The offer link is invalid",
+	"WAITLIST_OFFER_EXPIRED":       "The offer has expired or has already been claimed",
+	"EXTRA_NOT_FOUND":              "Extra not found",
+	"INVALID_EXTRA_PRICING":        "Pricing must be per_night, per_person or per_stay and the price cannot be negative",
+	"EXTRA_INACTIVE":               "This extra is currently not available",
+	"EXTRA_PROPERTY_MISMATCH":      "This extra is not offered at the booking's property",
+	"INVALID_EXTRA_QUANTITY":       "Extra quantity must be between 1 and 99",
+	"BOOKING_EXTRA_NOT_FOUND":      "This extra is not on the booking",
+	"BOOKING_EXTRA_FORBIDDEN":      "Extras added by staff or after check-in can only be removed by staff",
+	"REVIEW_NOT_FOUND":             "Review not found",
+	"REVIEW_ALREADY_EXISTS":        "You have already reviewed this booking",
+	"REVIEW_BOOKING_NOT_COMPLETED": "Reviews can only be written for completed bookings",
+	"INVALID_RATING":               "Rating must be between 1 and 5",
+	"PAYMENT_NOT_FOUND":            "Payment not found",
+	"PAYMENT_NOT_REFUNDABLE":       "Only successful payments can be refunded",
+	"PAYMENT_NOT_PENDING":          "Only pending payments can be processed",
+	"PAYMENT_ALREADY_PENDING":      "The booking still has a pending charge; process that charge instead",
+	"BOOKING_FULLY_PAID":           "The booking has already been paid in full",
+	"GUEST_NOT_FOUND":              "Guest profile not found",
+	"GUEST_ALREADY_EXISTS":         "Email or ID number is already used by another guest profile, use merge instead",
+	"INVALID_GUEST_MERGE":          "Choose other profiles to merge (a guest cannot be merged into itself)",
+	"PRIVACY_REQUEST_NOT_FOUND":    "Privacy request not found",
+	"PRIVACY_REQUEST_PROCESSED":    "This request has already been processed",
+	"ERASURE_ALREADY_REQUESTED":    "Your erasure request is still awaiting approval",
+	"ERASURE_ACTIVE_BOOKINGS":      "Data cannot be erased while you have active bookings; complete or cancel them first",
+	"ERASURE_NOT_ALLOWED":          "Staff accounts cannot request data erasure",
+	"ROOM_NOT_READY":               "Room is not ready yet (not cleaned)",
+	"HOUSEKEEPING_TASK_NOT_FOUND":  "Housekeeping task not found",
+	"INVALID_TASK_TRANSITION":      "Task cannot move to that stage",
+	"TASK_ASSIGNED_TO_OTHER":       "This task is assigned to another staff member",
+	"INVALID_TASK_ASSIGNEE":        "Tasks can only be assigned to housekeeping staff",
+	"CHECK_IN_NOT_ALLOWED":         "Check-in is only allowed for confirmed bookings within the stay period",
+	"BOOKING_ALREADY_CHECKED_IN":   "Guest has already checked in",
+
+	// --- Validation Rules (args: field name, rule parameter) ---
+	"VALIDATION_REQUIRED": "%s is required",
+	"VALIDATION_EMAIL":    "%s must be a valid email address",
+	"VALIDATION_MIN_LEN":  "%s must be at least %s characters",
+	"VALIDATION_MIN":      "%s must be at least %s",
+	"VALIDATION_MAX_LEN":  "%s must be at most %s characters",
+	"VALIDATION_MAX":      "%s must be at most %s",
+	"VALIDATION_GT":       "%s must be greater than %s",
+	"VALIDATION_GTE":      "%s must be at least %s",
+	"VALIDATION_ONEOF":    "%s must be one of: %s",
+	"VALIDATION_DATETIME": "%s must use the format %s",
+	"VALIDATION_DEFAULT":  "%s is invalid (%s)",
+}
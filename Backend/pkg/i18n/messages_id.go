@@ -0,0 +1,304 @@
+package i18n
+
+// messagesID adalah katalog pesan Bahasa Indonesia (bahasa default)
+var messagesID = map[string]string{
+	// --- Umum ---
+	"SUCCESS":           "Berhasil",
+	"NOT_FOUND":         "Data tidak ditemukan",
+	"CONFLICT":          "Data sudah ada",
+	"INVALID_BODY":      "Format request tidak valid",
+	"VALIDATION_FAILED": "Validasi gagal",
+	"INTERNAL_ERROR":    "Terjadi kesalahan pada server",
+
+	// --- Auth & Token ---
+	"LOGIN_SUCCESS":                  "Login berhasil",
+	"REGISTER_SUCCESS":               "Pendaftaran berhasil",
+	"TWO_FACTOR_CODE_REQUIRED":       "Masukkan kode dari aplikasi authenticator atau recovery code",
+	"TWO_FACTOR_ENROLLMENT_REQUIRED": "Akun ini wajib memakai 2FA, aktifkan terlebih dahulu untuk melanjutkan login",
+	"TWO_FACTOR_STATUS_FETCHED":      "Berhasil mengambil status 2FA",
+	"TWO_FACTOR_SETUP_STARTED":       "Pindai QR code atau masukkan secret di aplikasi authenticator, lalu konfirmasi dengan kode pertama",
+	"TWO_FACTOR_ENABLED":             "2FA berhasil diaktifkan, simpan recovery code di tempat aman",
+	"TWO_FACTOR_DISABLED":            "2FA berhasil dinonaktifkan",
+	"RECOVERY_CODES_REGENERATED":     "Recovery code baru berhasil dibuat, kode lama tidak berlaku lagi",
+	"OIDC_PROVIDERS_FETCHED":         "Daftar provider login berhasil diambil",
+	"OIDC_AUTHORIZATION_CREATED":     "Silakan lanjutkan login di halaman provider",
+	"IDENTITIES_FETCHED":             "Daftar akun tertaut berhasil diambil",
+	"IDENTITY_LINKED":                "Akun eksternal berhasil ditautkan",
+	"IDENTITY_UNLINKED":              "Akun eksternal berhasil dilepas",
+	"TOKEN_MISSING":                  "Token tidak ditemukan",
+	"TOKEN_INVALID_FORMAT":           "Format token tidak valid (gunakan 'Bearer <token>')",
+	"TOKEN_INVALID":                  "Token tidak valid atau sudah kadaluarsa",
+	"TOKEN_UNPROCESSABLE":            "Token tidak dapat diproses",
+	"UNAUTHENTICATED":                "Akses ditolak: user belum terauthentikasi",
+	"FORBIDDEN_ROLE":                 "Anda tidak memiliki akses ke resource ini",
+	"FORBIDDEN_PERMISSION":           "Role Anda tidak memiliki izin untuk aksi ini",
+
+	// --- User ---
+	"INVALID_USER_ID":         "ID pengguna tidak valid",
+	"USERS_FETCHED":           "Berhasil mengambil data pengguna",
+	"USER_FETCHED":            "Berhasil mengambil data pengguna",
+	"USER_CREATED":            "Pengguna berhasil dibuat",
+	"USER_UPDATED":            "Pengguna berhasil diubah",
+	"USER_DEACTIVATED":        "Pengguna berhasil dinonaktifkan",
+	"USER_REACTIVATED":        "Pengguna berhasil diaktifkan kembali",
+	"USER_ROLE_UPDATED":       "Role pengguna berhasil diubah",
+	"ROLES_FETCHED":           "Daftar role berhasil diambil",
+	"PERMISSIONS_FETCHED":     "Permission berhasil diambil",
+	"PROFILE_UPDATED":         "Profil berhasil diubah",
+	"PROFILE_FETCHED":         "Profil berhasil diambil",
+	"PASSWORD_CHANGED":        "Password berhasil diganti",
+	"EMAIL_VERIFICATION_SENT": "Link verifikasi sudah dikirim ke email baru",
+	"EMAIL_CHANGED":           "Email berhasil diganti",
+	"ACCOUNT_DELETED":         "Akun berhasil dihapus",
+	"INVALID_SAVED_GUEST_ID":  "ID data tamu tidak valid",
+	"SAVED_GUESTS_FETCHED":    "Data tamu tersimpan berhasil diambil",
+	"SAVED_GUEST_CREATED":     "Data tamu berhasil disimpan",
+	"SAVED_GUEST_UPDATED":     "Data tamu berhasil diubah",
+	"SAVED_GUEST_DELETED":     "Data tamu berhasil dihapus",
+
+	// --- Kamar ---
+	"INVALID_ROOM_ID":         "ID kamar tidak valid",
+	"INVALID_IMAGE_ID":        "ID gambar tidak valid",
+	"ROOMS_FETCHED":           "Berhasil mengambil data kamar",
+	"ROOM_FETCHED":            "Berhasil mengambil data kamar",
+	"AVAILABLE_ROOMS_FETCHED": "Berhasil mengambil kamar tersedia",
+	"ROOM_CREATED":            "Kamar berhasil dibuat",
+	"ROOM_UPDATED":            "Kamar berhasil diubah",
+	"ROOM_DELETED":            "Kamar berhasil dihapus",
+	"ROOM_STATUS_UPDATED":     "Status kamar berhasil diubah",
+	"ROOM_IMAGE_ADDED":        "Gambar kamar berhasil ditambah",
+	"ROOM_IMAGE_DELETED":      "Gambar kamar berhasil dihapus",
+	"ROOM_IMAGES_ADDED":       "Gambar kamar berhasil ditambah",
+	"ROOM_IMAGE_UPDATED":      "Gambar kamar berhasil diubah",
+	"ROOM_IMAGES_REORDERED":   "Urutan gambar kamar berhasil disimpan",
+	"ROOM_AMENITIES_UPDATED":  "Fasilitas kamar berhasil diubah",
+
+	// --- Properti ---
+	"INVALID_PROPERTY_ID":     "ID properti tidak valid",
+	"PROPERTIES_FETCHED":      "Data properti berhasil diambil",
+	"PROPERTY_FETCHED":        "Detail properti berhasil diambil",
+	"PROPERTY_CREATED":        "Properti berhasil dibuat",
+	"PROPERTY_UPDATED":        "Properti berhasil diperbarui",
+	"PROPERTY_DELETED":        "Properti berhasil dihapus",
+	"USER_PROPERTIES_FETCHED": "Properti admin berhasil diambil",
+	"USER_PROPERTIES_UPDATED": "Properti admin berhasil diperbarui",
+	"REPORT_FETCHED":          "Laporan berhasil diambil",
+	"INVALID_REPORT_DATE":     "Format tanggal laporan harus YYYY-MM-DD",
+
+	// --- Fasilitas ---
+	"INVALID_AMENITY_ID": "ID fasilitas tidak valid",
+	"AMENITIES_FETCHED":  "Berhasil mengambil data fasilitas",
+	"AMENITY_FETCHED":    "Berhasil mengambil detail fasilitas",
+	"AMENITY_CREATED":    "Fasilitas berhasil dibuat",
+	"AMENITY_UPDATED":    "Fasilitas berhasil diubah",
+	"AMENITY_DELETED":    "Fasilitas berhasil dihapus",
+
+	// --- Pemesanan ---
+	"INVALID_BOOKING_ID":            "ID pemesanan tidak valid",
+	"INVALID_CHECK_IN_DATE":         "Format tanggal check-in tidak valid (gunakan format YYYY-MM-DD)",
+	"INVALID_CHECK_OUT_DATE":        "Format tanggal check-out tidak valid (gunakan format YYYY-MM-DD)",
+	"BOOKINGS_FETCHED":              "Berhasil mengambil data pemesanan",
+	"BOOKING_FETCHED":               "Berhasil mengambil detail pemesanan",
+	"BOOKING_CREATED":               "Pemesanan berhasil dibuat",
+	"GUEST_DETAILS_INCOMPLETE":      "Data tamu belum lengkap (nama, email, dan telepon wajib diisi)",
+	"BOOKING_CANCELLED":             "Pemesanan berhasil dibatalkan",
+	"BOOKING_DELETED":               "Pemesanan berhasil dihapus",
+	"BOOKING_STATUS_UPDATED":        "Status booking berhasil diubah",
+	"BOOKING_CHECKED_IN":            "Tamu berhasil check-in",
+	"BOOKING_MODIFIED":              "Booking berhasil diubah",
+	"BOOKING_MODIFICATIONS_FETCHED": "Riwayat perubahan booking berhasil diambil",
+	"PAYMENT_STATUS_UPDATED":        "Status pembayaran berhasil diubah",
+	"INVALID_GROUP_ID":              "ID reservasi grup tidak valid",
+	"GROUP_CREATED":                 "Reservasi grup berhasil dibuat",
+	"GROUPS_FETCHED":                "Daftar reservasi grup berhasil diambil",
+	"GROUP_FETCHED":                 "Reservasi grup berhasil diambil",
+	"GROUP_CANCELLED":               "Reservasi grup berhasil dibatalkan",
+	"ROOMING_LIST_UPDATED":          "Rooming list berhasil diperbarui",
+	"INVALID_WAITLIST_ID":           "ID daftar tunggu tidak valid",
+	"WAITLIST_JOINED":               "Berhasil masuk daftar tunggu, kami akan mengirim email jika kamar tersedia",
+	"WAITLIST_FETCHED":              "Daftar tunggu berhasil diambil",
+	"WAITLIST_CANCELLED":            "Berhasil keluar dari daftar tunggu",
+	"WAITLIST_CLAIMED":              "Kamar dari daftar tunggu berhasil dibooking",
+	"INVALID_EXTRA_ID":              "ID layanan tambahan tidak valid",
+	"INVALID_BOOKING_EXTRA_ID":      "ID layanan tambahan booking tidak valid",
+	"EXTRAS_FETCHED":                "Daftar layanan tambahan berhasil diambil",
+	"EXTRA_FETCHED":                 "Detail layanan tambahan berhasil diambil",
+	"EXTRA_CREATED":                 "Layanan tambahan berhasil ditambahkan ke katalog",
+	"EXTRA_UPDATED":                 "Layanan tambahan berhasil diubah",
+	"EXTRA_DELETED":                 "Layanan tambahan berhasil dihapus dari katalog",
+	"BOOKING_EXTRA_ADDED":           "Layanan tambahan berhasil ditambahkan ke booking",
+	"BOOKING_EXTRA_REMOVED":         "Layanan tambahan berhasil dihapus dari booking",
+	"INVOICE_FETCHED":               "Rincian tagihan berhasil diambil",
+
+	// --- Profil Tamu ---
+	"INVALID_GUEST_ID":        "ID tamu tidak valid",
+	"GUESTS_FETCHED":          "Berhasil mengambil data tamu",
+	"GUEST_FETCHED":           "Berhasil mengambil detail tamu",
+	"GUEST_UPDATED":           "Profil tamu berhasil diubah",
+	"GUESTS_MERGED":           "Profil tamu berhasil digabung",
+	"GUEST_IDENTITY_REVEALED": "Data identitas tamu ditampilkan, akses telah dicatat",
+	"PII_ACCESS_LOGS_FETCHED": "Berhasil mengambil log akses data pribadi",
+
+	// --- Data Pribadi (UU PDP) ---
+	"INVALID_PRIVACY_REQUEST_ID": "ID permintaan data pribadi tidak valid",
+	"ERASURE_REQUESTED":          "Permintaan penghapusan data berhasil diajukan dan menunggu persetujuan admin",
+	"PRIVACY_REQUESTS_FETCHED":   "Berhasil mengambil permintaan data pribadi",
+	"ERASURE_APPROVED":           "Permintaan disetujui, data pribadi member telah dianonimkan",
+	"ERASURE_REJECTED":           "Permintaan penghapusan data ditolak",
+
+	// --- Housekeeping ---
+	"INVALID_TASK_ID":              "ID tugas housekeeping tidak valid",
+	"INVALID_TASK_DATE":            "Format tanggal tugas harus YYYY-MM-DD",
+	"HOUSEKEEPING_TASKS_FETCHED":   "Berhasil mengambil data tugas housekeeping",
+	"HOUSEKEEPING_TASKS_GENERATED": "Tugas stay-over berhasil dibuat",
+	"HOUSEKEEPING_TASK_ASSIGNED":   "Tugas berhasil ditugaskan",
+	"HOUSEKEEPING_TASK_STARTED":    "Tugas mulai dikerjakan",
+	"HOUSEKEEPING_TASK_COMPLETED":  "Tugas selesai dikerjakan",
+	"HOUSEKEEPING_TASK_INSPECTED":  "Hasil inspeksi berhasil disimpan",
+	"ROOM_HOUSEKEEPING_UPDATED":    "Status kebersihan kamar berhasil diubah",
+
+	// --- Ulasan ---
+	"INVALID_REVIEW_ID": "ID ulasan tidak valid",
+	"REVIEWS_FETCHED":   "Berhasil mengambil data ulasan",
+	"REVIEW_FETCHED":    "Berhasil mengambil data ulasan",
+	"REVIEW_CREATED":    "Ulasan berhasil dibuat",
+	"REVIEW_DELETED":    "Ulasan berhasil dihapus",
+
+	// --- Pembayaran ---
+	"INVALID_PAYMENT_ID": "ID pembayaran tidak valid",
+	"PAYMENT_CREATED":    "Pembayaran berhasil dibuat",
+	"PAYMENT_PROCESSED":  "Pembayaran berhasil diproses",
+	"PAYMENT_REFUNDED":   "Pembayaran berhasil di-refund",
+	"PAYMENT_FETCHED":    "Berhasil mengambil data pembayaran",
+
+	// --- Error Domain (models.DomainError.Code) ---
+	"INVALID_CREDENTIALS":          "Username atau password salah",
+	"USER_NOT_FOUND":               "Pengguna tidak ditemukan",
+	"ACCOUNT_LOCKED":               "Akun dikunci sementara karena terlalu banyak percobaan login gagal, coba lagi nanti",
+	"RATE_LIMITED":                 "Terlalu banyak request, coba lagi nanti",
+	"USER_ALREADY_EXISTS":          "Username atau email sudah digunakan",
+	"USERNAME_TAKEN":               "Username sudah digunakan",
+	"EMAIL_TAKEN":                  "Email sudah digunakan",
+	"ACCOUNT_DEACTIVATED":          "Akun Anda sudah dinonaktifkan, hubungi admin",
+	"LAST_ADMIN":                   "Admin grup aktif terakhir tidak bisa dinonaktifkan atau diubah role maupun aksesnya",
+	"CANNOT_DEACTIVATE_SELF":       "Tidak bisa menonaktifkan akun sendiri",
+	"INVALID_TWO_FACTOR_CHALLENGE": "Sesi verifikasi 2FA tidak valid atau sudah kedaluwarsa, silakan login ulang",
+	"INVALID_TWO_FACTOR_CODE":      "Kode 2FA atau recovery code salah",
+	"TWO_FACTOR_ALREADY_ENABLED":   "2FA sudah aktif",
+	"TWO_FACTOR_NOT_ENABLED":       "2FA belum aktif",
+	"TWO_FACTOR_SETUP_NOT_STARTED": "Mulai setup 2FA terlebih dahulu",
+	"TWO_FACTOR_MANDATORY":         "2FA wajib untuk akun ini dan tidak bisa dinonaktifkan",
+	"OIDC_PROVIDER_NOT_FOUND":      "Provider login tidak ditemukan",
+	"INVALID_OIDC_STATE":           "Sesi login tidak valid atau sudah kedaluwarsa, silakan ulangi",
+	"OIDC_LOGIN_FAILED":            "Login melalui provider gagal",
+	"OIDC_EMAIL_NOT_VERIFIED":      "Email akun provider belum terverifikasi",
+	"OIDC_ACCOUNT_EXISTS":          "Email ini sudah dipakai akun staf; login dengan password lalu tautkan akun dari pengaturan",
+	"IDENTITY_ALREADY_LINKED":      "Akun eksternal ini sudah tertaut ke user lain",
+	"IDENTITY_NOT_FOUND":           "Akun tertaut tidak ditemukan",
+	"LAST_LOGIN_METHOD":            "Tidak bisa melepas satu-satunya metode login akun ini",
+	"INVALID_IDENTITY_ID":          "ID akun tertaut tidak valid",
+	"INVALID_CURRENT_PASSWORD":     "Password saat ini salah",
+	"REAUTH_REQUIRED":              "Masukkan kode 2FA atau login ulang lewat provider untuk melanjutkan",
+	"EMAIL_UNCHANGED":              "Email baru sama dengan email saat ini",
+	"INVALID_EMAIL_TOKEN":          "Link verifikasi email tidak valid atau sudah kedaluwarsa",
+	"SAVED_GUEST_NOT_FOUND":        "Data tamu tersimpan tidak ditemukan",
+	"SAVED_GUEST_LIMIT":            "Jumlah data tamu tersimpan sudah mencapai batas",
+	"ROOM_NOT_FOUND":               "Kamar tidak ditemukan",
+	"ROOM_IMAGE_NOT_FOUND":         "Gambar tidak ditemukan",
+	"INVALID_ROOM_DATA":            "Data kamar tidak lengkap atau tidak valid",
+	"ROOM_NUMBER_TAKEN":            "Nomor kamar sudah dipakai di properti ini",
+	"AMENITY_NOT_FOUND":            "Fasilitas tidak ditemukan",
+	"AMENITY_ALREADY_EXISTS":       "Nama fasilitas sudah digunakan",
+	"PROPERTY_NOT_FOUND":           "Properti tidak ditemukan",
+	"PROPERTY_ALREADY_EXISTS":      "Kode properti sudah dipakai",
+	"PROPERTY_FORBIDDEN":           "Anda tidak memiliki akses ke properti ini",
+	"PROPERTY_REQUIRED":            "Pilih properti (property_id) terlebih dahulu",
+	"PROPERTY_HAS_ROOMS":           "Properti masih memiliki kamar",
+	"INVALID_TIMEZONE":             "Zona waktu tidak dikenal",
+	"PROPERTY_ASSIGN_NON_STAFF":    "Properti hanya bisa ditugaskan ke staf",
+	"NO_PROPERTY_ASSIGNED":         "Akun belum ditugaskan ke properti mana pun",
+	"GROUP_ADMIN_ROLE_REQUIRED":    "Hanya role admin yang bisa menjadi admin grup",
+	"INVALID_REPORT_PERIOD":        "Tanggal akhir laporan harus setelah tanggal awal",
+	"INVALID_IMAGE":                "File harus berupa gambar jpeg, png, atau webp yang valid",
+	"IMAGE_TOO_LARGE":              "Ukuran atau dimensi gambar melebihi batas",
+	"TOO_MANY_IMAGES":              "Jumlah gambar dalam satu upload melebihi batas",
+	"INVALID_IMAGE_ORDER":          "Urutan gambar harus berisi semua gambar kamar tepat satu kali",
+	"BOOKING_NOT_FOUND":            "Pemesanan tidak ditemukan",
+	"BOOKING_FORBIDDEN":            "Anda tidak memiliki izin mengakses pemesanan ini",
+	"ROOM_ALREADY_BOOKED":          "Kamar sudah dibooking pada periode tersebut",
+	"INVALID_DATE_FORMAT":          "Format tanggal check-in/out tidak valid",
+	"MINIMUM_STAY":                 "Durasi pemesanan minimal 1 malam",
+	"BOOKING_ALREADY_COMPLETED":    "Pemesanan yang sudah selesai tidak dapat dibatalkan",
+	"BOOKING_ALREADY_CANCELLED":    "Pemesanan sudah dibatalkan sebelumnya",
+	"BOOKING_NOT_CANCELLED":        "Hanya booking yang cancelled yang bisa dihapus",
+	"BOOKING_ALREADY_PAID":         "Booking yang sudah dibayar tidak bisa dihapus",
+	"BOOKING_NOT_MODIFIABLE":       "Booking ini tidak bisa diubah",
+	"BOOKING_IN_HOUSE_CHANGE":      "Tamu sudah check-in, hanya tanggal check-out dan jumlah tamu yang bisa diubah",
+	"BOOKING_UNCHANGED":            "Tidak ada perubahan pada booking",
+	"MODIFICATION_DATE_PAST":       "Tanggal baru tidak boleh sebelum hari ini",
+	"ROOM_PROPERTY_MISMATCH":       "Kamar pengganti harus berada di properti yang sama",
+	"OCCUPANCY_EXCEEDED":           "Jumlah tamu melebihi kapasitas kamar",
+	"EXTRA_BEDS_EXCEEDED":          "Jumlah extra bed melebihi batas kamar",
+	"ADULT_REQUIRED":               "Minimal satu tamu dewasa per kamar",
+	"GROUP_NOT_FOUND":              "Reservasi grup tidak ditemukan",
+	"GROUP_ROOM_COUNT":             "Reservasi grup berisi 2 sampai 20 kamar",
+	"GROUP_PROPERTY_MISMATCH":      "Semua kamar dalam reservasi grup harus berada di properti yang sama",
+	"GROUP_ROOM_CONFLICT":          "Kamar yang sama diminta lebih dari sekali pada tanggal yang bertumpuk",
+	"GROUP_ALREADY_CANCELLED":      "Reservasi grup sudah dibatalkan",
+	"GROUP_NOT_CANCELLABLE":        "Reservasi grup tidak bisa dibatalkan karena ada kamar yang sudah check-in atau selesai",
+	"GROUP_ALREADY_PAID":           "Reservasi grup sudah dibayar",
+	"GROUP_BOOKING_PAYMENT":        "Kamar dalam reservasi grup dibayar lewat pembayaran grup",
+	"ROOMING_LIST_INVALID_BOOKING": "Booking tidak termasuk kamar aktif dalam reservasi grup ini",
+	"WAITLIST_NOT_FOUND":           "Antrean daftar tunggu tidak ditemukan",
+	"WAITLIST_ROOM_AVAILABLE":      "Kamar masih tersedia pada tanggal tersebut, silakan langsung booking",
+	"WAITLIST_DUPLICATE":           "Anda sudah masuk daftar tunggu untuk kamar dan tanggal yang sama",
+	"WAITLIST_LIMIT":               "Maksimal 5 antrean daftar tunggu aktif per member",
+	"WAITLIST_NOT_ACTIVE":          "Antrean sudah tidak aktif",
+	"WAITLIST_CHECK_IN_PAST":       "Tanggal check-in tidak boleh sebelum hari ini",
+	"INVALID_WAITLIST_OFFER":       "Link penawaran tidak valid",
+	"WAITLIST_OFFER_EXPIRED":       "Penawaran sudah kedaluwarsa atau sudah diklaim",
+	"EXTRA_NOT_FOUND":              "Layanan tambahan tidak ditemukan",
+	"INVALID_EXTRA_PRICING":        "Cara hitung harus per_night, per_person, atau per_stay dan harga tidak boleh negatif",
+	"EXTRA_INACTIVE":               "Layanan tambahan sedang tidak dijual",
+	"EXTRA_PROPERTY_MISMATCH":      "Layanan tambahan tidak tersedia di properti booking ini",
+	"INVALID_EXTRA_QUANTITY":       "Jumlah layanan tambahan harus 1 sampai 99",
+	"BOOKING_EXTRA_NOT_FOUND":      "Layanan tambahan tidak ada di booking ini",
+	"BOOKING_EXTRA_FORBIDDEN":      "Layanan tambahan yang ditambahkan staf atau setelah check-in hanya bisa dihapus staf",
+	"REVIEW_NOT_FOUND":             "Ulasan tidak ditemukan",
+	"REVIEW_ALREADY_EXISTS":        "Anda sudah memberikan ulasan untuk pemesanan ini",
+	"REVIEW_BOOKING_NOT_COMPLETED": "Ulasan hanya dapat dibuat untuk pemesanan yang sudah selesai",
+	"INVALID_RATING":               "Rating harus antara 1 sampai 5",
+	"PAYMENT_NOT_FOUND":            "Pembayaran tidak ditemukan",
+	"PAYMENT_NOT_REFUNDABLE":       "Hanya pembayaran yang sukses yang dapat di-refund",
+	"PAYMENT_NOT_PENDING":          "Hanya pembayaran berstatus pending yang dapat diproses",
+	"PAYMENT_ALREADY_PENDING":      "Booking masih memiliki tagihan pending; proses tagihan tersebut",
+	"BOOKING_FULLY_PAID":           "Booking sudah lunas",
+	"GUEST_NOT_FOUND":              "Profil tamu tidak ditemukan",
+	"GUEST_ALREADY_EXISTS":         "Email atau nomor identitas sudah dipakai profil tamu lain, gunakan merge",
+	"INVALID_GUEST_MERGE":          "Pilih profil lain untuk digabung (tidak bisa menggabung ke dirinya sendiri)",
+	"PRIVACY_REQUEST_NOT_FOUND":    "Permintaan data pribadi tidak ditemukan",
+	"PRIVACY_REQUEST_PROCESSED":    "Permintaan ini sudah diproses",
+	"ERASURE_ALREADY_REQUESTED":    "Permintaan penghapusan data Anda masih menunggu persetujuan",
+	"ERASURE_ACTIVE_BOOKINGS":      "Data tidak bisa dihapus selama masih ada booking aktif, selesaikan atau batalkan terlebih dahulu",
+	"ERASURE_NOT_ALLOWED":          "Akun staf tidak bisa meminta penghapusan data",
+	"ROOM_NOT_READY":               "Kamar belum siap ditempati (belum dibersihkan)",
+	"HOUSEKEEPING_TASK_NOT_FOUND":  "Tugas housekeeping tidak ditemukan",
+	"INVALID_TASK_TRANSITION":      "Status tugas tidak dapat diubah ke tahap tersebut",
+	"TASK_ASSIGNED_TO_OTHER":       "Tugas ini ditugaskan ke staf lain",
+	"INVALID_TASK_ASSIGNEE":        "Tugas hanya bisa diberikan ke staf housekeeping",
+	"CHECK_IN_NOT_ALLOWED":         "Check-in hanya untuk booking terkonfirmasi pada periode menginap",
+	"BOOKING_ALREADY_CHECKED_IN":   "Tamu sudah check-in",
+
+	// --- Aturan Validasi (argumen: nama field, parameter rule) ---
+	"VALIDATION_REQUIRED": "%s wajib diisi",
+	"VALIDATION_EMAIL":    "%s harus berupa alamat email yang valid",
+	"VALIDATION_MIN_LEN":  "%s minimal %s karakter",
+	"VALIDATION_MIN":      "%s minimal %s",
+	"VALIDATION_MAX_LEN":  "%s maksimal %s karakter",
+	"VALIDATION_MAX":      "%s maksimal %s",
+	"VALIDATION_GT":       "%s harus lebih besar dari %s",
+	"VALIDATION_GTE":      "%s minimal %s",
+	"VALIDATION_ONEOF":    "%s harus salah satu dari: %s",
+	"VALIDATION_DATETIME": "%s harus menggunakan format %s",
+	"VALIDATION_DEFAULT":  "%s tidak valid (%s)",
+}
@@ -0,0 +1,231 @@
+// Package imaging memvalidasi dan memproses gambar upload:
+// deteksi tipe asli dari isi file, batas ukuran, buang metadata EXIF,
+// dan membuat varian ukuran standar (thumb, card, full, webp).
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"github.com/HugoSmits86/nativewebp"
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // decoder WebP untuk image.Decode
+)
+
+var (
+	ErrUnsupportedType = errors.New("tipe file bukan gambar yang didukung (jpeg, png, webp)")
+	ErrTooLarge        = errors.New("ukuran file gambar melebihi batas")
+	ErrTooManyPixels   = errors.New("dimensi gambar terlalu besar")
+	ErrCorrupt         = errors.New("file gambar rusak atau tidak dapat dibaca")
+)
+
+// DefaultMaxPixels membatasi resolusi (±40 megapiksel) agar decode tidak menghabiskan memori
+const DefaultMaxPixels = 40_000_000
+
+const jpegQuality = 85
+
+// Options mengatur batas pemrosesan
+type Options struct {
+	MaxBytes  int64 // Ukuran file maksimal
+	MaxPixels int   // Lebar x tinggi maksimal (0 = DefaultMaxPixels)
+}
+
+// VariantSpec adalah ukuran target (gambar dikecilkan agar muat, tidak pernah diperbesar)
+type VariantSpec struct {
+	Name      string
+	MaxWidth  int
+	MaxHeight int
+	WebP      bool
+}
+
+// StandardVariants adalah varian yang dibuat untuk setiap gambar kamar.
+// WebP di-encode lossless sehingga dibuat pada ukuran card agar file tetap kecil.
+var StandardVariants = []VariantSpec{
+	{Name: "thumb", MaxWidth: 320, MaxHeight: 240},
+	{Name: "card", MaxWidth: 800, MaxHeight: 600},
+	{Name: "full", MaxWidth: 1920, MaxHeight: 1440},
+	{Name: "webp", MaxWidth: 800, MaxHeight: 600, WebP: true},
+}
+
+// Encoded adalah hasil encode satu gambar
+type Encoded struct {
+	Name        string
+	Ext         string // Tanpa titik, contoh "jpg"
+	ContentType string
+	Width       int
+	Height      int
+	Data        []byte
+}
+
+// Result berisi gambar asli (sudah dibersihkan dari metadata) dan semua varian
+type Result struct {
+	Original Encoded
+	Variants []Encoded
+}
+
+// Process membaca gambar dari r, memvalidasinya, lalu membuat varian standar.
+// Gambar asli di-encode ulang sehingga EXIF (lokasi GPS, info kamera) ikut terbuang;
+// orientasi EXIF diterapkan terlebih dahulu agar foto dari ponsel tidak miring.
+func Process(r io.Reader, opts Options) (*Result, error) {
+	data, err := readLimited(r, opts.MaxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/jpeg", "image/png", "image/webp":
+	default:
+		return nil, ErrUnsupportedType
+	}
+
+	maxPixels := opts.MaxPixels
+	if maxPixels <= 0 {
+		maxPixels = DefaultMaxPixels
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrCorrupt
+	}
+
+	img := toNRGBA(src)
+	if contentType == "image/jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	// PNG/WebP dengan transparansi tetap PNG, selain itu JPEG
+	keepAlpha := contentType != "image/jpeg" && !img.Opaque()
+
+	original, err := encode("original", img, keepAlpha, false)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Original: original}
+
+	for _, spec := range StandardVariants {
+		variant, err := encode(spec.Name, fit(img, spec.MaxWidth, spec.MaxHeight), keepAlpha, spec.WebP)
+		if err != nil {
+			return nil, err
+		}
+		result.Variants = append(result.Variants, variant)
+	}
+	return result, nil
+}
+
+// readLimited membaca seluruh isi r dan gagal jika melebihi maxBytes
+func readLimited(r io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes <= 0 {
+		return io.ReadAll(r)
+	}
+	data, err := io.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+func encode(name string, img image.Image, keepAlpha, webp bool) (Encoded, error) {
+	var buf bytes.Buffer
+	result := Encoded{Name: name, Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}
+
+	var err error
+	switch {
+	case webp:
+		result.Ext, result.ContentType = "webp", "image/webp"
+		err = nativewebp.Encode(&buf, img, nil)
+	case keepAlpha:
+		result.Ext, result.ContentType = "png", "image/png"
+		err = png.Encode(&buf, img)
+	default:
+		result.Ext, result.ContentType = "jpg", "image/jpeg"
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return Encoded{}, fmt.Errorf("gagal encode varian %s: %w", name, err)
+	}
+
+	result.Data = buf.Bytes()
+	return result, nil
+}
+
+// fit mengecilkan gambar agar muat di maxWidth x maxHeight dengan rasio tetap
+func fit(img *image.NRGBA, maxWidth, maxHeight int) image.Image {
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width <= maxWidth && height <= maxHeight {
+		return img
+	}
+
+	scale := min(float64(maxWidth)/float64(width), float64(maxHeight)/float64(height))
+	newWidth := max(1, int(float64(width)*scale+0.5))
+	newHeight := max(1, int(float64(height)*scale+0.5))
+
+	dst := image.NewNRGBA(image.Rect(0, 0, newWidth, newHeight))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Src, nil)
+	return dst
+}
+
+func toNRGBA(src image.Image) *image.NRGBA {
+	if img, ok := src.(*image.NRGBA); ok && img.Bounds().Min == (image.Point{}) {
+		return img
+	}
+	bounds := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), src, bounds.Min, draw.Src)
+	return dst
+}
+
+// applyOrientation memutar/membalik gambar sesuai tag Orientation EXIF (1-8)
+func applyOrientation(img *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // cermin horizontal
+				dx, dy = width-1-x, y
+			case 3: // putar 180
+				dx, dy = width-1-x, height-1-y
+			case 4: // cermin vertikal
+				dx, dy = x, height-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // putar 90 searah jarum jam
+				dx, dy = height-1-y, x
+			case 7: // transverse
+				dx, dy = height-1-y, width-1-x
+			case 8: // putar 90 berlawanan jarum jam
+				dx, dy = y, width-1-x
+			}
+			dst.SetNRGBA(dx, dy, img.NRGBAAt(x, y))
+		}
+	}
+	return dst
+}
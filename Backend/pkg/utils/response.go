@@ -1,28 +1,62 @@
 package utils
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"backend/pkg/i18n"
+
+	"github.com/gofiber/fiber/v2"
+)
 
 // Struktur standar untuk response API
 type Response struct {
-	Success bool        `json:"success"`
-	Message string      `json:"message"`
-	Data    interface{} `json:"data"`
+	Success   bool        `json:"success"`
+	Message   string      `json:"message"`
+	ErrorCode string      `json:"error_code,omitempty"`
+	Data      interface{} `json:"data"`
+}
+
+// Lang mengambil bahasa request yang sudah dinegosiasikan oleh middleware
+func Lang(c *fiber.Ctx) string {
+	if lang, ok := c.Locals(i18n.LocalsKey).(string); ok && lang != "" {
+		return lang
+	}
+	return i18n.DefaultLang
 }
 
-// RespondSuccess mengirim response sukses
+// RespondSuccess mengirim response sukses. message adalah kode pesan di katalog i18n.
 func RespondSuccess(c *fiber.Ctx, status int, message string, data interface{}) error {
 	return c.Status(status).JSON(Response{
 		Success: true,
-		Message: message,
+		Message: i18n.T(Lang(c), message),
 		Data:    data,
 	})
 }
 
-// RespondError mengirim response error
+// RespondError mengirim response error. message adalah kode pesan di katalog i18n
+// dan sekaligus dikirim sebagai error_code jika terdaftar.
 func RespondError(c *fiber.Ctx, status int, message string) error {
+	code := ""
+	if _, ok := i18n.Lookup(Lang(c), message); ok {
+		code = message
+	}
+	return c.Status(status).JSON(Response{
+		Success:   false,
+		Message:   i18n.T(Lang(c), message),
+		ErrorCode: code,
+		Data:      nil,
+	})
+}
+
+// RespondErrorCode mengirim response error beserta kode error yang stabil untuk client.
+// fallback dipakai jika kode belum ada di katalog.
+func RespondErrorCode(c *fiber.Ctx, status int, code, fallback string) error {
+	message, ok := i18n.Lookup(Lang(c), code)
+	if !ok {
+		message = fallback
+	}
 	return c.Status(status).JSON(Response{
-		Success: false,
-		Message: message,
-		Data:    nil,
+		Success:   false,
+		Message:   message,
+		ErrorCode: code,
+		Data:      nil,
 	})
 }
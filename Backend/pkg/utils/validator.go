@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"backend/pkg/i18n"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+// validate adalah instance validator bersama (thread-safe, cache struct di-reuse)
+var validate = newValidator()
+
+// ErrInvalidBody dikembalikan jika body request tidak dapat di-parse
+var ErrInvalidBody = errors.New("format request tidak valid")
+
+// FieldError menjelaskan satu kesalahan validasi pada field tertentu
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+
+	isString bool // untuk membedakan pesan panjang karakter vs nilai angka
+}
+
+// ValidationError berisi seluruh kesalahan validasi dari satu request
+type ValidationError struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		parts = append(parts, fe.Message)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Gunakan nama dari tag json/form agar sesuai dengan field yang dikirim client
+	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+		for _, tag := range []string{"json", "form", "query"} {
+			name := strings.SplitN(fld.Tag.Get(tag), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return fld.Name
+	})
+	return v
+}
+
+// ValidateStruct menjalankan tag `validate` pada struct dan mengembalikan *ValidationError jika gagal
+func ValidateStruct(s interface{}) error {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return err
+	}
+
+	result := &ValidationError{Errors: make([]FieldError, 0, len(validationErrs))}
+	for _, fe := range validationErrs {
+		fieldErr := FieldError{
+			Field:    fe.Field(),
+			Rule:     fe.Tag(),
+			Param:    fe.Param(),
+			isString: fe.Kind() == reflect.String,
+		}
+		fieldErr.Message = fieldErrorMessage(i18n.DefaultLang, fieldErr)
+		result.Errors = append(result.Errors, fieldErr)
+	}
+	return result
+}
+
+// BindAndValidate mem-parse body request ke out lalu menjalankan validasi struct
+func BindAndValidate(c *fiber.Ctx, out interface{}) error {
+	if err := c.BodyParser(out); err != nil {
+		return ErrInvalidBody
+	}
+	return ValidateStruct(out)
+}
+
+// RespondBindError mengirim response yang sesuai untuk error dari BindAndValidate
+func RespondBindError(c *fiber.Ctx, err error) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		// Lokalisasi ulang pesan per field sesuai bahasa request
+		lang := Lang(c)
+		for i := range validationErr.Errors {
+			validationErr.Errors[i].Message = fieldErrorMessage(lang, validationErr.Errors[i])
+		}
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(Response{
+			Success:   false,
+			Message:   i18n.T(lang, "VALIDATION_FAILED"),
+			ErrorCode: "VALIDATION_FAILED",
+			Data:      validationErr,
+		})
+	}
+	return RespondErrorCode(c, fiber.StatusBadRequest, "INVALID_BODY", "Format request tidak valid")
+}
+
+// fieldErrorMessage menerjemahkan rule validator menjadi pesan yang mudah dibaca
+func fieldErrorMessage(lang string, fe FieldError) string {
+	switch fe.Rule {
+	case "required", "required_without":
+		return i18n.T(lang, "VALIDATION_REQUIRED", fe.Field)
+	case "email":
+		return i18n.T(lang, "VALIDATION_EMAIL", fe.Field)
+	case "min":
+		if fe.isString {
+			return i18n.T(lang, "VALIDATION_MIN_LEN", fe.Field, fe.Param)
+		}
+		return i18n.T(lang, "VALIDATION_MIN", fe.Field, fe.Param)
+	case "max":
+		if fe.isString {
+			return i18n.T(lang, "VALIDATION_MAX_LEN", fe.Field, fe.Param)
+		}
+		return i18n.T(lang, "VALIDATION_MAX", fe.Field, fe.Param)
+	case "gt":
+		return i18n.T(lang, "VALIDATION_GT", fe.Field, fe.Param)
+	case "gte":
+		return i18n.T(lang, "VALIDATION_GTE", fe.Field, fe.Param)
+	case "oneof":
+		return i18n.T(lang, "VALIDATION_ONEOF", fe.Field, fe.Param)
+	case "datetime":
+		return i18n.T(lang, "VALIDATION_DATETIME", fe.Field, fe.Param)
+	default:
+		return i18n.T(lang, "VALIDATION_DEFAULT", fe.Field, fe.Rule)
+	}
+}
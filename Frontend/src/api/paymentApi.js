@@ -9,7 +9,7 @@ import axiosInstance from './axios'
  */
 export const createPayment = async (paymentData) => {
   const response = await axiosInstance.post('/member/payments', paymentData)
-  return response.data
+  return response.data.data
 }
 
 /**
@@ -19,7 +19,7 @@ export const createPayment = async (paymentData) => {
  */
 export const getPaymentByBooking = async (bookingId) => {
   const response = await axiosInstance.get(`/member/payments/booking/${bookingId}`)
-  return response.data
+  return response.data.data
 }
 
 /**
@@ -41,16 +41,22 @@ Create Payment Request:
 
 Create Payment Response:
 {
-  "ID": 1,
-  "booking_id": 1,
-  "amount": 1500000,
-  "payment_method": "credit_card",
-  "status": "pending",
-  "transaction_id": "TRX-1-1732612345"
+  "success": true,
+  "message": "Pembayaran berhasil dibuat",
+  "data": {
+    "ID": 1,
+    "BookingID": 1,
+    "Amount": 1500000,
+    "PaymentMethod": "credit_card",
+    "Status": "pending",
+    "TransactionID": "TRX-1-1732612345"
+  }
 }
 
 Process Payment Response:
 {
-  "message": "Payment processed successfully"
+  "success": true,
+  "message": "Pembayaran berhasil diproses",
+  "data": null
 }
 */
@@ -8,7 +8,8 @@ const RoomCard = ({ room }) => {
     return null
   }
 
-  const imageUrl = room.Images?.[0]?.ImageURL
+  const primaryImage = room.PrimaryImage || room.Images?.[0]
+  const imageUrl = primaryImage?.CardURL || primaryImage?.ImageURL
   const mainImage = imageUrl ? `http://127.0.0.1:9000${imageUrl}` : 'https://via.placeholder.com/400x300?text=Room+Image'
   const roomNumber = room.RoomNumber || 'N/A'
   const roomType = room.Type || 'Standard'